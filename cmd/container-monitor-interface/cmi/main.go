@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -24,16 +24,21 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/huawei/csm/v2/config"
 	clientConfig "github.com/huawei/csm/v2/config/client"
 	cmiConfig "github.com/huawei/csm/v2/config/cmi"
 	"github.com/huawei/csm/v2/config/common"
 	logConfig "github.com/huawei/csm/v2/config/log"
+	tlsConfig "github.com/huawei/csm/v2/config/tls"
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/collect"
 	grpchelper "github.com/huawei/csm/v2/provider/grpc/helper"
 	"github.com/huawei/csm/v2/provider/grpc/server"
+	"github.com/huawei/csm/v2/provider/health"
 	"github.com/huawei/csm/v2/provider/utils"
 	"github.com/huawei/csm/v2/utils/log"
 	"github.com/huawei/csm/v2/utils/version"
@@ -52,7 +57,7 @@ var cmiService = &cobra.Command{
 
 func main() {
 	manager := config.NewOptionManager(cmiService.Flags(), logConfig.Option, clientConfig.Option, cmiConfig.Option,
-		common.Option)
+		common.Option, tlsConfig.Option)
 	manager.AddFlags()
 
 	cmiService.Run = func(cmd *cobra.Command, args []string) {
@@ -101,21 +106,41 @@ func main() {
 func StartGrpcServer(address string) error {
 	log.Infoln("Starting cmi server")
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(log.EnsureGRPCContext),
+		grpc.ChainUnaryInterceptor(log.EnsureGRPCContext, cmierror.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(log.EnsureStreamGRPCContext, cmierror.StreamServerInterceptor),
+	}
+	network, target, err := cmi.ParseAddress(address)
+	if err != nil {
+		return err
+	}
+
+	if network == "tcp" {
+		creds, err := cmi.NewServerCredentials(tlsConfig.GetTLSOption())
+		if err != nil {
+			return fmt.Errorf("build tls credentials failed, error: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
 	}
 	grpcServer := grpc.NewServer(opts...)
 
 	cmi.RegisterIdentityServer(grpcServer, &server.Identity{})
 	cmi.RegisterLabelServiceServer(grpcServer, &server.Label{})
 	cmi.RegisterCollectorServer(grpcServer, &server.Collector{})
+	healthpb.RegisterHealthServer(grpcServer, health.GetServer())
+	if cmiConfig.GetEnableReflection() {
+		log.Infoln("grpc server reflection is enabled")
+		reflection.Register(grpcServer)
+	}
 
-	if err := utils.CleanupSocketFile(address); err != nil {
-		return fmt.Errorf("cleanup unix socket failed, error: %v", err)
+	if network == "unix" {
+		if err := utils.CleanupSocketFile(target); err != nil {
+			return fmt.Errorf("cleanup unix socket failed, error: %v", err)
+		}
 	}
 
-	lis, err := net.Listen("unix", address)
+	lis, err := net.Listen(network, target)
 	if err != nil {
-		return fmt.Errorf("listen unix socket failed, error: %v", err)
+		return fmt.Errorf("listen %s address failed, error: %v", network, err)
 	}
 
 	signalChan := make(chan os.Signal, 1)
@@ -127,6 +152,7 @@ func StartGrpcServer(address string) error {
 	}()
 
 	defer func() {
+		health.GetServer().Shutdown()
 		grpcServer.GracefulStop()
 	}()
 
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2018-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2018-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -17,14 +17,18 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/huawei/csm/v2/config/consts"
+	tlsConfig "github.com/huawei/csm/v2/config/tls"
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/utils/log"
 	"github.com/huawei/csm/v2/utils/version"
@@ -42,6 +46,7 @@ const (
 	versionCmName       = "huawei-csm-version"
 
 	healthz = "/healthz"
+	readyz  = "/readyz"
 )
 
 // Command line flags
@@ -81,7 +86,13 @@ func main() {
 			return
 		}
 
-		clientSet, err := cmi.GetClientSet(cmiAddress)
+		err = tlsConfig.Option.ValidateConfig()
+		if err != nil {
+			log.Errorf("validate tls config failed: [%v]", err)
+			return
+		}
+
+		clientSet, err := cmi.GetClientSetWithTLS(cmiAddress, tlsConfig.GetTLSOption())
 		if err != nil {
 			log.Errorf("get cmi client set failed: [%v]", err)
 			return
@@ -92,6 +103,7 @@ func main() {
 
 		hp := healthProbe{client: clientSet}
 		mux.HandleFunc(healthz, hp.probe)
+		mux.HandleFunc(readyz, hp.probeBackends)
 
 		addr := fmt.Sprintf("%s:%s", ipAddress, healthzPort)
 		log.Infof("serveMux listening at [%s]", addr)
@@ -124,12 +136,58 @@ func (hp *healthProbe) probe(w http.ResponseWriter, req *http.Request) {
 	log.AddContext(ctx).Infoln("probe cmi service succeeded")
 }
 
+// probeBackends the cmi service is ready when there is no backend or at least one of the backends is logged in
+func (hp *healthProbe) probeBackends(w http.ResponseWriter, req *http.Request) {
+	ctx, cancel := context.WithTimeout(req.Context(), probeTimeout)
+	defer cancel()
+	log.AddContext(ctx).Infoln("start to probe cmi backends")
+
+	resp, err := hp.client.IdentityClient.ProbeBackends(ctx, &cmi.ProbeBackendsRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		log.AddContext(ctx).Infoln("cmi service does not support probe backends, fall back to probe")
+		hp.probe(w, req)
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		log.AddContext(ctx).Errorf("probe cmi backends failed: [%v]", err)
+		return
+	}
+
+	ready := len(resp.GetBackends()) == 0
+	for _, backend := range resp.GetBackends() {
+		if backend.GetLoggedIn() {
+			ready = true
+			continue
+		}
+		log.AddContext(ctx).Warningf("backend [%s] is not logged in, last error: [%s]",
+			backend.GetBackendName(), backend.GetLastError())
+	}
+
+	body, err := json.Marshal(resp.GetBackends())
+	if err != nil {
+		log.AddContext(ctx).Warningf("marshal backend states failed: [%v]", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		log.AddContext(ctx).Errorln("none of the cmi backends is logged in")
+	} else {
+		w.WriteHeader(http.StatusOK)
+		log.AddContext(ctx).Infoln("probe cmi backends succeeded")
+	}
+	if _, err = w.Write(body); err != nil {
+		log.AddContext(ctx).Warningf("write backend states failed: [%v]", err)
+	}
+}
+
 func parseFlags() {
 	livenessprobe.Flags().AddGoFlagSet(flag.CommandLine)
 	livenessprobe.Flags().DurationVar(&probeTimeout, "probe-timeout", defaultProbeTimeout,
 		"Probe timeout in seconds.")
 	livenessprobe.Flags().StringVar(&cmiAddress, "cmi-address", defaultCmiAddress,
-		"Address of the CMI driver socket.")
+		"Address of the CMI driver, unix socket path or tcp://host:port.")
 	livenessprobe.Flags().StringVar(&ipAddress, "ip-address", defaultIpAddress,
 		"The listening ip address in the container.")
 	livenessprobe.Flags().StringVar(&healthzPort, "healthz-port", defaultHealthzPort,
@@ -138,4 +196,5 @@ func parseFlags() {
 		"The log file name of the liveness probe")
 	livenessprobe.Flags().StringVar(&namespace, consts.CSMNamespace, defaultNamespace,
 		"Namespace of the csm")
+	tlsConfig.Option.AddFlags(livenessprobe.Flags())
 }
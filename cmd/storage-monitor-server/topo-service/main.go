@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -17,10 +17,13 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"syscall"
+	"time"
 
 	sbcInformers "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/informers/externalversions"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	k8sInformers "k8s.io/client-go/informers"
@@ -31,6 +34,7 @@ import (
 	"github.com/huawei/csm/v2/config/common"
 	leaderElectionConfig "github.com/huawei/csm/v2/config/leaderelection"
 	logConfig "github.com/huawei/csm/v2/config/log"
+	tlsConfig "github.com/huawei/csm/v2/config/tls"
 	controllerConfig "github.com/huawei/csm/v2/config/topology"
 	leaderElection "github.com/huawei/csm/v2/controller/leaderelection"
 	"github.com/huawei/csm/v2/controller/resourcetopology"
@@ -46,6 +50,12 @@ const (
 	leaderLockObjectName = "resource-topology"
 	namespaceEnv         = "NAMESPACE"
 	versionCmName        = "huawei-csm-version"
+	metricsPath          = "/metrics"
+
+	metricsReadHeaderTimeout = 5 * time.Second
+	metricsReadTimeout       = 10 * time.Second
+	metricsWriteTimeout      = 30 * time.Second
+	metricsIdleTimeout       = 60 * time.Second
 )
 
 var topoService = &cobra.Command{
@@ -55,7 +65,8 @@ var topoService = &cobra.Command{
 
 func main() {
 	manager := config.NewOptionManager(topoService.Flags(),
-		logConfig.Option, controllerConfig.Option, leaderElectionConfig.Option, clientConfig.Option, common.Option)
+		logConfig.Option, controllerConfig.Option, leaderElectionConfig.Option, clientConfig.Option, common.Option,
+		tlsConfig.Option)
 	manager.AddFlags()
 
 	topoService.Run = func(cmd *cobra.Command, args []string) {
@@ -89,6 +100,7 @@ func main() {
 		signalChan := make(chan os.Signal, 1)
 		defer close(signalChan)
 
+		startMetricsServer(ctx)
 		startController(ctx, clientsSet, signalChan)
 
 		err = waitTopoServiceStop(ctx, signalChan)
@@ -113,6 +125,30 @@ func startController(ctx context.Context, clientSets *utils.ClientsSet, ch chan
 	}
 }
 
+func startMetricsServer(ctx context.Context) {
+	address := controllerConfig.GetMetricsAddress()
+	if address == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.Handler())
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+		ReadTimeout:       metricsReadTimeout,
+		WriteTimeout:      metricsWriteTimeout,
+		IdleTimeout:       metricsIdleTimeout,
+	}
+	go func() {
+		log.AddContext(ctx).Infof("start metrics server on [%s]", address)
+		if err := server.ListenAndServe(); err != nil {
+			log.AddContext(ctx).Errorf("metrics server stopped, error: [%v]", err)
+		}
+	}()
+}
+
 func waitTopoServiceStop(ctx context.Context, signalChan chan os.Signal) error {
 	// Stop the main when stop signals are received
 	utils.WaitSignal(ctx, signalChan)
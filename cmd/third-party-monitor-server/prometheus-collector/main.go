@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -28,6 +28,7 @@ import (
 	"github.com/huawei/csm/v2/config/common"
 	exporterConfig "github.com/huawei/csm/v2/config/exporter"
 	logConfig "github.com/huawei/csm/v2/config/log"
+	tlsConfig "github.com/huawei/csm/v2/config/tls"
 	clientSet "github.com/huawei/csm/v2/server/prometheus-exporter/clientset"
 	exporterHandler "github.com/huawei/csm/v2/server/prometheus-exporter/exporterhandler"
 	"github.com/huawei/csm/v2/utils/log"
@@ -52,7 +53,7 @@ var prometheusExporter = &cobra.Command{
 
 func main() {
 	manager := config.NewOptionManager(prometheusExporter.Flags(), logConfig.Option, clientConfig.Option,
-		exporterConfig.Option, common.Option)
+		exporterConfig.Option, common.Option, tlsConfig.Option)
 	manager.AddFlags()
 
 	prometheusExporter.Run = func(cmd *cobra.Command, args []string) {
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -18,6 +18,8 @@
 package cmi
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -28,6 +30,7 @@ const (
 	defaultProviderOptionName = "providerOptionName"
 	defaultCmiAddress         = "/cmi/cmi.sock"
 	defaultNamespace          = "huawei-csi"
+	defaultNameMappingTTL     = 5 * time.Minute
 )
 
 // Option contains provider option args
@@ -39,6 +42,8 @@ type providerOption struct {
 	providerName         string
 	cmiAddress           string
 	backendNamespace     string
+	enableReflection     bool
+	nameMappingTTL       time.Duration
 }
 
 // GetName return option name
@@ -49,10 +54,13 @@ func (p *providerOption) GetName() string {
 // AddFlags add flags
 func (p *providerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&p.providerName, "cmi-name", defaultProviderName, "Name of provider")
-	fs.StringVar(&p.cmiAddress, "cmi-address", defaultCmiAddress, "Path to cmi socket")
+	fs.StringVar(&p.cmiAddress, "cmi-address", defaultCmiAddress, "Address of cmi, unix socket path or tcp://host:port")
 	fs.IntVar(&p.queryStoragePageSize, "page-size", defaultQueryPageSize, "Max size of query storage")
 	fs.StringVar(&p.backendNamespace, "backend-namespace", defaultNamespace, "Namespace of backend")
 	fs.IntVar(&p.clientMaxThreads, "client-max-threads", defaultClientMaxThreads, "Max client threads")
+	fs.BoolVar(&p.enableReflection, "enable-reflection", false, "Enable grpc server reflection")
+	fs.DurationVar(&p.nameMappingTTL, "name-mapping-ttl", defaultNameMappingTTL,
+		"Time to live of the cached object name mapping used by performance collect, 0 means no cache")
 }
 
 // ValidateConfig validate config
@@ -66,6 +74,7 @@ func NewProviderOption() *providerOption {
 		queryStoragePageSize: defaultQueryPageSize,
 		providerName:         defaultProviderName,
 		cmiAddress:           defaultCmiAddress,
+		nameMappingTTL:       defaultNameMappingTTL,
 	}
 }
 
@@ -93,3 +102,13 @@ func GetQueryStoragePageSize() int {
 func GetClientMaxThreads() int {
 	return Option.clientMaxThreads
 }
+
+// GetEnableReflection get whether to enable grpc server reflection
+func GetEnableReflection() bool {
+	return Option.enableReflection
+}
+
+// GetNameMappingTTL get the time to live of the cached object name mapping
+func GetNameMappingTTL() time.Duration {
+	return Option.nameMappingTTL
+}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2024. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -47,6 +47,10 @@ const (
 	ResyncPeriod = "resync-period"
 	// CmiAddress key name of cmi endpoint address
 	CmiAddress = "cmi-address"
+	// DriftReconcilePeriod key name of the interval of label drift reconciliation
+	DriftReconcilePeriod = "drift-reconcile-period"
+	// MetricsAddress key name of the address to expose the controller metrics
+	MetricsAddress = "metrics-address"
 )
 
 const (
@@ -75,6 +79,17 @@ const (
 	CSMNamespace = "csm-namespace"
 )
 
+const (
+	// CmiTLSCertFile key name of the certificate file used by cmi mutual tls
+	CmiTLSCertFile = "cmi-tls-cert-file"
+	// CmiTLSKeyFile key name of the private key file used by cmi mutual tls
+	CmiTLSKeyFile = "cmi-tls-key-file"
+	// CmiTLSCAFile key name of the ca file used by cmi mutual tls to verify the peer certificate
+	CmiTLSCAFile = "cmi-tls-ca-file"
+	// CmiTLSServerName key name of the server name used by cmi client to verify the server certificate
+	CmiTLSServerName = "cmi-tls-server-name"
+)
+
 const (
 	// EnableLeaderElection key name of controller leader election switch config
 	EnableLeaderElection = "enable-leader-election"
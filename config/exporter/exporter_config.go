@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -59,7 +59,7 @@ func (o *option) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.exporterPort, confConsts.ExporterPort, defaultExporterPort,
 		"The exporter port in the container.")
 	fs.StringVar(&o.storageGRPCSock, confConsts.StorageGRPCSock, defaultStorageGRPCSock,
-		"The storage grpc client sock file name.")
+		"The address of cmi, unix socket path or tcp://host:port.")
 	fs.StringVar(&o.storageBackendNamespace, confConsts.StorageBackendNamespace, defaultStorageBackendNamespace,
 		"The storage backend namespace name.")
 	fs.StringVar(&o.csiDriverName, confConsts.CSIDriverName, defaultCSIDriverName,
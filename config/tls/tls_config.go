@@ -0,0 +1,82 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package tls is used to init the mutual tls configurations and flags of cmi grpc api
+package tls
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+
+	"github.com/huawei/csm/v2/config/consts"
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+)
+
+const (
+	tlsOptionName = "TLSOption"
+)
+
+// Option is a tls option instance for manager init
+var Option = &option{}
+
+type option struct {
+	certFile   string
+	keyFile    string
+	caFile     string
+	serverName string
+}
+
+// GetName return name string of tls option
+func (o *option) GetName() string {
+	return tlsOptionName
+}
+
+// AddFlags is to add flags for tls configurations
+func (o *option) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.certFile, consts.CmiTLSCertFile, "",
+		"The certificate file used by cmi mutual tls, required when cmi address is tcp.")
+	fs.StringVar(&o.keyFile, consts.CmiTLSKeyFile, "",
+		"The private key file used by cmi mutual tls, required when cmi address is tcp.")
+	fs.StringVar(&o.caFile, consts.CmiTLSCAFile, "",
+		"The ca file used by cmi mutual tls to verify the peer certificate, required when cmi address is tcp.")
+	fs.StringVar(&o.serverName, consts.CmiTLSServerName, "",
+		"The server name used to verify the cmi server certificate, default is the host of cmi address.")
+}
+
+// ValidateConfig is to validate input tls configurations
+func (o *option) ValidateConfig() error {
+	if o.certFile == "" && o.keyFile == "" && o.caFile == "" {
+		return nil
+	}
+
+	if o.certFile == "" || o.keyFile == "" || o.caFile == "" {
+		return errors.New("cmi-tls-cert-file, cmi-tls-key-file and cmi-tls-ca-file must be configured together")
+	}
+	return nil
+}
+
+// GetTLSOption returns the tls option of cmi grpc api, nil means tls is not configured
+func GetTLSOption() *cmi.TLSOption {
+	if Option.certFile == "" {
+		return nil
+	}
+
+	return &cmi.TLSOption{
+		CertFile:   Option.certFile,
+		KeyFile:    Option.keyFile,
+		CAFile:     Option.caFile,
+		ServerName: Option.serverName,
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package tls is used to init the mutual tls configurations and flags of cmi grpc api
+package tls
+
+import (
+	"testing"
+)
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		option  *option
+		wantErr bool
+	}{
+		{name: "tls is not configured", option: &option{}, wantErr: false},
+		{name: "all files are configured",
+			option: &option{certFile: "tls.crt", keyFile: "tls.key", caFile: "ca.crt"}, wantErr: false},
+		{name: "ca file is missing", option: &option{certFile: "tls.crt", keyFile: "tls.key"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			err := tt.option.ValidateConfig()
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -40,6 +40,9 @@ const (
 	defaultCSIDriverName     = "csi.huawei.com"
 	defaultCSINamespace      = "huawei-csi"
 	minResyncPeriod          = 5 * time.Minute
+
+	defaultDriftReconcilePeriod = 30 * time.Minute
+	minDriftReconcilePeriod     = 1 * time.Minute
 )
 
 var (
@@ -61,6 +64,9 @@ type option struct {
 	controllerWorkers int
 	csiDriverName     string
 	backendNamespace  string
+
+	driftReconcilePeriod time.Duration
+	metricsAddress       string
 }
 
 // GetName return the name string of the ControllerOption
@@ -92,10 +98,14 @@ func (o *option) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&o.resyncPeriod, confConsts.ResyncPeriod, defaultResyncPeriod,
 		"The reSync interval of the controller.")
 	fs.StringVar(&o.cmiAddress, confConsts.CmiAddress, defaultCmiAddress,
-		"The socket address of container monitoring interface.")
+		"The address of container monitoring interface, unix socket path or tcp://host:port.")
 	fs.StringVar(&o.csiDriverName, confConsts.CSIDriverName, defaultCSIDriverName,
 		"The CSI driver name.")
 	fs.StringVar(&o.backendNamespace, "backend-namespace", defaultCSINamespace, "Namespace of backend.")
+	fs.DurationVar(&o.driftReconcilePeriod, confConsts.DriftReconcilePeriod, defaultDriftReconcilePeriod,
+		"The interval of reconciling labels between resourceTopology status and storage. 0 means disabled.")
+	fs.StringVar(&o.metricsAddress, confConsts.MetricsAddress, "",
+		"The address to expose the controller metrics, e.g. :9810. Empty means disabled.")
 }
 
 // ValidateConfig is to validate input resource topology controller configurations
@@ -129,6 +139,11 @@ func (o *option) ValidateConfig() error {
 			o.resyncPeriod, minResyncPeriod)
 	}
 
+	if o.driftReconcilePeriod != 0 && o.driftReconcilePeriod < minDriftReconcilePeriod {
+		return fmt.Errorf("drift reconcile period [%s] is less than min drift reconcile period [%s]",
+			o.driftReconcilePeriod, minDriftReconcilePeriod)
+	}
+
 	return nil
 }
 
@@ -191,3 +206,13 @@ func GetCSIDriverName() string {
 func GetBackendNamespace() string {
 	return Option.backendNamespace
 }
+
+// GetDriftReconcilePeriod returns the interval of label drift reconciliation
+func GetDriftReconcilePeriod() time.Duration {
+	return Option.driftReconcilePeriod
+}
+
+// GetMetricsAddress returns the address to expose the controller metrics
+func GetMetricsAddress() string {
+	return Option.metricsAddress
+}
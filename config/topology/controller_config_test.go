@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func Test_option_ValidateConfig_Success(t *testing.T) {
@@ -73,3 +74,24 @@ func Test_option_ValidateConfig_SupportResourcesLengthLessThanTwo_Failed(t *test
 			"want [%v], got [%v]", want, got)
 	}
 }
+
+func Test_option_ValidateConfig_DriftReconcilePeriodTooShort_Failed(t *testing.T) {
+	// arrange
+	o := &option{
+		controllerWorkers:    1,
+		supportResources:     []string{"Pod", "PersistentVolume"},
+		resyncPeriod:         defaultResyncPeriod,
+		driftReconcilePeriod: time.Second,
+	}
+	want := fmt.Errorf("drift reconcile period [%s] is less than min drift reconcile period [%s]",
+		o.driftReconcilePeriod, minDriftReconcilePeriod)
+
+	// act
+	got := o.ValidateConfig()
+
+	// assert
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Test_option_ValidateConfig_DriftReconcilePeriodTooShort_Failed: "+
+			"want [%v], got [%v]", want, got)
+	}
+}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -268,6 +268,10 @@ func (ctrl *Controller) Run(ctx context.Context, workers int, stopCh <-chan stru
 		go wait.Until(func() { ctrl.runPersistentVolumeWorker(ctx) }, time.Second, stopCh)
 		go wait.Until(func() { ctrl.runPodWorker(ctx) }, time.Second, stopCh)
 	}
+	if period := controllerConfig.GetDriftReconcilePeriod(); period > 0 {
+		log.AddContext(ctx).Infof("starting label drift reconciler, period: [%s]", period)
+		go wait.Until(func() { ctrl.reconcileLabelDrift(ctx) }, period, stopCh)
+	}
 	log.AddContext(ctx).Infoln("started workers")
 	defer log.AddContext(ctx).Infoln("shutting down workers")
 	if stopCh != nil {
@@ -366,9 +370,9 @@ func (ctrl *Controller) handle(ctx context.Context, obj interface{},
 	}
 
 	if err = function(ctx, key); err != nil {
-		if status.Code(err) == codes.InvalidArgument {
+		if isUnrecoverableError(err) {
 			queue.Forget(obj)
-			log.AddContext(ctx).Warningf("invalidArgument, handle key [%s] failed, error is [%v]", key, err)
+			log.AddContext(ctx).Warningf("%s, handle key [%s] failed, error is [%v]", status.Code(err), key, err)
 			return nil
 		}
 		queue.AddRateLimited(key)
@@ -380,6 +384,12 @@ func (ctrl *Controller) handle(ctx context.Context, obj interface{},
 	return nil
 }
 
+// isUnrecoverableError the work item will never succeed by retrying when the request is invalid,
+// the other errors will be retried with rate limit, e.g. the resource is not found because it is not created yet
+func isUnrecoverableError(err error) bool {
+	return status.Code(err) == codes.InvalidArgument
+}
+
 func (ctrl *Controller) handleResourceTopologyWork(ctx context.Context, key string) error {
 	_, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
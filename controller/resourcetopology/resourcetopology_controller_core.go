@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -17,7 +17,10 @@ package resourcetopology
 
 import (
 	"context"
+	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	apiXuanwuV1 "github.com/huawei/csm/v2/client/apis/xuanwu/v1"
@@ -38,6 +41,75 @@ func (ctrl *Controller) UpdateResourceTopologiesStatus(ctx context.Context,
 
 // CmiCreateLabel create label by cmi grpc connection
 func (ctrl *Controller) CmiCreateLabel(ctx context.Context, params *cmi.Params) error {
+	request := buildCreateLabelRequest(params)
+	_, err := ctrl.cmiClient.LabelClient.CreateLabel(ctx, request)
+	if err != nil {
+		log.AddContext(ctx).Errorf("create label [%v] on storage failed: [%v]", params, err)
+		return err
+	}
+
+	return err
+}
+
+// CmiDeleteLabel delete label by cmi grpc connection
+func (ctrl *Controller) CmiDeleteLabel(ctx context.Context, params *cmi.Params) error {
+	request := buildDeleteLabelRequest(params)
+	_, err := ctrl.cmiClient.LabelClient.DeleteLabel(ctx, request)
+	if err != nil {
+		log.AddContext(ctx).Errorf("delete label [%v] on storage failed: [%v]", params, err)
+		return err
+	}
+
+	return err
+}
+
+// CmiCreateLabels create labels in batch by cmi grpc connection
+// The returned errors are in the same order as paramsList, nil means the label is created successfully.
+// If the cmi does not support batch operations, the labels will be created one by one.
+func (ctrl *Controller) CmiCreateLabels(ctx context.Context, backendName string,
+	paramsList []*cmi.Params) ([]error, error) {
+	request := &grpc.CreateLabelsRequest{BackendName: backendName}
+	for _, params := range paramsList {
+		request.Labels = append(request.Labels, buildCreateLabelRequest(params))
+	}
+
+	response, err := ctrl.cmiClient.LabelClient.CreateLabels(ctx, request)
+	if status.Code(err) == codes.Unimplemented {
+		log.AddContext(ctx).Warningln("cmi does not support creating labels in batch, create them one by one")
+		return callLabelOneByOne(ctx, paramsList, ctrl.CmiCreateLabel), nil
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("create labels of backend [%s] on storage failed: [%v]", backendName, err)
+		return nil, err
+	}
+
+	return convertLabelResults(response.GetResults(), len(paramsList))
+}
+
+// CmiDeleteLabels delete labels in batch by cmi grpc connection
+// The returned errors are in the same order as paramsList, nil means the label is deleted successfully.
+// If the cmi does not support batch operations, the labels will be deleted one by one.
+func (ctrl *Controller) CmiDeleteLabels(ctx context.Context, backendName string,
+	paramsList []*cmi.Params) ([]error, error) {
+	request := &grpc.DeleteLabelsRequest{BackendName: backendName}
+	for _, params := range paramsList {
+		request.Labels = append(request.Labels, buildDeleteLabelRequest(params))
+	}
+
+	response, err := ctrl.cmiClient.LabelClient.DeleteLabels(ctx, request)
+	if status.Code(err) == codes.Unimplemented {
+		log.AddContext(ctx).Warningln("cmi does not support deleting labels in batch, delete them one by one")
+		return callLabelOneByOne(ctx, paramsList, ctrl.CmiDeleteLabel), nil
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("delete labels of backend [%s] on storage failed: [%v]", backendName, err)
+		return nil, err
+	}
+
+	return convertLabelResults(response.GetResults(), len(paramsList))
+}
+
+func buildCreateLabelRequest(params *cmi.Params) *grpc.CreateLabelRequest {
 	request := &grpc.CreateLabelRequest{
 		VolumeId:  params.VolumeId(),
 		LabelName: params.LabelName(),
@@ -51,17 +123,10 @@ func (ctrl *Controller) CmiCreateLabel(ctx context.Context, params *cmi.Params)
 	if params.Namespace() != "" {
 		request.Namespace = params.Namespace()
 	}
-	_, err := ctrl.cmiClient.LabelClient.CreateLabel(ctx, request)
-	if err != nil {
-		log.AddContext(ctx).Errorf("create label [%v] on storage failed: [%v]", params, err)
-		return err
-	}
-
-	return err
+	return request
 }
 
-// CmiDeleteLabel delete label by cmi grpc connection
-func (ctrl *Controller) CmiDeleteLabel(ctx context.Context, params *cmi.Params) error {
+func buildDeleteLabelRequest(params *cmi.Params) *grpc.DeleteLabelRequest {
 	request := &grpc.DeleteLabelRequest{
 		VolumeId:  params.VolumeId(),
 		LabelName: params.LabelName(),
@@ -71,12 +136,34 @@ func (ctrl *Controller) CmiDeleteLabel(ctx context.Context, params *cmi.Params)
 	if params.Namespace() != "" {
 		request.Namespace = params.Namespace()
 	}
+	return request
+}
 
-	_, err := ctrl.cmiClient.LabelClient.DeleteLabel(ctx, request)
-	if err != nil {
-		log.AddContext(ctx).Errorf("delete label [%v] on storage failed: [%v]", params, err)
-		return err
+func callLabelOneByOne(ctx context.Context, paramsList []*cmi.Params,
+	call func(context.Context, *cmi.Params) error) []error {
+	errs := make([]error, len(paramsList))
+	for i, params := range paramsList {
+		errs[i] = call(ctx, params)
 	}
+	return errs
+}
 
-	return err
+func convertLabelResults(results []*grpc.LabelResult, want int) ([]error, error) {
+	if len(results) != want {
+		return nil, fmt.Errorf("the number of label results is [%d], but want [%d]", len(results), want)
+	}
+
+	errs := make([]error, len(results))
+	for i, result := range results {
+		if result.GetSuccess().GetValue() {
+			continue
+		}
+		// the error code is not set by the cmi which does not support it
+		code := codes.Code(result.GetErrorCode())
+		if code == codes.OK {
+			code = codes.Unknown
+		}
+		errs[i] = status.Error(code, result.GetErrorMessage())
+	}
+	return errs, nil
 }
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -20,9 +20,13 @@ import (
 	"reflect"
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	apiXuanwuV1 "github.com/huawei/csm/v2/client/apis/xuanwu/v1"
+	grpc "github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	fakeXuanwuClient "github.com/huawei/csm/v2/pkg/client/clientset/versioned/fake"
 )
 
@@ -75,3 +79,52 @@ func TestResourceTopologyController_UpdateResourceTopologiesStatus_Failed(t *tes
 			"wantErr: [%v], gotErr: [%v]", want, got)
 	}
 }
+
+func TestResourceTopologyController_convertLabelResults(t *testing.T) {
+	// arrange
+	results := []*grpc.LabelResult{
+		{Success: wrapperspb.Bool(true)},
+		{Success: wrapperspb.Bool(false), ErrorMessage: "volume id is blank", ErrorCode: int32(codes.InvalidArgument)},
+		{Success: wrapperspb.Bool(false), ErrorMessage: "error without code"},
+	}
+	wantCodes := []codes.Code{codes.OK, codes.InvalidArgument, codes.Unknown}
+
+	// act
+	errs, err := convertLabelResults(results, len(results))
+
+	// assert
+	if err != nil {
+		t.Errorf("TestResourceTopologyController_convertLabelResults failed: want no error, got: [%v]", err)
+		return
+	}
+	for i, wantCode := range wantCodes {
+		if status.Code(errs[i]) != wantCode {
+			t.Errorf("TestResourceTopologyController_convertLabelResults failed: want code [%v], got: [%v]",
+				wantCode, status.Code(errs[i]))
+		}
+	}
+	_, firstErr := filterSucceededTags(make([]apiXuanwuV1.Tag, len(errs)), errs)
+	if !isUnrecoverableError(firstErr) {
+		t.Errorf("TestResourceTopologyController_convertLabelResults failed: want unrecoverable error, got: [%v]",
+			firstErr)
+	}
+}
+
+func TestResourceTopologyController_isUnrecoverableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "invalid argument", err: status.Error(codes.InvalidArgument, "volume id is blank"), want: true},
+		{name: "not found", err: status.Error(codes.NotFound, "volume not found"), want: false},
+		{name: "unavailable", err: status.Error(codes.Unavailable, "storage is busy"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isUnrecoverableError(tt.err); got != tt.want {
+				t.Errorf("isUnrecoverableError() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package resourcetopology defines to reconcile action of resources topologies
+package resourcetopology
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	apiXuanwuV1 "github.com/huawei/csm/v2/client/apis/xuanwu/v1"
+	"github.com/huawei/csm/v2/controller/utils/consts"
+	grpc "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	labelDriftReason         = "LabelDrift"
+	labelDriftRepairedReason = "LabelDriftRepaired"
+
+	foundLabelDriftMessage         = "Found labels drifted on storage"
+	failedRepairLabelDriftMessage  = "Failed to repair labels drifted on storage"
+	successRepairLabelDriftMessage = "Success to repair labels drifted on storage"
+)
+
+// reconcileLabelDrift compares the labels on storage with the tags recorded in status of all resourceTopologies,
+// the missing labels will be created again and the orphaned labels will be deleted
+func (ctrl *Controller) reconcileLabelDrift(ctx context.Context) {
+	ctx, err := log.SetRequestInfo(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("set request info failed, error: [%v]", err)
+		return
+	}
+
+	log.AddContext(ctx).Infoln("start to reconcile label drift")
+	defer log.AddContext(ctx).Infoln("finished reconcile label drift")
+
+	resourceTopologies, err := ctrl.topologyInformer.Lister().List(labels.Everything())
+	if err != nil {
+		log.AddContext(ctx).Errorf("list resourceTopologies from the indexer cache failed: [%v]", err)
+		return
+	}
+
+	// the backends which can not be connected or logged in, their other resourceTopologies will be skipped
+	unavailableBackends := make(map[string]struct{})
+	for _, resourceTopology := range resourceTopologies {
+		if !needCheckLabelDrift(resourceTopology) {
+			continue
+		}
+
+		backendName := getBackendNameByVolumeHandle(resourceTopology.Spec.VolumeHandle)
+		if _, ok := unavailableBackends[backendName]; ok {
+			continue
+		}
+
+		err = ctrl.reconcileResourceTopologyDrift(ctx, resourceTopology)
+		if status.Code(err) == codes.Unimplemented {
+			log.AddContext(ctx).Warningln("cmi does not support listing labels, skip reconciling label drift")
+			return
+		}
+		if code := status.Code(err); code == codes.Unavailable || code == codes.Unauthenticated {
+			log.AddContext(ctx).Warningf("backend [%s] is %s, skip reconciling its label drift: [%v]",
+				backendName, code, err)
+			unavailableBackends[backendName] = struct{}{}
+			continue
+		}
+		if err != nil {
+			log.AddContext(ctx).Errorf("reconcile label drift of resourceTopology [%s] failed: [%v]",
+				resourceTopology.Name, err)
+		}
+	}
+}
+
+func (ctrl *Controller) reconcileResourceTopologyDrift(ctx context.Context,
+	resourceTopology *apiXuanwuV1.ResourceTopology) error {
+	backendName := getBackendNameByVolumeHandle(resourceTopology.Spec.VolumeHandle)
+	response, err := ctrl.cmiClient.LabelClient.ListLabels(ctx, &grpc.ListLabelsRequest{
+		BackendName: backendName,
+		VolumeId:    resourceTopology.Spec.VolumeHandle,
+	})
+	if err != nil {
+		return err
+	}
+
+	missing, orphaned := getDriftedTags(resourceTopology.Status.Tags, response.GetLabels(),
+		os.Getenv("CLUSTER_NAME"))
+	if len(missing) == 0 && len(orphaned) == 0 {
+		return nil
+	}
+
+	log.AddContext(ctx).Warningf("labels of resourceTopology [%s] drifted, missing [%v], orphaned [%v]",
+		resourceTopology.Name, missing, orphaned)
+	labelDriftCounter.WithLabelValues(backendName, driftTypeMissing).Add(float64(len(missing)))
+	labelDriftCounter.WithLabelValues(backendName, driftTypeOrphaned).Add(float64(len(orphaned)))
+	ctrl.eventRecorder.Event(resourceTopology, coreV1.EventTypeWarning, labelDriftReason,
+		fmt.Sprintf("%s, missing %v, orphaned %v", foundLabelDriftMessage, missing, orphaned))
+
+	err = ctrl.repairLabelDrift(ctx, resourceTopology, missing, orphaned)
+	if err != nil {
+		ctrl.eventRecorder.Event(resourceTopology, coreV1.EventTypeWarning, labelDriftReason,
+			fmt.Sprintf("%s: %v", failedRepairLabelDriftMessage, err))
+		return err
+	}
+
+	ctrl.eventRecorder.Event(resourceTopology, coreV1.EventTypeNormal, labelDriftRepairedReason,
+		successRepairLabelDriftMessage)
+	return nil
+}
+
+// repairLabelDrift deletes the orphaned labels at first, then creates the missing labels
+func (ctrl *Controller) repairLabelDrift(ctx context.Context, resourceTopology *apiXuanwuV1.ResourceTopology,
+	missing, orphaned []apiXuanwuV1.Tag) error {
+	backendName := getBackendNameByVolumeHandle(resourceTopology.Spec.VolumeHandle)
+	if len(orphaned) != 0 {
+		errs, err := ctrl.CmiDeleteLabels(ctx, backendName, getCmiParamsList(resourceTopology, orphaned))
+		if err != nil {
+			return err
+		}
+		if _, err = filterSucceededTags(orphaned, errs); err != nil {
+			return err
+		}
+	}
+
+	if len(missing) != 0 {
+		errs, err := ctrl.CmiCreateLabels(ctx, backendName, getCmiParamsList(resourceTopology, missing))
+		if err != nil {
+			return err
+		}
+		if _, err = filterSucceededTags(missing, errs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// needCheckLabelDrift only the resourceTopology in normal status and without pending tags need to be checked,
+// otherwise the labels are being changed by the sync work
+func needCheckLabelDrift(resourceTopology *apiXuanwuV1.ResourceTopology) bool {
+	if !checkResourceTopologyName(resourceTopology.Name) || resourceTopology.DeletionTimestamp != nil {
+		return false
+	}
+
+	if resourceTopology.Status.Status != apiXuanwuV1.ResourceTopologyStatusNormal {
+		return false
+	}
+
+	addList, delList := getChangeList(resourceTopology)
+	return len(addList) == 0 && len(delList) == 0
+}
+
+// getDriftedTags returns the tags whose labels are missing on storage and the orphaned labels on storage,
+// the missing PersistentVolume tag is always in the first place, because it must be added before pods.
+// The PersistentVolume labels of other clusters are ignored.
+func getDriftedTags(statusTags []apiXuanwuV1.Tag, storageLabels []*grpc.Label,
+	clusterName string) ([]apiXuanwuV1.Tag, []apiXuanwuV1.Tag) {
+	expected := make(map[apiXuanwuV1.ResourceInfo]apiXuanwuV1.Tag)
+	for _, tag := range getPodAndPvTags(statusTags) {
+		expected[getDriftKey(tag.Kind, tag.Namespace, tag.Name)] = tag
+	}
+
+	var orphaned []apiXuanwuV1.Tag
+	for _, label := range storageLabels {
+		if label.GetKind() == consts.PersistentVolume && clusterName != "" &&
+			label.GetClusterName() != "" && label.GetClusterName() != clusterName {
+			continue
+		}
+
+		key := getDriftKey(label.GetKind(), label.GetNamespace(), label.GetLabelName())
+		if _, ok := expected[key]; ok {
+			delete(expected, key)
+			continue
+		}
+		orphaned = append(orphaned, apiXuanwuV1.Tag{ResourceInfo: key})
+	}
+
+	var missing []apiXuanwuV1.Tag
+	for _, tag := range expected {
+		missing = append(missing, tag)
+	}
+	sort.SliceStable(missing, func(i, j int) bool {
+		if missing[i].Kind != missing[j].Kind {
+			return missing[i].Kind == consts.PersistentVolume
+		}
+		return missing[i].Namespace+"/"+missing[i].Name < missing[j].Namespace+"/"+missing[j].Name
+	})
+	return missing, orphaned
+}
+
+func getDriftKey(kind, namespace, name string) apiXuanwuV1.ResourceInfo {
+	if kind == consts.PersistentVolume {
+		namespace = ""
+	}
+	return apiXuanwuV1.ResourceInfo{
+		TypeMeta:  metaV1.TypeMeta{Kind: kind, APIVersion: consts.KubernetesV1},
+		Namespace: namespace,
+		Name:      name,
+	}
+}
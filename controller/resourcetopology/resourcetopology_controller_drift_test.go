@@ -0,0 +1,56 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package resourcetopology
+package resourcetopology
+
+import (
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiXuanwuV1 "github.com/huawei/csm/v2/client/apis/xuanwu/v1"
+	grpc "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+)
+
+func TestResourceTopologyController_getDriftedTags(t *testing.T) {
+	// arrange
+	pvTag := apiXuanwuV1.Tag{ResourceInfo: apiXuanwuV1.ResourceInfo{
+		TypeMeta: metaV1.TypeMeta{Kind: "PersistentVolume", APIVersion: "v1"}, Name: "fakePv"}}
+	podTag := apiXuanwuV1.Tag{ResourceInfo: apiXuanwuV1.ResourceInfo{
+		TypeMeta: metaV1.TypeMeta{Kind: "Pod", APIVersion: "v1"}, Namespace: "fakeNs", Name: "fakePod1"}}
+	statusTags := []apiXuanwuV1.Tag{podTag, pvTag}
+	storageLabels := []*grpc.Label{
+		{Kind: "Pod", LabelName: "fakePod1", Namespace: "fakeNs"},
+		{Kind: "Pod", LabelName: "fakePod2", Namespace: "fakeNs"},
+		{Kind: "PersistentVolume", LabelName: "otherPv", ClusterName: "otherCluster"},
+	}
+	wantMissing := []apiXuanwuV1.Tag{pvTag}
+	wantOrphaned := []apiXuanwuV1.Tag{{ResourceInfo: apiXuanwuV1.ResourceInfo{
+		TypeMeta: metaV1.TypeMeta{Kind: "Pod", APIVersion: "v1"}, Namespace: "fakeNs", Name: "fakePod2"}}}
+
+	// act
+	missing, orphaned := getDriftedTags(statusTags, storageLabels, "fakeCluster")
+
+	// assert
+	if !reflect.DeepEqual(missing, wantMissing) {
+		t.Errorf("TestResourceTopologyController_getDriftedTags failed: want missing: [%v], got: [%v]",
+			wantMissing, missing)
+	}
+	if !reflect.DeepEqual(orphaned, wantOrphaned) {
+		t.Errorf("TestResourceTopologyController_getDriftedTags failed: want orphaned: [%v], got: [%v]",
+			wantOrphaned, orphaned)
+	}
+}
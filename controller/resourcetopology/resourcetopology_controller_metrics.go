@@ -0,0 +1,42 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package resourcetopology defines to reconcile action of resources topologies
+package resourcetopology
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "csm"
+	metricsSubsystem = "resource_topology"
+
+	driftTypeMissing  = "missing"
+	driftTypeOrphaned = "orphaned"
+)
+
+// labelDriftCounter counts the labels drifted between resourceTopology status and storage,
+// missing means the label is recorded in status but not on storage,
+// orphaned means the label is on storage but not recorded in status.
+var labelDriftCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: metricsNamespace,
+	Subsystem: metricsSubsystem,
+	Name:      "label_drift_total",
+	Help:      "The number of labels drifted between resourceTopology status and storage.",
+}, []string{"backend", "type"})
+
+func init() {
+	prometheus.MustRegister(labelDriftCounter)
+}
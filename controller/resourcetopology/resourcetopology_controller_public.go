@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2024. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -46,9 +46,10 @@ const (
 
 	resourceTopologyFinalizerBySelf = "resourcetopology.xuanwu.huawei.io/resourcetopology-protection"
 
-	rtPrefix          = "rt-"
-	updateRetryTimes  = 10
-	updateRetryPeriod = 100 * time.Millisecond
+	rtPrefix              = "rt-"
+	volumeHandleSeparator = "."
+	updateRetryTimes      = 10
+	updateRetryPeriod     = 100 * time.Millisecond
 
 	resourceRequeueInterval = 10 * time.Second
 )
@@ -182,6 +183,18 @@ func getCmiParams(resourceTopology *apiXuanwuV1.ResourceTopology, tag apiXuanwuV
 		SetClusterName(os.Getenv("CLUSTER_NAME"))
 }
 
+func getCmiParamsList(resourceTopology *apiXuanwuV1.ResourceTopology, tags []apiXuanwuV1.Tag) []*cmi.Params {
+	paramsList := make([]*cmi.Params, 0, len(tags))
+	for _, tag := range tags {
+		paramsList = append(paramsList, getCmiParams(resourceTopology, tag))
+	}
+	return paramsList
+}
+
+func getBackendNameByVolumeHandle(volumeHandle string) string {
+	return strings.SplitN(volumeHandle, volumeHandleSeparator, 2)[0]
+}
+
 func checkResourceTopologyName(rtName string) bool {
 	return strings.HasPrefix(rtName, rtPrefix)
 }
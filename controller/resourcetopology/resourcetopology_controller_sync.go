@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2024. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -174,16 +174,30 @@ func (ctrl *Controller) handleAddTags(ctx context.Context, resourceTopology *api
 		addList, resourceTopology.Name)
 
 	var err error
-	for _, tag := range addList {
-		log.AddContext(ctx).Infof("trying to add tag [%v]", tag)
-		err = ctrl.CmiCreateLabel(ctx, getCmiParams(resourceTopology, tag))
-		if err != nil {
+	var addedList []apiXuanwuV1.Tag
+	if len(addList) > 1 {
+		addedList, err = ctrl.addTagsInBatch(ctx, resourceTopology, addList)
+		if len(addedList) == 0 {
 			return nil, err
 		}
+	} else {
+		for _, tag := range addList {
+			log.AddContext(ctx).Infof("trying to add tag [%v]", tag)
+			err = ctrl.CmiCreateLabel(ctx, getCmiParams(resourceTopology, tag))
+			if err != nil {
+				return nil, err
+			}
+		}
+		addedList = addList
+	}
+
+	statusTags := append(resourceTopology.Status.Tags, addedList...)
+	resourceTopology, updateErr := ctrl.updateResourceTopologyStatusTagsWithRetry(ctx, resourceTopology, statusTags)
+	if updateErr != nil {
+		return nil, updateErr
 	}
 
-	statusTags := append(resourceTopology.Status.Tags, addList...)
-	resourceTopology, err = ctrl.updateResourceTopologyStatusTagsWithRetry(ctx, resourceTopology, statusTags)
+	// some tags failed in batch, the succeeded tags are recorded and the failed tags will be added again
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +205,20 @@ func (ctrl *Controller) handleAddTags(ctx context.Context, resourceTopology *api
 	return resourceTopology, nil
 }
 
+// addTagsInBatch create labels of all tags by one batch request,
+// returns the tags whose labels are created successfully and the error of the first failed tag
+func (ctrl *Controller) addTagsInBatch(ctx context.Context, resourceTopology *apiXuanwuV1.ResourceTopology,
+	addList []apiXuanwuV1.Tag) ([]apiXuanwuV1.Tag, error) {
+	log.AddContext(ctx).Infof("trying to add tags [%v] in batch", addList)
+	errs, err := ctrl.CmiCreateLabels(ctx, getBackendNameByVolumeHandle(resourceTopology.Spec.VolumeHandle),
+		getCmiParamsList(resourceTopology, addList))
+	if err != nil {
+		return nil, err
+	}
+
+	return filterSucceededTags(addList, errs)
+}
+
 func (ctrl *Controller) rollBack(ctx context.Context,
 	resourceTopology *apiXuanwuV1.ResourceTopology, tag apiXuanwuV1.Tag) {
 	log.AddContext(ctx).Infof("rolling back resource topology tag [%v]", tag)
@@ -268,16 +296,32 @@ func (ctrl *Controller) handleDeleteTags(ctx context.Context,
 	statusTags := resourceTopology.Status.Tags
 
 	var err error
-	for _, tag := range delList {
-		log.AddContext(ctx).Infof("trying to delete tag [%v]", tag)
-		err = ctrl.CmiDeleteLabel(ctx, getCmiParams(resourceTopology, tag))
-		if err != nil {
+	if len(delList) > 1 {
+		var deletedList []apiXuanwuV1.Tag
+		deletedList, err = ctrl.deleteTagsInBatch(ctx, resourceTopology, delList)
+		if len(deletedList) == 0 {
 			return nil, err
 		}
-		statusTags = deleteTag(statusTags, tag)
+		for _, tag := range deletedList {
+			statusTags = deleteTag(statusTags, tag)
+		}
+	} else {
+		for _, tag := range delList {
+			log.AddContext(ctx).Infof("trying to delete tag [%v]", tag)
+			err = ctrl.CmiDeleteLabel(ctx, getCmiParams(resourceTopology, tag))
+			if err != nil {
+				return nil, err
+			}
+			statusTags = deleteTag(statusTags, tag)
+		}
+	}
+
+	resourceTopology, updateErr := ctrl.updateResourceTopologyStatusTagsWithRetry(ctx, resourceTopology, statusTags)
+	if updateErr != nil {
+		return nil, updateErr
 	}
 
-	resourceTopology, err = ctrl.updateResourceTopologyStatusTagsWithRetry(ctx, resourceTopology, statusTags)
+	// some tags failed in batch, the succeeded tags are removed and the failed tags will be deleted again
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +329,20 @@ func (ctrl *Controller) handleDeleteTags(ctx context.Context,
 	return resourceTopology, nil
 }
 
+// deleteTagsInBatch delete labels of all tags by one batch request,
+// returns the tags whose labels are deleted successfully and the error of the first failed tag
+func (ctrl *Controller) deleteTagsInBatch(ctx context.Context, resourceTopology *apiXuanwuV1.ResourceTopology,
+	delList []apiXuanwuV1.Tag) ([]apiXuanwuV1.Tag, error) {
+	log.AddContext(ctx).Infof("trying to delete tags [%v] in batch", delList)
+	errs, err := ctrl.CmiDeleteLabels(ctx, getBackendNameByVolumeHandle(resourceTopology.Spec.VolumeHandle),
+		getCmiParamsList(resourceTopology, delList))
+	if err != nil {
+		return nil, err
+	}
+
+	return filterSucceededTags(delList, errs)
+}
+
 func (ctrl *Controller) deleteTagFromSlice(ctx context.Context, tags []apiXuanwuV1.Tag,
 	tag apiXuanwuV1.Tag, params *cmi.Params) ([]apiXuanwuV1.Tag, error) {
 	inner, err := innerTag.NewInnerTag(tag.TypeMeta)
@@ -431,6 +489,21 @@ func deleteTag(tags []apiXuanwuV1.Tag, target apiXuanwuV1.Tag) []apiXuanwuV1.Tag
 	return tags
 }
 
+func filterSucceededTags(tags []apiXuanwuV1.Tag, errs []error) ([]apiXuanwuV1.Tag, error) {
+	var succeeded []apiXuanwuV1.Tag
+	var firstErr error
+	for i, tag := range tags {
+		if errs[i] == nil {
+			succeeded = append(succeeded, tag)
+			continue
+		}
+		if firstErr == nil {
+			firstErr = fmt.Errorf("label of tag [%v] failed on storage: [%w]", tag, errs[i])
+		}
+	}
+	return succeeded, firstErr
+}
+
 func addResourceCheck(inner innerTag.InnerTag) error {
 	if !inner.Exists() {
 		return fmt.Errorf("resource of tag [%v] does not exist", inner)
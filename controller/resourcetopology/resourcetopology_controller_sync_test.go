@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -130,3 +130,26 @@ func TestResourceTopologyController_syncResourceTopology_StatusToNormal(t *testi
 		mock.Reset()
 	})
 }
+
+func TestResourceTopologyController_filterSucceededTags(t *testing.T) {
+	// arrange
+	tags := []apiXuanwuV1.Tag{
+		{ResourceInfo: apiXuanwuV1.ResourceInfo{TypeMeta: metaV1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			Name: "fakePod1"}},
+		{ResourceInfo: apiXuanwuV1.ResourceInfo{TypeMeta: metaV1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			Name: "fakePod2"}},
+	}
+	errs := []error{nil, errors.New("fake error")}
+
+	// act
+	succeeded, err := filterSucceededTags(tags, errs)
+
+	// assert
+	if !reflect.DeepEqual(succeeded, tags[:1]) {
+		t.Errorf("TestResourceTopologyController_filterSucceededTags failed: want: [%v], got: [%v]",
+			tags[:1], succeeded)
+	}
+	if err == nil {
+		t.Errorf("TestResourceTopologyController_filterSucceededTags failed: want an error, got nil")
+	}
+}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -30,6 +30,7 @@ import (
 	sbcClient "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned"
 
 	"github.com/huawei/csm/v2/config/client"
+	tlsConfig "github.com/huawei/csm/v2/config/tls"
 	cmiGrpc "github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	xuanwuClient "github.com/huawei/csm/v2/pkg/client/clientset/versioned"
 	"github.com/huawei/csm/v2/utils/log"
@@ -199,7 +200,7 @@ func initCmiClient(c *ClientsSet) error {
 		return nil
 	}
 
-	cmiClientSet, err := cmiGrpc.GetClientSet(c.CmiAddress)
+	cmiClientSet, err := cmiGrpc.GetClientSetWithTLS(c.CmiAddress, tlsConfig.GetTLSOption())
 	if err != nil {
 		return fmt.Errorf("error getting client set of cmi: [%v]", err)
 	}
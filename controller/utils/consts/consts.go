@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -50,7 +50,7 @@ const (
 )
 
 // SupportedType contains all the storage volume type CSM supported
-var SupportedType = []string{StorageNas, StorageSan}
+var SupportedType = []string{StorageNas, StorageSan, StorageFusionNas, StorageFusionSan}
 
 const (
 	// StorageNas is a storage volume type oceanstor-nas
@@ -58,4 +58,10 @@ const (
 
 	// StorageSan is a storage volume type oceanstor-san
 	StorageSan = "oceanstor-san"
+
+	// StorageFusionNas is a storage volume type fusionstorage-nas
+	StorageFusionNas = "fusionstorage-nas"
+
+	// StorageFusionSan is a storage volume type fusionstorage-san
+	StorageFusionSan = "fusionstorage-san"
 )
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -18,8 +18,7 @@
 package cmi
 
 import (
-	"fmt"
-	"strings"
+	"errors"
 
 	"google.golang.org/grpc"
 
@@ -36,7 +35,12 @@ type ClientSet struct {
 
 // GetClientSet get client set
 func GetClientSet(address string) (*ClientSet, error) {
-	connect, err := buildGrpcConnect(address)
+	return GetClientSetWithTLS(address, nil)
+}
+
+// GetClientSetWithTLS get client set, the tlsOption is required when the address is tcp
+func GetClientSetWithTLS(address string, tlsOption *TLSOption) (*ClientSet, error) {
+	connect, err := buildGrpcConnect(address, tlsOption)
 	if err != nil {
 		return nil, err
 	}
@@ -48,21 +52,25 @@ func GetClientSet(address string) (*ClientSet, error) {
 	}, nil
 }
 
-func buildGrpcConnect(address string) (*grpc.ClientConn, error) {
+func buildGrpcConnect(address string, tlsOption *TLSOption) (*grpc.ClientConn, error) {
 	log.Infof("Connecting to %s", address)
 
-	unixPrefix := "unix://"
-	if strings.HasPrefix(address, "/") {
-		// It looks like filesystem path.
-		address = unixPrefix + address
+	network, target, err := ParseAddress(address)
+	if err != nil {
+		return nil, err
 	}
 
-	if !strings.HasPrefix(address, unixPrefix) {
-		return nil, fmt.Errorf("invalid unix domain path [%s]", address)
+	if network == "unix" {
+		return grpc.Dial(unixPrefix+target, grpc.WithInsecure())
 	}
 
-	dialOptions := []grpc.DialOption{
-		grpc.WithInsecure()}
+	if tlsOption == nil {
+		return nil, errors.New("mutual tls is required when connecting to cmi over tcp")
+	}
 
-	return grpc.Dial(address, dialOptions...)
+	creds, err := NewClientCredentials(tlsOption)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Dial(target, grpc.WithTransportCredentials(creds))
 }
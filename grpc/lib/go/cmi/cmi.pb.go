@@ -1,5 +1,5 @@
 //
-//Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+//Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
 //
 //Licensed under the Apache License, Version 2.0 (the "License");
 //you may not use this file except in compliance with the License.
@@ -85,7 +85,7 @@ func (x ProviderCapability_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProviderCapability_Type.Descriptor instead.
 func (ProviderCapability_Type) EnumDescriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{8, 0}
+	return file_cmi_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type CreateLabelRequest struct {
@@ -355,6 +355,539 @@ func (x *DeleteLabelResponse) GetSuccess() *wrappers.BoolValue {
 	return nil
 }
 
+type CreateLabelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// This field is REQUIRED. Value of this field is StorageBackendClaim name.
+	// All labels in one batch must belong to volumes of this backend.
+	BackendName string `protobuf:"bytes,1,opt,name=backend_name,json=backendName,proto3" json:"backend_name,omitempty"`
+	// This field is REQUIRED. The labels to be created.
+	Labels []*CreateLabelRequest `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *CreateLabelsRequest) Reset() {
+	*x = CreateLabelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelsRequest) ProtoMessage() {}
+
+func (x *CreateLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelsRequest.ProtoReflect.Descriptor instead.
+func (*CreateLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateLabelsRequest) GetBackendName() string {
+	if x != nil {
+		return x.BackendName
+	}
+	return ""
+}
+
+func (x *CreateLabelsRequest) GetLabels() []*CreateLabelRequest {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateLabelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The result of each label, in the same order as the labels in request.
+	Results []*LabelResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CreateLabelsResponse) Reset() {
+	*x = CreateLabelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelsResponse) ProtoMessage() {}
+
+func (x *CreateLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelsResponse.ProtoReflect.Descriptor instead.
+func (*CreateLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateLabelsResponse) GetResults() []*LabelResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DeleteLabelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// This field is REQUIRED. Value of this field is StorageBackendClaim name.
+	// All labels in one batch must belong to volumes of this backend.
+	BackendName string `protobuf:"bytes,1,opt,name=backend_name,json=backendName,proto3" json:"backend_name,omitempty"`
+	// This field is REQUIRED. The labels to be deleted.
+	Labels []*DeleteLabelRequest `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *DeleteLabelsRequest) Reset() {
+	*x = DeleteLabelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelsRequest) ProtoMessage() {}
+
+func (x *DeleteLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteLabelsRequest) GetBackendName() string {
+	if x != nil {
+		return x.BackendName
+	}
+	return ""
+}
+
+func (x *DeleteLabelsRequest) GetLabels() []*DeleteLabelRequest {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type DeleteLabelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The result of each label, in the same order as the labels in request.
+	Results []*LabelResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *DeleteLabelsResponse) Reset() {
+	*x = DeleteLabelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelsResponse) ProtoMessage() {}
+
+func (x *DeleteLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteLabelsResponse) GetResults() []*LabelResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type LabelResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// See CreateLabelRequest's volume_id for details.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// See CreateLabelRequest's label_name for details.
+	LabelName string `protobuf:"bytes,2,opt,name=label_name,json=labelName,proto3" json:"label_name,omitempty"`
+	// See CreateLabelRequest's kind for details.
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// See CreateLabelRequest's namespace for details.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Indicates if success or not
+	Success *wrappers.BoolValue `protobuf:"bytes,5,opt,name=success,proto3" json:"success,omitempty"`
+	// This field is OPTIONAL. Value of this field is the error message when failed.
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// This field is OPTIONAL. Value of this field is the grpc status code of the error when failed,
+	// e.g. 3 is InvalidArgument, 5 is NotFound, 14 is Unavailable.
+	ErrorCode int32 `protobuf:"varint,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+}
+
+func (x *LabelResult) Reset() {
+	*x = LabelResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelResult) ProtoMessage() {}
+
+func (x *LabelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelResult.ProtoReflect.Descriptor instead.
+func (*LabelResult) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LabelResult) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *LabelResult) GetLabelName() string {
+	if x != nil {
+		return x.LabelName
+	}
+	return ""
+}
+
+func (x *LabelResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LabelResult) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *LabelResult) GetSuccess() *wrappers.BoolValue {
+	if x != nil {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *LabelResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *LabelResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type ListLabelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// This field is REQUIRED. Value of this field is StorageBackendClaim name.
+	BackendName string `protobuf:"bytes,1,opt,name=backend_name,json=backendName,proto3" json:"backend_name,omitempty"`
+	// This field is OPTIONAL. If specified, only the labels of this volume will be listed.
+	VolumeId string `protobuf:"bytes,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// This field is OPTIONAL. If specified, only the labels of this storage resource will be listed,
+	// e.g. the id of lun or filesystem. It will be ignored when volume_id is specified.
+	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// This field is OPTIONAL. Value of this field is label kind, e.g. Pod, PersistentVolume...
+	// If not specified, labels of all kinds will be listed.
+	Kind string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *ListLabelsRequest) Reset() {
+	*x = ListLabelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsRequest) ProtoMessage() {}
+
+func (x *ListLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLabelsRequest) GetBackendName() string {
+	if x != nil {
+		return x.BackendName
+	}
+	return ""
+}
+
+func (x *ListLabelsRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *ListLabelsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListLabelsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type ListLabelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The labels exist on the storage.
+	Labels []*Label `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *ListLabelsResponse) Reset() {
+	*x = ListLabelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsResponse) ProtoMessage() {}
+
+func (x *ListLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLabelsResponse) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type Label struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value of this field is label kind, e.g. Pod, PersistentVolume...
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Value of this field is the id of storage resource, e.g. the id of lun or filesystem.
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Value of this field is the type of storage resource, e.g. 11 is lun, 40 is filesystem.
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Value of this field is label name, e.g. pv name or pod name.
+	LabelName string `protobuf:"bytes,4,opt,name=label_name,json=labelName,proto3" json:"label_name,omitempty"`
+	// Value of this field is the namespace when kind is Pod.
+	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Value of this field is the cluster name when kind is PersistentVolume.
+	ClusterName string `protobuf:"bytes,6,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Label) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Label) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *Label) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *Label) GetLabelName() string {
+	if x != nil {
+		return x.LabelName
+	}
+	return ""
+}
+
+func (x *Label) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Label) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
 // Probe request to check health/availability
 type ProbeRequest struct {
 	state         protoimpl.MessageState
@@ -365,7 +898,7 @@ type ProbeRequest struct {
 func (x *ProbeRequest) Reset() {
 	*x = ProbeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[4]
+		mi := &file_cmi_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -378,7 +911,7 @@ func (x *ProbeRequest) String() string {
 func (*ProbeRequest) ProtoMessage() {}
 
 func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[4]
+	mi := &file_cmi_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +924,7 @@ func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeRequest.ProtoReflect.Descriptor instead.
 func (*ProbeRequest) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{4}
+	return file_cmi_proto_rawDescGZIP(), []int{12}
 }
 
 // Response to indicate health/availability status
@@ -407,7 +940,7 @@ type ProbeResponse struct {
 func (x *ProbeResponse) Reset() {
 	*x = ProbeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[5]
+		mi := &file_cmi_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -420,7 +953,7 @@ func (x *ProbeResponse) String() string {
 func (*ProbeResponse) ProtoMessage() {}
 
 func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[5]
+	mi := &file_cmi_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,19 +961,191 @@ func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeResponse.ProtoReflect.Descriptor instead.
+func (*ProbeResponse) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProbeResponse) GetReady() *wrappers.BoolValue {
+	if x != nil {
+		return x.Ready
+	}
+	return nil
+}
+
+// Probe backends request to check the login state of storage backends
+type ProbeBackendsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProbeBackendsRequest) Reset() {
+	*x = ProbeBackendsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeBackendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeBackendsRequest) ProtoMessage() {}
+
+func (x *ProbeBackendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeBackendsRequest.ProtoReflect.Descriptor instead.
+func (*ProbeBackendsRequest) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{14}
+}
+
+// Response to indicate the login state of storage backends
+type ProbeBackendsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The states of all the backends which have been used by CMI.
+	Backends []*BackendState `protobuf:"bytes,1,rep,name=backends,proto3" json:"backends,omitempty"`
+}
+
+func (x *ProbeBackendsResponse) Reset() {
+	*x = ProbeBackendsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeBackendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeBackendsResponse) ProtoMessage() {}
+
+func (x *ProbeBackendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeBackendsResponse.ProtoReflect.Descriptor instead.
+func (*ProbeBackendsResponse) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProbeBackendsResponse) GetBackends() []*BackendState {
+	if x != nil {
+		return x.Backends
+	}
+	return nil
+}
+
+type BackendState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value of this field is the name of StorageBackendClaim.
+	BackendName string `protobuf:"bytes,1,opt,name=backend_name,json=backendName,proto3" json:"backend_name,omitempty"`
+	// Indicates whether the storage is logged in and the latest call is succeeded.
+	LoggedIn bool `protobuf:"varint,2,opt,name=logged_in,json=loggedIn,proto3" json:"logged_in,omitempty"`
+	// The unix time in seconds of the latest successful login or call, 0 means never succeeded.
+	LastSuccessTime int64 `protobuf:"varint,3,opt,name=last_success_time,json=lastSuccessTime,proto3" json:"last_success_time,omitempty"`
+	// The latest error of login or call, empty means no error occurred.
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The unix time in seconds of the latest error, 0 means no error occurred.
+	LastErrorTime int64 `protobuf:"varint,5,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+}
+
+func (x *BackendState) Reset() {
+	*x = BackendState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendState) ProtoMessage() {}
+
+func (x *BackendState) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendState.ProtoReflect.Descriptor instead.
+func (*BackendState) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BackendState) GetBackendName() string {
+	if x != nil {
+		return x.BackendName
+	}
+	return ""
+}
+
+func (x *BackendState) GetLoggedIn() bool {
+	if x != nil {
+		return x.LoggedIn
+	}
+	return false
 }
 
-// Deprecated: Use ProbeResponse.ProtoReflect.Descriptor instead.
-func (*ProbeResponse) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{5}
+func (x *BackendState) GetLastSuccessTime() int64 {
+	if x != nil {
+		return x.LastSuccessTime
+	}
+	return 0
 }
 
-func (x *ProbeResponse) GetReady() *wrappers.BoolValue {
+func (x *BackendState) GetLastError() string {
 	if x != nil {
-		return x.Ready
+		return x.LastError
 	}
-	return nil
+	return ""
+}
+
+func (x *BackendState) GetLastErrorTime() int64 {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return 0
 }
 
 type GetProviderCapabilitiesRequest struct {
@@ -452,7 +1157,7 @@ type GetProviderCapabilitiesRequest struct {
 func (x *GetProviderCapabilitiesRequest) Reset() {
 	*x = GetProviderCapabilitiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[6]
+		mi := &file_cmi_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +1170,7 @@ func (x *GetProviderCapabilitiesRequest) String() string {
 func (*GetProviderCapabilitiesRequest) ProtoMessage() {}
 
 func (x *GetProviderCapabilitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[6]
+	mi := &file_cmi_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +1183,7 @@ func (x *GetProviderCapabilitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProviderCapabilitiesRequest.ProtoReflect.Descriptor instead.
 func (*GetProviderCapabilitiesRequest) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{6}
+	return file_cmi_proto_rawDescGZIP(), []int{17}
 }
 
 type GetProviderCapabilitiesResponse struct {
@@ -493,7 +1198,7 @@ type GetProviderCapabilitiesResponse struct {
 func (x *GetProviderCapabilitiesResponse) Reset() {
 	*x = GetProviderCapabilitiesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[7]
+		mi := &file_cmi_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -506,7 +1211,7 @@ func (x *GetProviderCapabilitiesResponse) String() string {
 func (*GetProviderCapabilitiesResponse) ProtoMessage() {}
 
 func (x *GetProviderCapabilitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[7]
+	mi := &file_cmi_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +1224,7 @@ func (x *GetProviderCapabilitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProviderCapabilitiesResponse.ProtoReflect.Descriptor instead.
 func (*GetProviderCapabilitiesResponse) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{7}
+	return file_cmi_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetProviderCapabilitiesResponse) GetCapabilities() []*ProviderCapability {
@@ -540,7 +1245,7 @@ type ProviderCapability struct {
 func (x *ProviderCapability) Reset() {
 	*x = ProviderCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[8]
+		mi := &file_cmi_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -553,7 +1258,7 @@ func (x *ProviderCapability) String() string {
 func (*ProviderCapability) ProtoMessage() {}
 
 func (x *ProviderCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[8]
+	mi := &file_cmi_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +1271,7 @@ func (x *ProviderCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderCapability.ProtoReflect.Descriptor instead.
 func (*ProviderCapability) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{8}
+	return file_cmi_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ProviderCapability) GetType() ProviderCapability_Type {
@@ -585,7 +1290,7 @@ type GetProviderInfoRequest struct {
 func (x *GetProviderInfoRequest) Reset() {
 	*x = GetProviderInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[9]
+		mi := &file_cmi_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -598,7 +1303,7 @@ func (x *GetProviderInfoRequest) String() string {
 func (*GetProviderInfoRequest) ProtoMessage() {}
 
 func (x *GetProviderInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[9]
+	mi := &file_cmi_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +1316,7 @@ func (x *GetProviderInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProviderInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetProviderInfoRequest) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{9}
+	return file_cmi_proto_rawDescGZIP(), []int{20}
 }
 
 type GetProviderInfoResponse struct {
@@ -626,7 +1331,7 @@ type GetProviderInfoResponse struct {
 func (x *GetProviderInfoResponse) Reset() {
 	*x = GetProviderInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[10]
+		mi := &file_cmi_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -639,7 +1344,7 @@ func (x *GetProviderInfoResponse) String() string {
 func (*GetProviderInfoResponse) ProtoMessage() {}
 
 func (x *GetProviderInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[10]
+	mi := &file_cmi_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +1357,7 @@ func (x *GetProviderInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProviderInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetProviderInfoResponse) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{10}
+	return file_cmi_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetProviderInfoResponse) GetProvider() string {
@@ -680,12 +1385,32 @@ type CollectRequest struct {
 	MetricsType string `protobuf:"bytes,3,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type,omitempty"`
 	// This field is REQUIRED when metrics_type is performance
 	Indicators []string `protobuf:"bytes,4,rep,name=indicators,proto3" json:"indicators,omitempty"`
+	// This field is OPTIONAL. If specified, only the objects with these ids will be collected.
+	ObjectIds []string `protobuf:"bytes,5,rep,name=object_ids,json=objectIds,proto3" json:"object_ids,omitempty"`
+	// This field is OPTIONAL. If specified, only the objects with these names will be collected.
+	ObjectNames []string `protobuf:"bytes,6,rep,name=object_names,json=objectNames,proto3" json:"object_names,omitempty"`
+	// This field is OPTIONAL. If specified, only the objects whose name starts with it will be collected.
+	// If several filters are specified, an object will be collected only when it matches all of them,
+	// and the objects without name, e.g. array, never match the name filters.
+	NamePrefix string `protobuf:"bytes,7,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	// This field is OPTIONAL and only used when metrics_type is performance.
+	// Value of this field is the start of the time range in seconds since epoch.
+	// If start_time and end_time are specified, the historical performance data in the time range
+	// will be collected instead of the realtime data, and each detail has a timestamp.
+	StartTime int64 `protobuf:"varint,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// This field is OPTIONAL. Value of this field is the end of the time range in seconds since epoch.
+	// It is REQUIRED when start_time is specified, it must be greater than start_time and not in the future,
+	// and the time range must not exceed 24 hours.
+	EndTime int64 `protobuf:"varint,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// This field is OPTIONAL. Value of this field is the sample interval of the historical performance data
+	// in seconds. If it is not specified, the default interval of the storage will be used.
+	Interval int64 `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
 }
 
 func (x *CollectRequest) Reset() {
 	*x = CollectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[11]
+		mi := &file_cmi_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -698,7 +1423,7 @@ func (x *CollectRequest) String() string {
 func (*CollectRequest) ProtoMessage() {}
 
 func (x *CollectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[11]
+	mi := &file_cmi_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +1436,7 @@ func (x *CollectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectRequest.ProtoReflect.Descriptor instead.
 func (*CollectRequest) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{11}
+	return file_cmi_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CollectRequest) GetBackendName() string {
@@ -742,6 +1467,48 @@ func (x *CollectRequest) GetIndicators() []string {
 	return nil
 }
 
+func (x *CollectRequest) GetObjectIds() []string {
+	if x != nil {
+		return x.ObjectIds
+	}
+	return nil
+}
+
+func (x *CollectRequest) GetObjectNames() []string {
+	if x != nil {
+		return x.ObjectNames
+	}
+	return nil
+}
+
+func (x *CollectRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *CollectRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CollectRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CollectRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
 type CollectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -758,12 +1525,16 @@ type CollectResponse struct {
 	MetricsType string `protobuf:"bytes,3,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type,omitempty"`
 	// The list of collected data.
 	Details []*CollectDetail `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	// This field is OPTIONAL. It is true when only part of the objects are collected,
+	// e.g. some pages of the objects failed to be queried from storage,
+	// and the details only contain the objects which are collected successfully.
+	Partial bool `protobuf:"varint,5,opt,name=partial,proto3" json:"partial,omitempty"`
 }
 
 func (x *CollectResponse) Reset() {
 	*x = CollectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[12]
+		mi := &file_cmi_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +1547,7 @@ func (x *CollectResponse) String() string {
 func (*CollectResponse) ProtoMessage() {}
 
 func (x *CollectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[12]
+	mi := &file_cmi_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +1560,7 @@ func (x *CollectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectResponse.ProtoReflect.Descriptor instead.
 func (*CollectResponse) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{12}
+	return file_cmi_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CollectResponse) GetBackendName() string {
@@ -820,6 +1591,13 @@ func (x *CollectResponse) GetDetails() []*CollectDetail {
 	return nil
 }
 
+func (x *CollectResponse) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
 type CollectDetail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -828,12 +1606,17 @@ type CollectDetail struct {
 	// This field is REQUIRED. Value of this field is a map of data to be collected.
 	// Collected data are specified in as key-value pairs.
 	Data map[string]string `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Value of this field is the sample time in seconds since epoch.
+	// It is set for the historical performance data, see CollectRequest's start_time for details,
+	// and for the realtime performance data which is sampled before this request, e.g. the storage returns
+	// empty data for this request and the former sample is returned. It is not set for the current data.
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *CollectDetail) Reset() {
 	*x = CollectDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cmi_proto_msgTypes[13]
+		mi := &file_cmi_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -846,7 +1629,7 @@ func (x *CollectDetail) String() string {
 func (*CollectDetail) ProtoMessage() {}
 
 func (x *CollectDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_cmi_proto_msgTypes[13]
+	mi := &file_cmi_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1642,7 @@ func (x *CollectDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectDetail.ProtoReflect.Descriptor instead.
 func (*CollectDetail) Descriptor() ([]byte, []int) {
-	return file_cmi_proto_rawDescGZIP(), []int{13}
+	return file_cmi_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CollectDetail) GetData() map[string]string {
@@ -869,6 +1652,185 @@ func (x *CollectDetail) GetData() map[string]string {
 	return nil
 }
 
+func (x *CollectDetail) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetCollectCapabilitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// This field is REQUIRED. Value of this field is StorageBackendClaim name.
+	BackendName string `protobuf:"bytes,1,opt,name=backend_name,json=backendName,proto3" json:"backend_name,omitempty"`
+}
+
+func (x *GetCollectCapabilitiesRequest) Reset() {
+	*x = GetCollectCapabilitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCollectCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCollectCapabilitiesRequest) GetBackendName() string {
+	if x != nil {
+		return x.BackendName
+	}
+	return ""
+}
+
+type GetCollectCapabilitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// See GetCollectCapabilitiesRequest's backend_name for details.
+	BackendName string `protobuf:"bytes,1,opt,name=backend_name,json=backendName,proto3" json:"backend_name,omitempty"`
+	// The collect types supported when metrics_type is object, e.g. array, controller, lun...
+	ObjectTypes []string `protobuf:"bytes,2,rep,name=object_types,json=objectTypes,proto3" json:"object_types,omitempty"`
+	// The collect types and indicators supported when metrics_type is performance.
+	PerformanceTypes []*PerformanceCapability `protobuf:"bytes,3,rep,name=performance_types,json=performanceTypes,proto3" json:"performance_types,omitempty"`
+}
+
+func (x *GetCollectCapabilitiesResponse) Reset() {
+	*x = GetCollectCapabilitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCollectCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCollectCapabilitiesResponse) GetBackendName() string {
+	if x != nil {
+		return x.BackendName
+	}
+	return ""
+}
+
+func (x *GetCollectCapabilitiesResponse) GetObjectTypes() []string {
+	if x != nil {
+		return x.ObjectTypes
+	}
+	return nil
+}
+
+func (x *GetCollectCapabilitiesResponse) GetPerformanceTypes() []*PerformanceCapability {
+	if x != nil {
+		return x.PerformanceTypes
+	}
+	return nil
+}
+
+type PerformanceCapability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value of this field is collect type, e.g. controller, lun...
+	CollectType string `protobuf:"bytes,1,opt,name=collect_type,json=collectType,proto3" json:"collect_type,omitempty"`
+	// The indicator ids which are reported as available by the storage, e.g. 22 is total iops.
+	// Empty means the storage does not report the available indicators, all indicators should be allowed.
+	Indicators []string `protobuf:"bytes,2,rep,name=indicators,proto3" json:"indicators,omitempty"`
+}
+
+func (x *PerformanceCapability) Reset() {
+	*x = PerformanceCapability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmi_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerformanceCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceCapability) ProtoMessage() {}
+
+func (x *PerformanceCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_cmi_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceCapability.ProtoReflect.Descriptor instead.
+func (*PerformanceCapability) Descriptor() ([]byte, []int) {
+	return file_cmi_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PerformanceCapability) GetCollectType() string {
+	if x != nil {
+		return x.CollectType
+	}
+	return ""
+}
+
+func (x *PerformanceCapability) GetIndicators() []string {
+	if x != nil {
+		return x.Indicators
+	}
+	return nil
+}
+
 var File_cmi_proto protoreflect.FileDescriptor
 
 var file_cmi_proto_rawDesc = []byte{
@@ -912,97 +1874,245 @@ var file_cmi_proto_rawDesc = []byte{
 	0x34, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x1f, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x9f, 0x01,
-	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x10, 0x01, 0x22,
-	0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x22, 0x99, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xab, 0x01, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6d, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x7d, 0x0a, 0x0d, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6d, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x89, 0x02, 0x0a, 0x08, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12,
-	0x14, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6d, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa2, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6d,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x6c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x22, 0x45, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63,
+	0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x45, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0xf5, 0x01, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x22, 0x3b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22,
+	0xc1, 0x01, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49,
+	0x0a, 0x15, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6d, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0c, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x61, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x54,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x10, 0x01, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xd2, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x22, 0x9b, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x42, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x4a, 0x0a,
+	0x11, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x10, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x15, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x32, 0xd9, 0x02, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6d,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1e, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x32, 0x83, 0x03, 0x0a, 0x0c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x63, 0x6d,
 	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x49, 0x0a, 0x09, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6d, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x6c, 0x69, 0x62, 0x2f, 0x67, 0x6f, 0x3b,
-	0x63, 0x6d, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x19,
+	0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6d, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfa, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x16, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63,
+	0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x6d, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6d, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x6c, 0x69, 0x62, 0x2f, 0x67, 0x6f, 0x3b, 0x63,
+	0x6d, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1018,53 +2128,87 @@ func file_cmi_proto_rawDescGZIP() []byte {
 }
 
 var file_cmi_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cmi_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_cmi_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_cmi_proto_goTypes = []interface{}{
 	(ProviderCapability_Type)(0),            // 0: cmi.v1.ProviderCapability.Type
 	(*CreateLabelRequest)(nil),              // 1: cmi.v1.CreateLabelRequest
 	(*CreateLabelResponse)(nil),             // 2: cmi.v1.CreateLabelResponse
 	(*DeleteLabelRequest)(nil),              // 3: cmi.v1.DeleteLabelRequest
 	(*DeleteLabelResponse)(nil),             // 4: cmi.v1.DeleteLabelResponse
-	(*ProbeRequest)(nil),                    // 5: cmi.v1.ProbeRequest
-	(*ProbeResponse)(nil),                   // 6: cmi.v1.ProbeResponse
-	(*GetProviderCapabilitiesRequest)(nil),  // 7: cmi.v1.GetProviderCapabilitiesRequest
-	(*GetProviderCapabilitiesResponse)(nil), // 8: cmi.v1.GetProviderCapabilitiesResponse
-	(*ProviderCapability)(nil),              // 9: cmi.v1.ProviderCapability
-	(*GetProviderInfoRequest)(nil),          // 10: cmi.v1.GetProviderInfoRequest
-	(*GetProviderInfoResponse)(nil),         // 11: cmi.v1.GetProviderInfoResponse
-	(*CollectRequest)(nil),                  // 12: cmi.v1.CollectRequest
-	(*CollectResponse)(nil),                 // 13: cmi.v1.CollectResponse
-	(*CollectDetail)(nil),                   // 14: cmi.v1.CollectDetail
-	nil,                                     // 15: cmi.v1.CreateLabelRequest.ParametersEntry
-	nil,                                     // 16: cmi.v1.CollectDetail.DataEntry
-	(*wrappers.BoolValue)(nil),              // 17: google.protobuf.BoolValue
+	(*CreateLabelsRequest)(nil),             // 5: cmi.v1.CreateLabelsRequest
+	(*CreateLabelsResponse)(nil),            // 6: cmi.v1.CreateLabelsResponse
+	(*DeleteLabelsRequest)(nil),             // 7: cmi.v1.DeleteLabelsRequest
+	(*DeleteLabelsResponse)(nil),            // 8: cmi.v1.DeleteLabelsResponse
+	(*LabelResult)(nil),                     // 9: cmi.v1.LabelResult
+	(*ListLabelsRequest)(nil),               // 10: cmi.v1.ListLabelsRequest
+	(*ListLabelsResponse)(nil),              // 11: cmi.v1.ListLabelsResponse
+	(*Label)(nil),                           // 12: cmi.v1.Label
+	(*ProbeRequest)(nil),                    // 13: cmi.v1.ProbeRequest
+	(*ProbeResponse)(nil),                   // 14: cmi.v1.ProbeResponse
+	(*ProbeBackendsRequest)(nil),            // 15: cmi.v1.ProbeBackendsRequest
+	(*ProbeBackendsResponse)(nil),           // 16: cmi.v1.ProbeBackendsResponse
+	(*BackendState)(nil),                    // 17: cmi.v1.BackendState
+	(*GetProviderCapabilitiesRequest)(nil),  // 18: cmi.v1.GetProviderCapabilitiesRequest
+	(*GetProviderCapabilitiesResponse)(nil), // 19: cmi.v1.GetProviderCapabilitiesResponse
+	(*ProviderCapability)(nil),              // 20: cmi.v1.ProviderCapability
+	(*GetProviderInfoRequest)(nil),          // 21: cmi.v1.GetProviderInfoRequest
+	(*GetProviderInfoResponse)(nil),         // 22: cmi.v1.GetProviderInfoResponse
+	(*CollectRequest)(nil),                  // 23: cmi.v1.CollectRequest
+	(*CollectResponse)(nil),                 // 24: cmi.v1.CollectResponse
+	(*CollectDetail)(nil),                   // 25: cmi.v1.CollectDetail
+	(*GetCollectCapabilitiesRequest)(nil),   // 26: cmi.v1.GetCollectCapabilitiesRequest
+	(*GetCollectCapabilitiesResponse)(nil),  // 27: cmi.v1.GetCollectCapabilitiesResponse
+	(*PerformanceCapability)(nil),           // 28: cmi.v1.PerformanceCapability
+	nil,                                     // 29: cmi.v1.CreateLabelRequest.ParametersEntry
+	nil,                                     // 30: cmi.v1.CollectDetail.DataEntry
+	(*wrappers.BoolValue)(nil),              // 31: google.protobuf.BoolValue
 }
 var file_cmi_proto_depIdxs = []int32{
-	15, // 0: cmi.v1.CreateLabelRequest.parameters:type_name -> cmi.v1.CreateLabelRequest.ParametersEntry
-	17, // 1: cmi.v1.CreateLabelResponse.success:type_name -> google.protobuf.BoolValue
-	17, // 2: cmi.v1.DeleteLabelResponse.success:type_name -> google.protobuf.BoolValue
-	17, // 3: cmi.v1.ProbeResponse.ready:type_name -> google.protobuf.BoolValue
-	9,  // 4: cmi.v1.GetProviderCapabilitiesResponse.capabilities:type_name -> cmi.v1.ProviderCapability
-	0,  // 5: cmi.v1.ProviderCapability.type:type_name -> cmi.v1.ProviderCapability.Type
-	14, // 6: cmi.v1.CollectResponse.details:type_name -> cmi.v1.CollectDetail
-	16, // 7: cmi.v1.CollectDetail.data:type_name -> cmi.v1.CollectDetail.DataEntry
-	5,  // 8: cmi.v1.Identity.Probe:input_type -> cmi.v1.ProbeRequest
-	10, // 9: cmi.v1.Identity.GetProvisionerInfo:input_type -> cmi.v1.GetProviderInfoRequest
-	7,  // 10: cmi.v1.Identity.GetProviderCapabilities:input_type -> cmi.v1.GetProviderCapabilitiesRequest
-	1,  // 11: cmi.v1.LabelService.CreateLabel:input_type -> cmi.v1.CreateLabelRequest
-	3,  // 12: cmi.v1.LabelService.DeleteLabel:input_type -> cmi.v1.DeleteLabelRequest
-	12, // 13: cmi.v1.Collector.Collect:input_type -> cmi.v1.CollectRequest
-	6,  // 14: cmi.v1.Identity.Probe:output_type -> cmi.v1.ProbeResponse
-	11, // 15: cmi.v1.Identity.GetProvisionerInfo:output_type -> cmi.v1.GetProviderInfoResponse
-	8,  // 16: cmi.v1.Identity.GetProviderCapabilities:output_type -> cmi.v1.GetProviderCapabilitiesResponse
-	2,  // 17: cmi.v1.LabelService.CreateLabel:output_type -> cmi.v1.CreateLabelResponse
-	4,  // 18: cmi.v1.LabelService.DeleteLabel:output_type -> cmi.v1.DeleteLabelResponse
-	13, // 19: cmi.v1.Collector.Collect:output_type -> cmi.v1.CollectResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	29, // 0: cmi.v1.CreateLabelRequest.parameters:type_name -> cmi.v1.CreateLabelRequest.ParametersEntry
+	31, // 1: cmi.v1.CreateLabelResponse.success:type_name -> google.protobuf.BoolValue
+	31, // 2: cmi.v1.DeleteLabelResponse.success:type_name -> google.protobuf.BoolValue
+	1,  // 3: cmi.v1.CreateLabelsRequest.labels:type_name -> cmi.v1.CreateLabelRequest
+	9,  // 4: cmi.v1.CreateLabelsResponse.results:type_name -> cmi.v1.LabelResult
+	3,  // 5: cmi.v1.DeleteLabelsRequest.labels:type_name -> cmi.v1.DeleteLabelRequest
+	9,  // 6: cmi.v1.DeleteLabelsResponse.results:type_name -> cmi.v1.LabelResult
+	31, // 7: cmi.v1.LabelResult.success:type_name -> google.protobuf.BoolValue
+	12, // 8: cmi.v1.ListLabelsResponse.labels:type_name -> cmi.v1.Label
+	31, // 9: cmi.v1.ProbeResponse.ready:type_name -> google.protobuf.BoolValue
+	17, // 10: cmi.v1.ProbeBackendsResponse.backends:type_name -> cmi.v1.BackendState
+	20, // 11: cmi.v1.GetProviderCapabilitiesResponse.capabilities:type_name -> cmi.v1.ProviderCapability
+	0,  // 12: cmi.v1.ProviderCapability.type:type_name -> cmi.v1.ProviderCapability.Type
+	25, // 13: cmi.v1.CollectResponse.details:type_name -> cmi.v1.CollectDetail
+	30, // 14: cmi.v1.CollectDetail.data:type_name -> cmi.v1.CollectDetail.DataEntry
+	28, // 15: cmi.v1.GetCollectCapabilitiesResponse.performance_types:type_name -> cmi.v1.PerformanceCapability
+	13, // 16: cmi.v1.Identity.Probe:input_type -> cmi.v1.ProbeRequest
+	21, // 17: cmi.v1.Identity.GetProvisionerInfo:input_type -> cmi.v1.GetProviderInfoRequest
+	18, // 18: cmi.v1.Identity.GetProviderCapabilities:input_type -> cmi.v1.GetProviderCapabilitiesRequest
+	15, // 19: cmi.v1.Identity.ProbeBackends:input_type -> cmi.v1.ProbeBackendsRequest
+	1,  // 20: cmi.v1.LabelService.CreateLabel:input_type -> cmi.v1.CreateLabelRequest
+	3,  // 21: cmi.v1.LabelService.DeleteLabel:input_type -> cmi.v1.DeleteLabelRequest
+	5,  // 22: cmi.v1.LabelService.CreateLabels:input_type -> cmi.v1.CreateLabelsRequest
+	7,  // 23: cmi.v1.LabelService.DeleteLabels:input_type -> cmi.v1.DeleteLabelsRequest
+	10, // 24: cmi.v1.LabelService.ListLabels:input_type -> cmi.v1.ListLabelsRequest
+	23, // 25: cmi.v1.Collector.Collect:input_type -> cmi.v1.CollectRequest
+	23, // 26: cmi.v1.Collector.CollectStream:input_type -> cmi.v1.CollectRequest
+	26, // 27: cmi.v1.Collector.GetCollectCapabilities:input_type -> cmi.v1.GetCollectCapabilitiesRequest
+	14, // 28: cmi.v1.Identity.Probe:output_type -> cmi.v1.ProbeResponse
+	22, // 29: cmi.v1.Identity.GetProvisionerInfo:output_type -> cmi.v1.GetProviderInfoResponse
+	19, // 30: cmi.v1.Identity.GetProviderCapabilities:output_type -> cmi.v1.GetProviderCapabilitiesResponse
+	16, // 31: cmi.v1.Identity.ProbeBackends:output_type -> cmi.v1.ProbeBackendsResponse
+	2,  // 32: cmi.v1.LabelService.CreateLabel:output_type -> cmi.v1.CreateLabelResponse
+	4,  // 33: cmi.v1.LabelService.DeleteLabel:output_type -> cmi.v1.DeleteLabelResponse
+	6,  // 34: cmi.v1.LabelService.CreateLabels:output_type -> cmi.v1.CreateLabelsResponse
+	8,  // 35: cmi.v1.LabelService.DeleteLabels:output_type -> cmi.v1.DeleteLabelsResponse
+	11, // 36: cmi.v1.LabelService.ListLabels:output_type -> cmi.v1.ListLabelsResponse
+	24, // 37: cmi.v1.Collector.Collect:output_type -> cmi.v1.CollectResponse
+	24, // 38: cmi.v1.Collector.CollectStream:output_type -> cmi.v1.CollectResponse
+	27, // 39: cmi.v1.Collector.GetCollectCapabilities:output_type -> cmi.v1.GetCollectCapabilitiesResponse
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_cmi_proto_init() }
@@ -1085,8 +2229,68 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLabelResponse); i {
+		file_cmi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLabelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1097,8 +2301,8 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLabelRequest); i {
+		file_cmi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLabelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1109,8 +2313,8 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLabelResponse); i {
+		file_cmi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1121,7 +2325,43 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Label); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProbeRequest); i {
 			case 0:
 				return &v.state
@@ -1133,7 +2373,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProbeResponse); i {
 			case 0:
 				return &v.state
@@ -1145,7 +2385,43 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeBackendsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeBackendsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProviderCapabilitiesRequest); i {
 			case 0:
 				return &v.state
@@ -1157,7 +2433,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProviderCapabilitiesResponse); i {
 			case 0:
 				return &v.state
@@ -1169,7 +2445,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProviderCapability); i {
 			case 0:
 				return &v.state
@@ -1181,7 +2457,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProviderInfoRequest); i {
 			case 0:
 				return &v.state
@@ -1193,7 +2469,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProviderInfoResponse); i {
 			case 0:
 				return &v.state
@@ -1205,7 +2481,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollectRequest); i {
 			case 0:
 				return &v.state
@@ -1217,7 +2493,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollectResponse); i {
 			case 0:
 				return &v.state
@@ -1229,7 +2505,7 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
-		file_cmi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_cmi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollectDetail); i {
 			case 0:
 				return &v.state
@@ -1241,6 +2517,42 @@ func file_cmi_proto_init() {
 				return nil
 			}
 		}
+		file_cmi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectCapabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectCapabilitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmi_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerformanceCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1248,7 +2560,7 @@ func file_cmi_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cmi_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -1282,6 +2594,8 @@ type IdentityClient interface {
 	// Get CMI capabilities
 	// Though it, can know which interfaces have been implemented by CMI.
 	GetProviderCapabilities(ctx context.Context, in *GetProviderCapabilitiesRequest, opts ...grpc.CallOption) (*GetProviderCapabilitiesResponse, error)
+	// Get the login state of each storage backend.
+	ProbeBackends(ctx context.Context, in *ProbeBackendsRequest, opts ...grpc.CallOption) (*ProbeBackendsResponse, error)
 }
 
 type identityClient struct {
@@ -1319,6 +2633,15 @@ func (c *identityClient) GetProviderCapabilities(ctx context.Context, in *GetPro
 	return out, nil
 }
 
+func (c *identityClient) ProbeBackends(ctx context.Context, in *ProbeBackendsRequest, opts ...grpc.CallOption) (*ProbeBackendsResponse, error) {
+	out := new(ProbeBackendsResponse)
+	err := c.cc.Invoke(ctx, "/cmi.v1.Identity/ProbeBackends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IdentityServer is the server API for Identity service.
 type IdentityServer interface {
 	// Get CMI running status.
@@ -1328,6 +2651,8 @@ type IdentityServer interface {
 	// Get CMI capabilities
 	// Though it, can know which interfaces have been implemented by CMI.
 	GetProviderCapabilities(context.Context, *GetProviderCapabilitiesRequest) (*GetProviderCapabilitiesResponse, error)
+	// Get the login state of each storage backend.
+	ProbeBackends(context.Context, *ProbeBackendsRequest) (*ProbeBackendsResponse, error)
 }
 
 // UnimplementedIdentityServer can be embedded to have forward compatible implementations.
@@ -1343,6 +2668,9 @@ func (*UnimplementedIdentityServer) GetProvisionerInfo(context.Context, *GetProv
 func (*UnimplementedIdentityServer) GetProviderCapabilities(context.Context, *GetProviderCapabilitiesRequest) (*GetProviderCapabilitiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProviderCapabilities not implemented")
 }
+func (*UnimplementedIdentityServer) ProbeBackends(context.Context, *ProbeBackendsRequest) (*ProbeBackendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProbeBackends not implemented")
+}
 
 func RegisterIdentityServer(s *grpc.Server, srv IdentityServer) {
 	s.RegisterService(&_Identity_serviceDesc, srv)
@@ -1402,6 +2730,24 @@ func _Identity_GetProviderCapabilities_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Identity_ProbeBackends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeBackendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServer).ProbeBackends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cmi.v1.Identity/ProbeBackends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServer).ProbeBackends(ctx, req.(*ProbeBackendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Identity_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cmi.v1.Identity",
 	HandlerType: (*IdentityServer)(nil),
@@ -1418,6 +2764,10 @@ var _Identity_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProviderCapabilities",
 			Handler:    _Identity_GetProviderCapabilities_Handler,
 		},
+		{
+			MethodName: "ProbeBackends",
+			Handler:    _Identity_ProbeBackends_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cmi.proto",
@@ -1431,6 +2781,12 @@ type LabelServiceClient interface {
 	CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*CreateLabelResponse, error)
 	// Delete label
 	DeleteLabel(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*DeleteLabelResponse, error)
+	// Create labels in batch, each label has its own result
+	CreateLabels(ctx context.Context, in *CreateLabelsRequest, opts ...grpc.CallOption) (*CreateLabelsResponse, error)
+	// Delete labels in batch, each label has its own result
+	DeleteLabels(ctx context.Context, in *DeleteLabelsRequest, opts ...grpc.CallOption) (*DeleteLabelsResponse, error)
+	// List labels which exist on the storage
+	ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error)
 }
 
 type labelServiceClient struct {
@@ -1459,12 +2815,45 @@ func (c *labelServiceClient) DeleteLabel(ctx context.Context, in *DeleteLabelReq
 	return out, nil
 }
 
+func (c *labelServiceClient) CreateLabels(ctx context.Context, in *CreateLabelsRequest, opts ...grpc.CallOption) (*CreateLabelsResponse, error) {
+	out := new(CreateLabelsResponse)
+	err := c.cc.Invoke(ctx, "/cmi.v1.LabelService/CreateLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) DeleteLabels(ctx context.Context, in *DeleteLabelsRequest, opts ...grpc.CallOption) (*DeleteLabelsResponse, error) {
+	out := new(DeleteLabelsResponse)
+	err := c.cc.Invoke(ctx, "/cmi.v1.LabelService/DeleteLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error) {
+	out := new(ListLabelsResponse)
+	err := c.cc.Invoke(ctx, "/cmi.v1.LabelService/ListLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LabelServiceServer is the server API for LabelService service.
 type LabelServiceServer interface {
 	// Create label
 	CreateLabel(context.Context, *CreateLabelRequest) (*CreateLabelResponse, error)
 	// Delete label
 	DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error)
+	// Create labels in batch, each label has its own result
+	CreateLabels(context.Context, *CreateLabelsRequest) (*CreateLabelsResponse, error)
+	// Delete labels in batch, each label has its own result
+	DeleteLabels(context.Context, *DeleteLabelsRequest) (*DeleteLabelsResponse, error)
+	// List labels which exist on the storage
+	ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error)
 }
 
 // UnimplementedLabelServiceServer can be embedded to have forward compatible implementations.
@@ -1477,6 +2866,15 @@ func (*UnimplementedLabelServiceServer) CreateLabel(context.Context, *CreateLabe
 func (*UnimplementedLabelServiceServer) DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLabel not implemented")
 }
+func (*UnimplementedLabelServiceServer) CreateLabels(context.Context, *CreateLabelsRequest) (*CreateLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLabels not implemented")
+}
+func (*UnimplementedLabelServiceServer) DeleteLabels(context.Context, *DeleteLabelsRequest) (*DeleteLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLabels not implemented")
+}
+func (*UnimplementedLabelServiceServer) ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLabels not implemented")
+}
 
 func RegisterLabelServiceServer(s *grpc.Server, srv LabelServiceServer) {
 	s.RegisterService(&_LabelService_serviceDesc, srv)
@@ -1518,6 +2916,60 @@ func _LabelService_DeleteLabel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LabelService_CreateLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).CreateLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cmi.v1.LabelService/CreateLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).CreateLabels(ctx, req.(*CreateLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_DeleteLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).DeleteLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cmi.v1.LabelService/DeleteLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).DeleteLabels(ctx, req.(*DeleteLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).ListLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cmi.v1.LabelService/ListLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).ListLabels(ctx, req.(*ListLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LabelService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cmi.v1.LabelService",
 	HandlerType: (*LabelServiceServer)(nil),
@@ -1530,6 +2982,18 @@ var _LabelService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLabel",
 			Handler:    _LabelService_DeleteLabel_Handler,
 		},
+		{
+			MethodName: "CreateLabels",
+			Handler:    _LabelService_CreateLabels_Handler,
+		},
+		{
+			MethodName: "DeleteLabels",
+			Handler:    _LabelService_DeleteLabels_Handler,
+		},
+		{
+			MethodName: "ListLabels",
+			Handler:    _LabelService_ListLabels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cmi.proto",
@@ -1541,6 +3005,11 @@ var _LabelService_serviceDesc = grpc.ServiceDesc{
 type CollectorClient interface {
 	// collect storage info
 	Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error)
+	// collect storage info in stream mode
+	// Each response carries a part of the details, e.g. one page of storage data.
+	CollectStream(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (Collector_CollectStreamClient, error)
+	// get the collect types and indicators supported by the backend
+	GetCollectCapabilities(ctx context.Context, in *GetCollectCapabilitiesRequest, opts ...grpc.CallOption) (*GetCollectCapabilitiesResponse, error)
 }
 
 type collectorClient struct {
@@ -1560,10 +3029,56 @@ func (c *collectorClient) Collect(ctx context.Context, in *CollectRequest, opts
 	return out, nil
 }
 
+func (c *collectorClient) CollectStream(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (Collector_CollectStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Collector_serviceDesc.Streams[0], "/cmi.v1.Collector/CollectStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &collectorCollectStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Collector_CollectStreamClient interface {
+	Recv() (*CollectResponse, error)
+	grpc.ClientStream
+}
+
+type collectorCollectStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *collectorCollectStreamClient) Recv() (*CollectResponse, error) {
+	m := new(CollectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *collectorClient) GetCollectCapabilities(ctx context.Context, in *GetCollectCapabilitiesRequest, opts ...grpc.CallOption) (*GetCollectCapabilitiesResponse, error) {
+	out := new(GetCollectCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/cmi.v1.Collector/GetCollectCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectorServer is the server API for Collector service.
 type CollectorServer interface {
 	// collect storage info
 	Collect(context.Context, *CollectRequest) (*CollectResponse, error)
+	// collect storage info in stream mode
+	// Each response carries a part of the details, e.g. one page of storage data.
+	CollectStream(*CollectRequest, Collector_CollectStreamServer) error
+	// get the collect types and indicators supported by the backend
+	GetCollectCapabilities(context.Context, *GetCollectCapabilitiesRequest) (*GetCollectCapabilitiesResponse, error)
 }
 
 // UnimplementedCollectorServer can be embedded to have forward compatible implementations.
@@ -1573,6 +3088,12 @@ type UnimplementedCollectorServer struct {
 func (*UnimplementedCollectorServer) Collect(context.Context, *CollectRequest) (*CollectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Collect not implemented")
 }
+func (*UnimplementedCollectorServer) CollectStream(*CollectRequest, Collector_CollectStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CollectStream not implemented")
+}
+func (*UnimplementedCollectorServer) GetCollectCapabilities(context.Context, *GetCollectCapabilitiesRequest) (*GetCollectCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectCapabilities not implemented")
+}
 
 func RegisterCollectorServer(s *grpc.Server, srv CollectorServer) {
 	s.RegisterService(&_Collector_serviceDesc, srv)
@@ -1596,6 +3117,45 @@ func _Collector_Collect_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Collector_CollectStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CollectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollectorServer).CollectStream(m, &collectorCollectStreamServer{stream})
+}
+
+type Collector_CollectStreamServer interface {
+	Send(*CollectResponse) error
+	grpc.ServerStream
+}
+
+type collectorCollectStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *collectorCollectStreamServer) Send(m *CollectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Collector_GetCollectCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorServer).GetCollectCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cmi.v1.Collector/GetCollectCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorServer).GetCollectCapabilities(ctx, req.(*GetCollectCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Collector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cmi.v1.Collector",
 	HandlerType: (*CollectorServer)(nil),
@@ -1604,7 +3164,17 @@ var _Collector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Collect",
 			Handler:    _Collector_Collect_Handler,
 		},
+		{
+			MethodName: "GetCollectCapabilities",
+			Handler:    _Collector_GetCollectCapabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CollectStream",
+			Handler:       _Collector_CollectStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cmi.proto",
 }
@@ -0,0 +1,213 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package cmi provides grpc clients
+package cmi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	unixPrefix = "unix://"
+	tcpPrefix  = "tcp://"
+)
+
+// TLSOption contains the certificate files used to build the mutual tls credentials,
+// the files are usually mounted from a secret and will be reloaded when they are changed
+type TLSOption struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+	// ServerName is used to verify the hostname of server certificate, only used by client
+	ServerName string
+}
+
+// ParseAddress parse the address to network and address, e.g. unix:///cmi/cmi.sock, tcp://0.0.0.0:9090
+func ParseAddress(address string) (string, string, error) {
+	if strings.HasPrefix(address, "/") {
+		// It looks like filesystem path.
+		return "unix", address, nil
+	}
+
+	if strings.HasPrefix(address, unixPrefix) {
+		return "unix", strings.TrimPrefix(address, unixPrefix), nil
+	}
+
+	if strings.HasPrefix(address, tcpPrefix) {
+		return "tcp", strings.TrimPrefix(address, tcpPrefix), nil
+	}
+
+	return "", "", fmt.Errorf("invalid address [%s], only unix domain path and tcp address are supported", address)
+}
+
+// NewServerCredentials build the server credentials which requires and verifies the client certificates
+func NewServerCredentials(option *TLSOption) (credentials.TransportCredentials, error) {
+	reloader, err := newCertReloader(option)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool, err := reloader.load()
+			if err != nil {
+				return nil, err
+			}
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    caPool,
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+			}, nil
+		},
+	}), nil
+}
+
+// NewClientCredentials build the client credentials which presents the client certificate
+// and verifies the server certificate
+func NewClientCredentials(option *TLSOption) (credentials.TransportCredentials, error) {
+	reloader, err := newCertReloader(option)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: option.ServerName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _, err := reloader.load()
+			return cert, err
+		},
+		// the server certificate is verified in VerifyConnection with the latest ca,
+		// because RootCAs can not be reloaded after the connection is created.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			_, caPool, err := reloader.load()
+			if err != nil {
+				return err
+			}
+			return verifyServerCertificate(state, caPool, option.ServerName)
+		},
+	}), nil
+}
+
+func verifyServerCertificate(state tls.ConnectionState, caPool *x509.CertPool, serverName string) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server certificate is not provided")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if serverName == "" {
+		serverName = state.ServerName
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         caPool,
+		Intermediates: intermediates,
+	})
+	if err != nil {
+		return fmt.Errorf("verify server certificate failed, error: %v", err)
+	}
+	return nil
+}
+
+// certReloader reloads the certificate and ca when the files are modified
+type certReloader struct {
+	option  *TLSOption
+	mutex   sync.Mutex
+	modTime time.Time
+	cert    *tls.Certificate
+	caPool  *x509.CertPool
+}
+
+func newCertReloader(option *TLSOption) (*certReloader, error) {
+	if option == nil || option.CertFile == "" || option.KeyFile == "" || option.CAFile == "" {
+		return nil, errors.New("cert file, key file and ca file are required by mutual tls")
+	}
+
+	reloader := &certReloader{option: option}
+	if _, _, err := reloader.load(); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+// load returns the cached certificate and ca, which will be reloaded if any file is modified
+func (r *certReloader) load() (*tls.Certificate, *x509.CertPool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if r.cert != nil && modTime.Equal(r.modTime) {
+		return r.cert, r.caPool, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.option.CertFile, r.option.KeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load x509 key pair failed, error: %v", err)
+	}
+
+	caData, err := os.ReadFile(r.option.CAFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read ca file failed, error: %v", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caData) {
+		return nil, nil, fmt.Errorf("parse ca file [%s] failed", r.option.CAFile)
+	}
+
+	if r.cert != nil {
+		log.Infof("certificates of cmi tls are reloaded")
+	}
+	r.cert, r.caPool, r.modTime = &cert, caPool, modTime
+	return r.cert, r.caPool, nil
+}
+
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{r.option.CertFile, r.option.KeyFile, r.option.CAFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return latest, fmt.Errorf("stat file [%s] failed, error: %v", file, err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
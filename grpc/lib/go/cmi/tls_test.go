@@ -0,0 +1,172 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package cmi provides grpc clients
+package cmi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		wantNetwork string
+		wantTarget  string
+		wantErr     bool
+	}{
+		{name: "filesystem path", address: "/cmi/cmi.sock", wantNetwork: "unix", wantTarget: "/cmi/cmi.sock"},
+		{name: "unix address", address: "unix:///cmi/cmi.sock", wantNetwork: "unix", wantTarget: "/cmi/cmi.sock"},
+		{name: "tcp address", address: "tcp://cmi.huawei-csm:9090", wantNetwork: "tcp",
+			wantTarget: "cmi.huawei-csm:9090"},
+		{name: "invalid address", address: "cmi.huawei-csm:9090", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			network, target, err := ParseAddress(tt.address)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAddress() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if network != tt.wantNetwork || target != tt.wantTarget {
+				t.Errorf("ParseAddress() got = %s, %s, want %s, %s", network, target, tt.wantNetwork, tt.wantTarget)
+			}
+		})
+	}
+}
+
+func TestGetClientSetWithTLS_MutualTLS(t *testing.T) {
+	// arrange
+	dir := t.TempDir()
+	caCert, caKey := writeTestCert(t, dir, "ca", nil, nil)
+	writeTestCert(t, dir, "server", caCert, caKey)
+	writeTestCert(t, dir, "client", caCert, caKey)
+	serverCreds, err := NewServerCredentials(testTLSOption(dir, "server"))
+	if err != nil {
+		t.Fatalf("NewServerCredentials() error = %v", err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen tcp failed, error = %v", err)
+	}
+	server := grpc.NewServer(grpc.Creds(serverCreds))
+	RegisterIdentityServer(server, &UnimplementedIdentityServer{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	// action
+	clientSet, err := GetClientSetWithTLS(tcpPrefix+lis.Addr().String(), testTLSOption(dir, "client"))
+	if err != nil {
+		t.Fatalf("GetClientSetWithTLS() error = %v", err)
+	}
+	defer clientSet.Conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = clientSet.IdentityClient.Probe(ctx, &ProbeRequest{})
+
+	// assert
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Probe() want the handshake success and get unimplemented, but got error = %v", err)
+	}
+}
+
+func TestGetClientSetWithTLS_TcpWithoutTLS(t *testing.T) {
+	// action
+	_, err := GetClientSetWithTLS("tcp://127.0.0.1:9090", nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("GetClientSetWithTLS() want error when tls is not configured for tcp, but got nil")
+	}
+}
+
+func testTLSOption(dir, name string) *TLSOption {
+	return &TLSOption{
+		CertFile:   filepath.Join(dir, name+".crt"),
+		KeyFile:    filepath.Join(dir, name+".key"),
+		CAFile:     filepath.Join(dir, "ca.crt"),
+		ServerName: "127.0.0.1",
+	}
+}
+
+func writeTestCert(t *testing.T, dir, name string, parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key failed, error = %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("create certificate failed, error = %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key failed, error = %v", err)
+	}
+
+	writePem(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	writePem(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyDer)
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate failed, error = %v", err)
+	}
+	return cert, key
+}
+
+func writePem(t *testing.T, file, blockType string, data []byte) {
+	err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0600)
+	if err != nil {
+		t.Fatalf("write file [%s] failed, error = %v", file, err)
+	}
+}
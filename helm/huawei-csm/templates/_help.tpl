@@ -14,4 +14,58 @@
 - --log-level={{ .level | default "info" }}
 - --log-file-size={{ .fileSize | default "20M" }}
 - --max-backups={{ .maxBackups | default 9 }}
-{{- end -}}
\ No newline at end of file
+{{- end -}}
+{{- define "cmi-listen-address" -}}
+{{- if (((.Values.features).cmi).tls).enabled -}}
+tcp://:{{ .Values.features.cmi.tls.port | default 9811 }}
+{{- else -}}
+{{ ((.Values.features).cmi).socket | default "/cmi/cmi.sock" }}
+{{- end -}}
+{{- end -}}
+
+{{- define "cmi-service-host" -}}
+{{- $namespace := (.Values.global).namespace | default "huawei-csm" -}}
+{{ (((.Values.features).cmi).tls).address | default (printf "huawei-csm-cmi.%s.svc" $namespace) }}
+{{- end -}}
+
+{{- define "cmi-dial-address" -}}
+{{- if (((.Values.features).cmi).tls).enabled -}}
+tcp://{{ include "cmi-service-host" . }}:{{ .Values.features.cmi.tls.port | default 9811 }}
+{{- else -}}
+{{ ((.Values.features).cmi).socket | default "/cmi/cmi.sock" }}
+{{- end -}}
+{{- end -}}
+
+{{- define "cmi-local-address" -}}
+{{- if (((.Values.features).cmi).tls).enabled -}}
+tcp://localhost:{{ .Values.features.cmi.tls.port | default 9811 }}
+{{- else -}}
+{{ ((.Values.features).cmi).socket | default "/cmi/cmi.sock" }}
+{{- end -}}
+{{- end -}}
+
+{{- define "cmi-tls-args" -}}
+{{- if (((.Values.features).cmi).tls).enabled -}}
+- --cmi-tls-cert-file=/etc/cmi-tls/tls.crt
+- --cmi-tls-key-file=/etc/cmi-tls/tls.key
+- --cmi-tls-ca-file=/etc/cmi-tls/ca.crt
+- --cmi-tls-server-name={{ .Values.features.cmi.tls.serverName | default (include "cmi-service-host" .) }}
+{{- end -}}
+{{- end -}}
+
+{{- define "cmi-tls-volume-mount" -}}
+{{- if (((.Values.features).cmi).tls).enabled -}}
+- name: cmi-tls
+  mountPath: /etc/cmi-tls
+  readOnly: true
+{{- end -}}
+{{- end -}}
+
+{{- define "cmi-tls-volume" -}}
+{{- if (((.Values.features).cmi).tls).enabled -}}
+- name: cmi-tls
+  secret:
+    secretName: {{ .Values.features.cmi.tls.secretName | default "huawei-csm-cmi-tls" }}
+    defaultMode: 0400
+{{- end -}}
+{{- end -}}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -36,5 +36,5 @@ func GetClientByBackendName(ctx context.Context, backendName string) (ClientInfo
 	}
 
 	return NewClientInfoBuilder(ctx).
-		WithVolumeType(config.StorageType).WithClient(config).Build()
+		WithVolumeType(config.StorageType).WithClient(config).WithProfile().Build()
 }
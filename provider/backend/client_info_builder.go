@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,16 +19,44 @@ package backend
 
 import (
 	"context"
+	"errors"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/provider/health"
+	"github.com/huawei/csm/v2/storage/client"
 	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/storage/client/distributedstorage"
 	"github.com/huawei/csm/v2/storage/constant"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
+// storageClients storage client builders
+// Map key is the storage field of the backend.
+// Map value is the builder of the client which is used to operate the storage.
+var storageClients = map[string]storageClientBuilder{
+	constants.StorageNas:       {storageType: constants.OceanStorage, newClient: newCentralizedClient},
+	constants.StorageSan:       {storageType: constants.OceanStorage, newClient: newCentralizedClient},
+	constants.StorageFusionNas: {storageType: constants.DistributedStorage, newClient: newDistributedClient},
+	constants.StorageFusionSan: {storageType: constants.DistributedStorage, newClient: newDistributedClient},
+}
+
+// loginClient storage client which need to log in before used
+type loginClient interface {
+	Login(ctx context.Context) error
+}
+
+// callStateObserver the client which reports the changes of its call state
+type callStateObserver interface {
+	ObserveCallState(observer func(client.CallRecord))
+}
+
+// storageClientBuilder build the client and specify the storage type of the client
+type storageClientBuilder struct {
+	storageType string
+	newClient   func(context.Context, *constant.StorageBackendConfig) (loginClient, error)
+}
+
 // ClientInfoBuilder client builder
 type ClientInfoBuilder struct {
 	ctx        context.Context
@@ -54,7 +82,7 @@ func (b *ClientInfoBuilder) WithVolumeType(storageType string) *ClientInfoBuilde
 
 	volumeType, ok := volumeTypes[storageType]
 	if !ok {
-		b.err = status.Errorf(codes.InvalidArgument, "illegalArgumentError unsupported storage type")
+		b.err = cmierror.New(cmierror.InvalidArgument, "unsupported storage type [%s]", storageType)
 	}
 	b.clientInfo.VolumeType = volumeType
 	return b
@@ -66,9 +94,15 @@ func (b *ClientInfoBuilder) WithClient(config *constant.StorageBackendConfig) *C
 		return b
 	}
 
-	client, err := centralizedstorage.NewCentralizedClient(b.ctx, config)
+	clientBuilder, ok := storageClients[config.StorageType]
+	if !ok {
+		b.err = cmierror.New(cmierror.InvalidArgument, "unsupported storage type [%s]", config.StorageType)
+		return b
+	}
+
+	client, err := clientBuilder.newClient(b.ctx, config)
 	if err != nil {
-		log.AddContext(b.ctx).Errorf("init centralized client failed, backendName: %s, error: %v",
+		log.AddContext(b.ctx).Errorf("init storage client failed, backendName: %s, error: %v",
 			config.StorageBackendName, err)
 		b.err = err
 		return b
@@ -77,12 +111,44 @@ func (b *ClientInfoBuilder) WithClient(config *constant.StorageBackendConfig) *C
 	if err := client.Login(b.ctx); err != nil {
 		log.AddContext(b.ctx).Errorf("login storage failed, backendName: %s, error: %v",
 			config.StorageBackendName, err)
-		b.err = err
+		health.SetLoginState(config.StorageBackendName, err)
+		b.err = convertLoginError(err)
 		return b
 	}
+	health.SetLoginState(config.StorageBackendName, nil)
+	observeCallState(config.StorageBackendName, client)
 
 	b.clientInfo.StorageName = config.StorageBackendName
-	b.clientInfo.StorageType = constants.OceanStorage
+	b.clientInfo.StorageType = clientBuilder.storageType
 	b.clientInfo.Client = client
 	return b
 }
+
+func newCentralizedClient(ctx context.Context, config *constant.StorageBackendConfig) (loginClient, error) {
+	return centralizedstorage.NewCentralizedClient(ctx, config)
+}
+
+func newDistributedClient(ctx context.Context, config *constant.StorageBackendConfig) (loginClient, error) {
+	return distributedstorage.NewDistributedClient(ctx, config)
+}
+
+// convertLoginError the storage is unavailable if none of the urls can be connected,
+// otherwise the login is rejected by the storage, e.g. wrong password or locked account
+func convertLoginError(err error) error {
+	if errors.Is(err, client.ErrConnectFailed) {
+		return cmierror.Wrap(cmierror.BackendUnavailable, err, "connect storage failed")
+	}
+	return cmierror.Wrap(cmierror.AuthFailure, err, "login storage failed")
+}
+
+// observeCallState update the health state of backend when the calls of the client become succeeded or failed,
+// so the health status does not wait for the next login
+func observeCallState(backendName string, storageClient loginClient) {
+	observer, ok := storageClient.(callStateObserver)
+	if !ok {
+		return
+	}
+	observer.ObserveCallState(func(record client.CallRecord) {
+		health.SetCallState(backendName, record.Available(), record.LastError)
+	})
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -23,10 +23,11 @@ import (
 	"reflect"
 	"testing"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/agiledragon/gomonkey/v2"
 
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/distributedstorage"
 	"github.com/huawei/csm/v2/storage/constant"
 )
 
@@ -49,7 +50,7 @@ func TestClientInfoBuilder_WithVolumeType_Success(t *testing.T) {
 
 func TestClientInfoBuilder_WithVolumeType_UnsupportedErr(t *testing.T) {
 	// arrange
-	wantErr := status.Errorf(codes.InvalidArgument, "illegalArgumentError unsupported storage type")
+	wantErr := cmierror.New(cmierror.InvalidArgument, "unsupported storage type [noneType]")
 	builder := &ClientInfoBuilder{
 		ctx:        context.Background(),
 		clientInfo: &ClientInfo{},
@@ -102,3 +103,34 @@ func TestClientInfoBuilder_WithClient_ErrExisted(t *testing.T) {
 			wantErr, getRes.err)
 	}
 }
+
+func TestClientInfoBuilder_WithClient_DistributedStorage(t *testing.T) {
+	// arrange
+	client := &distributedstorage.DistributedClient{}
+	config := &constant.StorageBackendConfig{StorageType: constants.StorageFusionSan, StorageBackendName: "pacific"}
+	builder := NewClientInfoBuilder(context.Background())
+
+	// mock
+	patches := gomonkey.ApplyFunc(distributedstorage.NewDistributedClient,
+		func(context.Context, *constant.StorageBackendConfig) (*distributedstorage.DistributedClient, error) {
+			return client, nil
+		}).
+		ApplyMethod(client, "Login", func(*distributedstorage.DistributedClient, context.Context) error {
+			return nil
+		})
+	defer patches.Reset()
+
+	// act
+	got, err := builder.WithVolumeType(config.StorageType).WithClient(config).Build()
+
+	// assert
+	if err != nil {
+		t.Errorf("TestClientInfoBuilder_WithClient_DistributedStorage failed, error = %v", err)
+		return
+	}
+	want := ClientInfo{StorageName: "pacific", StorageType: constants.DistributedStorage,
+		VolumeType: constants.LunVolume, Client: client}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("TestClientInfoBuilder_WithClient_DistributedStorage failed, want = %v, got = %v", want, got)
+	}
+}
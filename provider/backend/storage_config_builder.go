@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -23,10 +23,12 @@ import (
 
 	xuanwuV1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
 	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
 	cmiConfig "github.com/huawei/csm/v2/config/cmi"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/provider/grpc/helper"
 	"github.com/huawei/csm/v2/provider/utils"
@@ -38,8 +40,10 @@ import (
 // Map key is the storage field of the backend.
 // Map key is the volume type.
 var volumeTypes = map[string]string{
-	constants.StorageNas: constants.NasVolume,
-	constants.StorageSan: constants.LunVolume,
+	constants.StorageNas:       constants.NasVolume,
+	constants.StorageSan:       constants.LunVolume,
+	constants.StorageFusionNas: constants.NasVolume,
+	constants.StorageFusionSan: constants.LunVolume,
 }
 
 // StorageBackendConfigBuilder storage backend config builder
@@ -71,6 +75,9 @@ func (b *StorageBackendConfigBuilder) WithSbcInfo() *StorageBackendConfigBuilder
 		Get(b.ctx, b.backendName, metaV1.GetOptions{})
 	if err != nil {
 		log.AddContext(b.ctx).Errorf("Get StorageBackendClaims failed, error: %v", err)
+		if apiErrors.IsNotFound(err) {
+			err = cmierror.Wrap(cmierror.NotFound, err, "backend [%s] does not exist", b.backendName)
+		}
 		b.err = err
 		return b
 	}
@@ -0,0 +1,203 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package backend is a package that manager storage backend
+package backend
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/provider/utils"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/storage/client/distributedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// profileDetectors storage profile detectors
+// Map key is the storage type.
+// Map value is the function which detects the version and features of the storage by the client.
+var profileDetectors = map[string]func(context.Context, interface{}) (StorageProfile, error){
+	constants.OceanStorage:       detectCentralizedProfile,
+	constants.DistributedStorage: detectDistributedProfile,
+}
+
+// profileMaxAge the max age of the storage profile, the profile is detected again after it,
+// so that the features changed by the storage upgrade are found
+const profileMaxAge = time.Hour
+
+// indicatorsCapability the performance indicators available for the object type
+type indicatorsCapability struct {
+	ObjectType int   `json:"object_type"`
+	Indicators []int `json:"indicators"`
+}
+
+// StorageProfile the version and features of the storage, which are detected after login and refreshed
+// when it is older than profileMaxAge
+type StorageProfile struct {
+	// whether the profile is detected, the features are unknown if not
+	Detected bool
+	// the time when the profile is detected
+	DetectedAt time.Time
+	// product version of the storage, e.g. V700R001C00
+	ProductVersion string
+	// point release of the storage, e.g. 6.1.2, the storage of V3 or V5 does not have it
+	PointRelease string
+	// whether the performance data can be queried by post request, it is supported since 6.1.2
+	SupportPerformancePost bool
+	// the collect types which have available performance indicators in the storage,
+	// it is nil if the storage does not support querying the available indicators
+	PerformanceCollectTypes []string
+}
+
+// SupportPerformanceCollectType whether the storage supports collecting the performance data of the collect type,
+// all collect types are regarded as supported if the supported collect types are unknown
+func (p StorageProfile) SupportPerformanceCollectType(collectType string) bool {
+	if !p.Detected || p.PerformanceCollectTypes == nil {
+		return true
+	}
+	for _, supported := range p.PerformanceCollectTypes {
+		if supported == collectType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStale whether the profile is not detected or older than profileMaxAge, it should be detected again then
+func (p StorageProfile) IsStale() bool {
+	return !p.Detected || time.Since(p.DetectedAt) > profileMaxAge
+}
+
+// WithProfile build with the profile of the storage, it should be called after WithClient
+// The build will not fail if the profile can not be detected, the profile will be detected again when used.
+func (b *ClientInfoBuilder) WithProfile() *ClientInfoBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	detect, ok := profileDetectors[b.clientInfo.StorageType]
+	if !ok {
+		return b
+	}
+
+	profile, err := detect(b.ctx, b.clientInfo.Client)
+	if err != nil {
+		log.AddContext(b.ctx).Warningf("detect storage profile failed, backendName: %s, error: %v",
+			b.clientInfo.StorageName, err)
+		return b
+	}
+
+	log.AddContext(b.ctx).Infof("detect storage profile success, backendName: %s, profile: %+v",
+		b.clientInfo.StorageName, profile)
+	b.clientInfo.Profile = profile
+	return b
+}
+
+// DetectCentralizedProfile detect the version and features of the centralized storage
+func DetectCentralizedProfile(ctx context.Context,
+	client *centralizedstorage.CentralizedClient) (StorageProfile, error) {
+	profile, err := DetectCentralizedVersion(ctx, client)
+	if err != nil {
+		return StorageProfile{}, err
+	}
+
+	profile.PerformanceCollectTypes = detectPerformanceCollectTypes(ctx, client)
+	return profile, nil
+}
+
+// DetectCentralizedVersion detect the version of the centralized storage and the features decided by the version,
+// the supported performance collect types are left unknown
+func DetectCentralizedVersion(ctx context.Context,
+	client *centralizedstorage.CentralizedClient) (StorageProfile, error) {
+	storageInfo, err := client.GetSystemInfo(ctx)
+	if err != nil {
+		return StorageProfile{}, err
+	}
+
+	// storage of V3 or V5 not has the pointRelease field
+	pointRelease, _ := storageInfo["pointRelease"].(string)
+	productVersion, _ := storageInfo["PRODUCTVERSION"].(string)
+	return StorageProfile{
+		Detected:       true,
+		DetectedAt:     time.Now(),
+		ProductVersion: productVersion,
+		PointRelease:   pointRelease,
+		// 6.1.2 and later versions support the Post request
+		SupportPerformancePost: utils.CompareVersions(pointRelease, constants.MinVersionSupportPost) != -1,
+	}, nil
+}
+
+// detectPerformanceCollectTypes query the available performance indicators of each collect type,
+// nil is returned if the storage does not support the query
+func detectPerformanceCollectTypes(ctx context.Context, client *centralizedstorage.CentralizedClient) []string {
+	collectTypes := []string{}
+	for collectType, objectType := range constants.PerformanceObjectTypes {
+		data, err := client.GetPerformanceIndicators(ctx, objectType)
+		if err != nil {
+			log.AddContext(ctx).Infof("query available indicators of object type [%d] failed, "+
+				"the supported collect types are unknown, error: %v", objectType, err)
+			return nil
+		}
+
+		capabilities, err := utils.MapToStructSlice[[]map[string]interface{}, indicatorsCapability](data)
+		if err != nil {
+			log.AddContext(ctx).Warningf("convert available indicators of object type [%d] failed, error: %v",
+				objectType, err)
+			continue
+		}
+		for _, capability := range capabilities {
+			if capability.ObjectType == objectType && len(capability.Indicators) != 0 {
+				collectTypes = append(collectTypes, collectType)
+				break
+			}
+		}
+	}
+	sort.Strings(collectTypes)
+	return collectTypes
+}
+
+// DetectDistributedProfile detect the version of the distributed storage, the performance data of
+// the distributed storage is always queried by get request
+func DetectDistributedProfile(ctx context.Context,
+	client *distributedstorage.DistributedClient) (StorageProfile, error) {
+	storageInfo, err := client.GetSystemInfo(ctx)
+	if err != nil {
+		return StorageProfile{}, err
+	}
+
+	productVersion, _ := storageInfo["version"].(string)
+	return StorageProfile{Detected: true, DetectedAt: time.Now(), ProductVersion: productVersion}, nil
+}
+
+func detectCentralizedProfile(ctx context.Context, client interface{}) (StorageProfile, error) {
+	centralizedClient, ok := client.(*centralizedstorage.CentralizedClient)
+	if !ok {
+		return StorageProfile{}, errors.New("convert client to centralized storage client failed")
+	}
+	return DetectCentralizedProfile(ctx, centralizedClient)
+}
+
+func detectDistributedProfile(ctx context.Context, client interface{}) (StorageProfile, error) {
+	distributedClient, ok := client.(*distributedstorage.DistributedClient)
+	if !ok {
+		return StorageProfile{}, errors.New("convert client to distributed storage client failed")
+	}
+	return DetectDistributedProfile(ctx, distributedClient)
+}
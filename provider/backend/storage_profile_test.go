@@ -0,0 +1,169 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package backend is a package that manager storage backend
+package backend
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestDetectCentralizedProfile_with_storage_V612(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(client, "GetSystemInfo", func(ctx context.Context) (
+		map[string]interface{}, error) {
+		return map[string]interface{}{"pointRelease": "6.1.2", "PRODUCTVERSION": "V600R005C20"}, nil
+	}).ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+		objectType int) ([]map[string]interface{}, error) {
+		if objectType == constants.PerformanceObjectTypes[constants.Lun] {
+			return []map[string]interface{}{{"object_type": objectType, "indicators": []int{22}}}, nil
+		}
+		return []map[string]interface{}{}, nil
+	})
+	defer patches.Reset()
+
+	// act
+	got, err := DetectCentralizedProfile(context.Background(), client)
+
+	// assert
+	if got.IsStale() {
+		t.Errorf("TestDetectCentralizedProfile_with_storage_V612 failed, want detected time set, got = %v", got.DetectedAt)
+	}
+	got.DetectedAt = time.Time{}
+	want := StorageProfile{Detected: true, ProductVersion: "V600R005C20", PointRelease: "6.1.2",
+		SupportPerformancePost: true, PerformanceCollectTypes: []string{constants.Lun}}
+	if err != nil || !reflect.DeepEqual(want, got) {
+		t.Errorf("TestDetectCentralizedProfile_with_storage_V612 failed, want = %+v, got = %+v, error = %v",
+			want, got, err)
+	}
+}
+
+func TestDetectCentralizedProfile_with_storage_V5(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(client, "GetSystemInfo", func(ctx context.Context) (
+		map[string]interface{}, error) {
+		return map[string]interface{}{"PRODUCTVERSION": "V500R007C60"}, nil
+	}).ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+		objectType int) ([]map[string]interface{}, error) {
+		return nil, errors.New("not supported")
+	})
+	defer patches.Reset()
+
+	// act
+	got, err := DetectCentralizedProfile(context.Background(), client)
+
+	// assert
+	if got.IsStale() {
+		t.Errorf("TestDetectCentralizedProfile_with_storage_V5 failed, want detected time set, got = %v", got.DetectedAt)
+	}
+	got.DetectedAt = time.Time{}
+	want := StorageProfile{Detected: true, ProductVersion: "V500R007C60"}
+	if err != nil || !reflect.DeepEqual(want, got) {
+		t.Errorf("TestDetectCentralizedProfile_with_storage_V5 failed, want = %+v, got = %+v, error = %v",
+			want, got, err)
+	}
+	if !got.SupportPerformanceCollectType(constants.Lun) {
+		t.Errorf("TestDetectCentralizedProfile_with_storage_V5 failed, want unknown collect types supported")
+	}
+}
+
+func TestClientInfoBuilder_WithProfile_DetectFailed(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	builder := &ClientInfoBuilder{
+		ctx:        context.Background(),
+		clientInfo: &ClientInfo{StorageType: constants.OceanStorage, Client: client},
+	}
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(client, "GetSystemInfo", func(ctx context.Context) (
+		map[string]interface{}, error) {
+		return nil, errors.New("get system info failed")
+	})
+	defer patches.Reset()
+
+	// act
+	got, err := builder.WithProfile().Build()
+
+	// assert
+	if err != nil || got.Profile.Detected {
+		t.Errorf("TestClientInfoBuilder_WithProfile_DetectFailed failed, want undetected profile without error, "+
+			"got = %+v, error = %v", got.Profile, err)
+	}
+}
+
+func TestStorageProfile_SupportPerformanceCollectType(t *testing.T) {
+	// arrange
+	tests := []struct {
+		name    string
+		profile StorageProfile
+		want    bool
+	}{
+		{name: "undetected profile", profile: StorageProfile{}, want: true},
+		{name: "unknown collect types", profile: StorageProfile{Detected: true}, want: true},
+		{name: "supported collect type", want: true,
+			profile: StorageProfile{Detected: true, PerformanceCollectTypes: []string{constants.Lun}}},
+		{name: "unsupported collect type", want: false,
+			profile: StorageProfile{Detected: true, PerformanceCollectTypes: []string{constants.Filesystem}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// act
+			got := tt.profile.SupportPerformanceCollectType(constants.Lun)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("SupportPerformanceCollectType() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStorageProfile_IsStale(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile StorageProfile
+		want    bool
+	}{
+		{name: "not detected", profile: StorageProfile{}, want: true},
+		{name: "detected just now", profile: StorageProfile{Detected: true, DetectedAt: time.Now()}, want: false},
+		{name: "detected too long ago",
+			profile: StorageProfile{Detected: true, DetectedAt: time.Now().Add(-2 * profileMaxAge)}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.profile.IsStale(); got != tt.want {
+				t.Errorf("IsStale() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
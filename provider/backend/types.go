@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -27,4 +27,6 @@ type ClientInfo struct {
 	VolumeType string
 	// storage Client
 	Client interface{}
+	// version and features of the storage detected after login
+	Profile StorageProfile
 }
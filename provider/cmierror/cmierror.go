@@ -0,0 +1,181 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package cmierror is a package that defines the typed errors of cmi, which are mapped to grpc status codes
+package cmierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/storage/httpcode"
+	"github.com/huawei/csm/v2/storage/utils"
+)
+
+// Domain is the domain of the ErrorInfo details in grpc status
+const Domain = "cmi.huawei.com"
+
+// Reason is the reason of cmi error, which is set to the ErrorInfo details in grpc status
+type Reason string
+
+const (
+	// InvalidArgument the request is invalid, retrying the same request will never succeed
+	InvalidArgument Reason = "INVALID_ARGUMENT"
+	// NotFound the resource in request, e.g. backend or volume, does not exist
+	NotFound Reason = "NOT_FOUND"
+	// BackendUnavailable the storage can not be connected
+	BackendUnavailable Reason = "BACKEND_UNAVAILABLE"
+	// AuthFailure the storage can not be logged in, e.g. the password is wrong or the account is locked
+	AuthFailure Reason = "AUTH_FAILURE"
+	// StorageBusy the storage is busy, the request can be retried later
+	StorageBusy Reason = "STORAGE_BUSY"
+)
+
+// reasonCodes is the mapping of error reason and grpc status code
+var reasonCodes = map[Reason]codes.Code{
+	InvalidArgument:    codes.InvalidArgument,
+	NotFound:           codes.NotFound,
+	BackendUnavailable: codes.Unavailable,
+	AuthFailure:        codes.Unauthenticated,
+	StorageBusy:        codes.ResourceExhausted,
+}
+
+// Error is the typed error of cmi, it will be converted to the grpc status with code and details
+type Error struct {
+	Reason  Reason
+	Message string
+	Cause   error
+}
+
+// Error implements error interface
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s, error: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+// Unwrap returns the cause of error
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// GRPCStatus converts the error to grpc status, which is used by grpc server to build the response
+func (e *Error) GRPCStatus() *status.Status {
+	code, ok := reasonCodes[e.Reason]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	st := status.New(code, e.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: string(e.Reason), Domain: Domain})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// New returns a typed error with the reason
+func New(reason Reason, format string, args ...interface{}) error {
+	return &Error{Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap returns a typed error with the reason and the cause
+func Wrap(reason Reason, cause error, format string, args ...interface{}) error {
+	return &Error{Reason: reason, Message: fmt.Sprintf(format, args...), Cause: cause}
+}
+
+// GetReason get the reason of error, the error can be a typed error or an error returned by grpc client
+func GetReason(err error) (Reason, bool) {
+	var cmiErr *Error
+	if errors.As(err, &cmiErr) {
+		return cmiErr.Reason, true
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return "", false
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if ok && info.GetDomain() == Domain {
+			return Reason(info.GetReason()), true
+		}
+	}
+	return "", false
+}
+
+// FromStorageError converts the error returned by storage client to a typed error,
+// the error will be returned as it is if it can not be classified
+func FromStorageError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var codeErr *client.ResponseCodeError
+	if errors.As(err, &codeErr) {
+		if utils.IsFloat64InList(httpcode.RetryCodes, codeErr.Code) {
+			return Wrap(StorageBusy, err, "storage is busy")
+		}
+		if codeErr.Code == httpcode.NoAuthentication {
+			return Wrap(AuthFailure, err, "storage authentication failed")
+		}
+		return err
+	}
+
+	var urlErr *url.Error
+	if errors.Is(err, client.ErrConnectFailed) || errors.As(err, &urlErr) {
+		return Wrap(BackendUnavailable, err, "storage is unavailable")
+	}
+	return err
+}
+
+// ToStatusError converts the error to an error with grpc status,
+// so that the clients can act on the status code instead of the error message
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	return FromStorageError(err)
+}
+
+// UnaryServerInterceptor converts the error returned by the unary handler to an error with grpc status
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, ToStatusError(err)
+}
+
+// StreamServerInterceptor converts the error returned by the stream handler to an error with grpc status
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return ToStatusError(handler(srv, ss))
+}
@@ -0,0 +1,66 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package cmierror is a package that defines the typed errors of cmi, which are mapped to grpc status codes
+package cmierror
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/storage/httpcode"
+)
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason Reason
+	}{
+		{name: "invalid argument", err: New(InvalidArgument, "backend name is blank"),
+			wantCode: codes.InvalidArgument, wantReason: InvalidArgument},
+		{name: "wrapped not found", err: fmt.Errorf("prepare failed: %w", New(NotFound, "volume not found")),
+			wantCode: codes.NotFound, wantReason: NotFound},
+		{name: "storage busy", err: &client.ResponseCodeError{Code: httpcode.SystemBusy1},
+			wantCode: codes.ResourceExhausted, wantReason: StorageBusy},
+		{name: "storage no authentication", err: &client.ResponseCodeError{Code: httpcode.NoAuthentication},
+			wantCode: codes.Unauthenticated, wantReason: AuthFailure},
+		{name: "storage connect failed", err: &url.Error{Op: "Get", URL: "https://fake", Err: errors.New("refused")},
+			wantCode: codes.Unavailable, wantReason: BackendUnavailable},
+		{name: "unknown error", err: errors.New("unknown"), wantCode: codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			err := ToStatusError(tt.err)
+
+			// assert
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ToStatusError() code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+			reason, _ := GetReason(status.Convert(err).Err())
+			if reason != tt.wantReason {
+				t.Errorf("GetReason() got = %v, want %v", reason, tt.wantReason)
+			}
+		})
+	}
+}
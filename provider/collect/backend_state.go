@@ -0,0 +1,105 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"sort"
+	"time"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/health"
+	"github.com/huawei/csm/v2/storage/client"
+)
+
+// callRecorder is the client which records the results of the calls to storage
+type callRecorder interface {
+	GetCallRecord() client.CallRecord
+}
+
+// GetBackendStates get the states of the backends which have been logged in or tried to log in,
+// the login results are merged with the call results of the clients in clientCache
+func GetBackendStates() []*cmi.BackendState {
+	loginStates := health.GetLoginStates()
+	callRecords := getCallRecords()
+
+	names := make([]string, 0, len(loginStates))
+	for name := range loginStates {
+		names = append(names, name)
+	}
+	for name := range callRecords {
+		if _, ok := loginStates[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	states := make([]*cmi.BackendState, 0, len(names))
+	for _, name := range names {
+		record := mergeLoginState(callRecords[name], loginStates[name])
+		states = append(states, &cmi.BackendState{
+			BackendName:     name,
+			LoggedIn:        record.Available(),
+			LastSuccessTime: toUnix(record.LastSuccessTime),
+			LastError:       record.LastError,
+			LastErrorTime:   toUnix(record.LastErrorTime),
+		})
+	}
+	return states
+}
+
+func getCallRecords() map[string]client.CallRecord {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	records := make(map[string]client.CallRecord, len(clientCache))
+	for name, info := range clientCache {
+		recorder, ok := info.Client.(callRecorder)
+		if !ok {
+			continue
+		}
+		records[name] = recorder.GetCallRecord()
+	}
+	return records
+}
+
+// mergeLoginState a login is regarded as a call, the later one of the login and call takes precedence
+func mergeLoginState(record client.CallRecord, state health.LoginState) client.CallRecord {
+	if state.UpdateTime.IsZero() {
+		return record
+	}
+
+	if state.LoggedIn {
+		if state.UpdateTime.After(record.LastSuccessTime) {
+			record.LastSuccessTime = state.UpdateTime
+		}
+		return record
+	}
+
+	if state.UpdateTime.After(record.LastErrorTime) {
+		record.LastErrorTime = state.UpdateTime
+		record.LastError = state.LastError
+	}
+	return record
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,82 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/huawei/csm/v2/provider/backend"
+	"github.com/huawei/csm/v2/provider/health"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestGetBackendStates(t *testing.T) {
+	// arrange
+	backendNames := []string{"state-login-failed", "state-call-failed", "state-call-succeeded"}
+	defer func() {
+		for _, name := range backendNames {
+			health.RemoveBackend(name)
+			RemoveClient(name)
+		}
+	}()
+
+	// mock
+	health.SetLoginState("state-login-failed", errors.New("login failed"))
+	health.SetLoginState("state-call-failed", nil)
+	failedClient := &centralizedstorage.CentralizedClient{}
+	failedClient.CallState.Record(nil)
+	failedClient.CallState.Record(errors.New("call failed"))
+	RegisterClient("state-call-failed", backend.ClientInfo{Client: failedClient})
+	health.SetLoginState("state-call-succeeded", nil)
+	succeededClient := &centralizedstorage.CentralizedClient{}
+	succeededClient.CallState.Record(nil)
+	RegisterClient("state-call-succeeded", backend.ClientInfo{Client: succeededClient})
+
+	want := map[string]struct {
+		loggedIn  bool
+		lastError string
+	}{
+		"state-login-failed":   {loggedIn: false, lastError: "login failed"},
+		"state-call-failed":    {loggedIn: false, lastError: "call failed"},
+		"state-call-succeeded": {loggedIn: true, lastError: ""},
+	}
+
+	// action
+	states := GetBackendStates()
+
+	// assert
+	var found int
+	for _, state := range states {
+		wantState, ok := want[state.GetBackendName()]
+		if !ok {
+			continue
+		}
+		found++
+		if state.GetLoggedIn() != wantState.loggedIn || state.GetLastError() != wantState.lastError {
+			t.Errorf("GetBackendStates() backend [%s] got = %v, want = %v",
+				state.GetBackendName(), state, wantState)
+		}
+		if state.GetLoggedIn() && state.GetLastSuccessTime() == 0 {
+			t.Errorf("GetBackendStates() backend [%s] want last success time, but got 0", state.GetBackendName())
+		}
+	}
+	if found != len(want) {
+		t.Errorf("GetBackendStates() want %d backends, but found %d", len(want), found)
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -28,7 +28,7 @@ import (
 
 	"github.com/huawei/csm/v2/provider/backend"
 	"github.com/huawei/csm/v2/provider/grpc/helper"
-	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/provider/health"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -96,6 +96,7 @@ func deleteBackendCache(obj interface{}) {
 		log.Errorf("failed to convert obj to storageBackendClaim, obj is [%v]", obj)
 		return
 	}
+	health.RemoveBackend(storageBackendClaim.Name)
 
 	err := releaseCache(storageBackendClaim.Name)
 	if err != nil {
@@ -111,13 +112,15 @@ func releaseCache(backendName string) error {
 		return nil
 	}
 
-	centralizedClient, ok := client.Client.(*centralizedstorage.CentralizedClient)
+	logoutClient, ok := client.Client.(interface{ Logout(context.Context) })
 	if !ok {
-		return fmt.Errorf("backend [%s] client convert to centralizedClient failed", backendName)
+		return fmt.Errorf("backend [%s] client convert to storage client failed", backendName)
 	}
 
-	centralizedClient.Logout(context.Background())
+	logoutClient.Logout(context.Background())
 	RemoveClient(backendName)
+	InvalidateNameMapping(backendName)
+	InvalidatePerformanceSamples(backendName)
 
 	return nil
 }
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -27,6 +27,7 @@ import (
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/huawei/csm/v2/provider/backend"
+	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
 )
 
@@ -37,6 +38,7 @@ func TestReleaseCache(t *testing.T) {
 	client := &centralizedstorage.CentralizedClient{}
 	clientInfo := backend.ClientInfo{Client: client}
 	RegisterClient(backendName, clientInfo)
+	nameMappingCache.set(backendName, constants.Lun, &nameMappingEntry{})
 
 	//mock
 	patches := gomonkey.NewPatches()
@@ -50,6 +52,9 @@ func TestReleaseCache(t *testing.T) {
 	if !reflect.DeepEqual(err, wantErr) {
 		t.Errorf("releaseCache() error = %v, wantErr %v", err, wantErr)
 	}
+	if nameMappingCache.get(backendName, constants.Lun) != nil {
+		t.Errorf("releaseCache() want name mapping invalidated, but it is still cached")
+	}
 
 	//clean
 	t.Cleanup(func() {
@@ -95,6 +100,7 @@ func TestUpdateBackendCache_DifferentSpec(t *testing.T) {
 	client := &centralizedstorage.CentralizedClient{}
 	clientInfo := backend.ClientInfo{StorageName: "storage", Client: client}
 	RegisterClient(backendName, clientInfo)
+	nameMappingCache.set(backendName, constants.Lun, &nameMappingEntry{})
 
 	//mock
 	patches := gomonkey.NewPatches()
@@ -132,6 +138,7 @@ func TestDeleteBackendCache(t *testing.T) {
 	client := &centralizedstorage.CentralizedClient{}
 	clientInfo := backend.ClientInfo{StorageName: "storage", Client: client}
 	RegisterClient(backendName, clientInfo)
+	nameMappingCache.set(backendName, constants.Lun, &nameMappingEntry{})
 
 	//mock
 	patches := gomonkey.NewPatches()
@@ -0,0 +1,40 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// alarmNameKey the alarms have no NAME, the names in request are matched with the alarm name
+const alarmNameKey = "name"
+
+// CollectAlarm collect object data of current alarm in storage
+func CollectAlarm(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	alarms, err := ConcurrentPaginate(ctx, client.GetCurrentAlarmCount, client.GetCurrentAlarms)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect current alarm failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponseByName[[]map[string]interface{}, AlarmObject](alarms, request, alarmNameKey)
+}
@@ -0,0 +1,60 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestCollectAlarm(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "alarm", MetricsType: "object", NamePrefix: "Storage Pool"}
+	alarms := []map[string]interface{}{
+		{"sequence": "1", "alarmId": "0xF00D0001", "name": "Storage Pool Degraded", "level": "4",
+			"alarmObjType": "216", "alarmObjId": "0", "startTime": "1700000000"},
+		{"sequence": "2", "alarmId": "0xF00A0002", "name": "Disk Fault", "level": "3",
+			"alarmObjType": "10", "alarmObjId": "CTE0.1"},
+	}
+	want := []map[string]string{
+		{"sequence": "1", "alarmId": "0xF00D0001", "name": "Storage Pool Degraded", "level": "4",
+			"alarmObjType": "216", "alarmObjId": "0", "startTime": "1700000000"},
+	}
+
+	// mock
+	alarmCount, alarmPage := mockPageQuery(alarms)
+	patches := gomonkey.ApplyMethod(client, "GetCurrentAlarmCount", alarmCount).
+		ApplyMethod(client, "GetCurrentAlarms", alarmPage)
+	defer patches.Reset()
+
+	// action
+	got, err := CollectAlarm(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectAlarm() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectAlarm()", got, want)
+}
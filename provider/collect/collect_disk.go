@@ -0,0 +1,60 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	diskDomainIdKey   = "POOLID"
+	diskDomainNameKey = "diskDomainName"
+)
+
+// CollectDisk collect object data of disk in storage, the disks have no name, so they can only be filtered by ids
+func CollectDisk(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	disks, err := ConcurrentPaginate(ctx, client.GetDiskCount, client.GetDisks)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect disk failed, error: %v", err)
+		return nil, err
+	}
+
+	disks = filterObjects(disks, NewObjectFilter(request))
+	if len(disks) != 0 {
+		diskDomains, err := client.GetDiskPools(ctx)
+		if err != nil {
+			log.AddContext(ctx).Errorf("collect disk domains failed, error: %v", err)
+			return nil, err
+		}
+
+		domainNames := make(map[string]string, len(diskDomains))
+		for _, diskDomain := range diskDomains {
+			domainNames[getStringValue(diskDomain, constants.Id)] = getStringValue(diskDomain, constants.Name)
+		}
+		for _, disk := range disks {
+			disk[diskDomainNameKey] = domainNames[getStringValue(disk, diskDomainIdKey)]
+		}
+	}
+	return ConvertToResponse[[]map[string]interface{}, DiskObject](disks, request)
+}
@@ -0,0 +1,63 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestCollectDisk(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "disk", MetricsType: "object", ObjectIds: []string{"1", "2"}}
+	disks := []map[string]interface{}{
+		{"ID": "1", "LOCATION": "CTE0.0", "DISKTYPE": "3", "REMAINLIFE": "98", "POOLID": "0"},
+		{"ID": "2", "LOCATION": "CTE0.1", "DISKTYPE": "1", "POOLID": ""},
+		{"ID": "3", "LOCATION": "CTE0.2", "DISKTYPE": "3", "POOLID": "0"},
+	}
+	want := []map[string]string{
+		{"ID": "1", "LOCATION": "CTE0.0", "REMAINLIFE": "98", "POOLID": "0", "diskDomainName": "domain-0"},
+		{"ID": "2", "LOCATION": "CTE0.1", "REMAINLIFE": "", "POOLID": "", "diskDomainName": ""},
+	}
+
+	// mock
+	diskCount, diskPage := mockPageQuery(disks)
+	patches := gomonkey.ApplyMethod(client, "GetDiskCount", diskCount).
+		ApplyMethod(client, "GetDisks", diskPage).
+		ApplyMethod(client, "GetDiskPools",
+			func(*centralizedstorage.CentralizedClient, context.Context) ([]map[string]interface{}, error) {
+				return []map[string]interface{}{{"ID": "0", "NAME": "domain-0"}}, nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectDisk(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectDisk() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectDisk()", got, want)
+}
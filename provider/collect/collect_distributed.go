@@ -0,0 +1,338 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/cmierror"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/provider/utils"
+	"github.com/huawei/csm/v2/storage/client/distributedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// sectorsPerMB the capacities of distributed storage are in MB, they are converted to sectors
+// which are used by the objects of ocean storage
+const sectorsPerMB = 2048
+
+// distributedPerformanceIndicators the performance indicators supported by distributed storage of each collect type,
+// the distributed storage does not support querying the available indicators, so they are reported as fixed
+var distributedPerformanceIndicators = map[string][]string{
+	constants.StoragePool: {"21", "22", "23", "25", "26", "28", "370"},
+	constants.Lun:         {"21", "22", "23", "25", "26", "28", "370"},
+	constants.Filesystem:  {"21", "22", "23", "25", "26", "28", "370", "182", "524", "525"},
+}
+
+// The objects of distributed storage are converted to the objects of the same collect type in ocean storage,
+// so that they can be parsed by the exporter in the same way
+func init() {
+	RegisterObjectHandler(constants.DistributedStorage, constants.Array, CollectDistributedArray)
+	RegisterObjectHandler(constants.DistributedStorage, constants.StoragePool, CollectDistributedStoragePool)
+	RegisterObjectHandler(constants.DistributedStorage, constants.Lun, CollectDistributedLun)
+	RegisterObjectHandler(constants.DistributedStorage, constants.Filesystem, CollectDistributedFilesystem)
+
+	RegisterPerformanceHandler(constants.DistributedStorage, constants.StoragePool,
+		GetDistributedStoragePoolNameMapping)
+	RegisterPerformanceHandler(constants.DistributedStorage, constants.Lun, GetDistributedLunNameMapping)
+	RegisterPerformanceHandler(constants.DistributedStorage, constants.Filesystem,
+		GetDistributedFilesystemNameMapping)
+}
+
+// GetDistributedIndicators get the performance indicators supported by distributed storage of the collect type
+func GetDistributedIndicators(collectType string) []string {
+	indicators, ok := distributedPerformanceIndicators[collectType]
+	if !ok {
+		return []string{}
+	}
+	return append([]string{}, indicators...)
+}
+
+// distributedObject object of distributed storage which can be converted to the object of ocean storage
+type distributedObject[T any] interface {
+	ToObject() T
+}
+
+// DistributedArray product information of distributed storage
+type DistributedArray struct {
+	Sn      string `json:"sn"`
+	Model   string `json:"model"`
+	Version string `json:"version"`
+}
+
+// ToObject convert to array object
+func (a DistributedArray) ToObject() ArrayObject {
+	return ArrayObject{Id: a.Sn, ProductModeString: a.Model, ProductVersion: a.Version}
+}
+
+// DistributedStoragePool storage pool information of distributed storage, the capacities are in MB
+type DistributedStoragePool struct {
+	PoolId        json.Number `json:"poolId"`
+	PoolName      string      `json:"poolName"`
+	TotalCapacity json.Number `json:"totalCapacity"`
+	UsedCapacity  json.Number `json:"usedCapacity"`
+}
+
+// ToObject convert to storage pool object
+func (p DistributedStoragePool) ToObject() StoragePoolObject {
+	object := StoragePoolObject{Id: p.PoolId.String(), Name: p.PoolName}
+	total, totalErr := p.TotalCapacity.Float64()
+	used, usedErr := p.UsedCapacity.Float64()
+	if totalErr != nil || usedErr != nil {
+		return object
+	}
+
+	object.TotalCapacity = formatMBToSectors(total)
+	object.UsedCapacity = formatMBToSectors(used)
+	object.FreeCapacity = formatMBToSectors(total - used)
+	if total > 0 {
+		object.CapacityUsage = strconv.FormatFloat(used*100/total, 'f', 0, 64)
+	}
+	return object
+}
+
+// DistributedVolume volume information of distributed storage, the capacities are in MB
+type DistributedVolume struct {
+	Id           json.Number `json:"id"`
+	Name         string      `json:"name"`
+	Capacity     json.Number `json:"capacity"`
+	UsedCapacity json.Number `json:"used_capacity"`
+}
+
+// ToObject convert to lun object
+func (v DistributedVolume) ToObject() LunObject {
+	return LunObject{
+		Id:            v.Id.String(),
+		Name:          v.Name,
+		Capacity:      convertMBToSectors(v.Capacity),
+		AllocCapacity: convertMBToSectors(v.UsedCapacity),
+	}
+}
+
+// DistributedNamespace namespace information of distributed storage, the capacities are in MB
+type DistributedNamespace struct {
+	Id           json.Number `json:"id"`
+	Name         string      `json:"name"`
+	Capacity     json.Number `json:"capacity"`
+	UsedCapacity json.Number `json:"used_capacity"`
+}
+
+// ToObject convert to filesystem object, the namespace has no snapshot reserve space
+func (n DistributedNamespace) ToObject() FileSystemObject {
+	usedCapacity := convertMBToSectors(n.UsedCapacity)
+	return FileSystemObject{
+		Id:                      n.Id.String(),
+		Name:                    n.Name,
+		Capacity:                convertMBToSectors(n.Capacity),
+		AllocCapacity:           usedCapacity,
+		AllocatedPoolQuota:      usedCapacity,
+		SnapshotReserveCapacity: "0",
+	}
+}
+
+// CollectDistributedArray collect object data of array in distributed storage
+func CollectDistributedArray(ctx context.Context, client *distributedstorage.DistributedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	data, err := client.GetSystemInfo(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect array of distributed storage failed, error: %v", err)
+		return nil, err
+	}
+
+	return convertDistributedResponse[ArrayObject, DistributedArray](data, request)
+}
+
+// CollectDistributedStoragePool collect object data of storage pool in distributed storage
+func CollectDistributedStoragePool(ctx context.Context, client *distributedstorage.DistributedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	data, err := client.GetStoragePools(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect storage pool of distributed storage failed, error: %v", err)
+		return nil, err
+	}
+
+	return convertDistributedResponse[StoragePoolObject, DistributedStoragePool](data, request)
+}
+
+// CollectDistributedLun collect object data of volume in distributed storage
+func CollectDistributedLun(ctx context.Context, client *distributedstorage.DistributedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	data, err := ConcurrentPaginate(ctx, client.GetVolumeCount, client.GetVolumes)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect volume of distributed storage failed, error: %v", err)
+		return nil, err
+	}
+
+	return convertDistributedResponse[LunObject, DistributedVolume](data, request)
+}
+
+// CollectDistributedFilesystem collect object data of namespace in distributed storage
+func CollectDistributedFilesystem(ctx context.Context, client *distributedstorage.DistributedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	data, err := ConcurrentPaginate(ctx, client.GetFileSystemCount, client.GetFileSystems)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect namespace of distributed storage failed, error: %v", err)
+		return nil, err
+	}
+
+	return convertDistributedResponse[FileSystemObject, DistributedNamespace](data, request)
+}
+
+// CollectDistributedPerformance collect performance data of distributed storage
+func CollectDistributedPerformance(ctx context.Context, client *distributedstorage.DistributedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	performances, nameMapping, err := QueryDistributedPerformance(ctx, client, request)
+	if err != nil {
+		return nil, err
+	}
+	return MergePerformance(performances, nameMapping, request), nil
+}
+
+// QueryDistributedPerformance query performance data of distributed storage and the name mapping of
+// the objects in the performance data
+func QueryDistributedPerformance(ctx context.Context, client *distributedstorage.DistributedClient,
+	request *cmi.CollectRequest) ([]PerformanceIndicators, map[string]string, error) {
+	objectType, ok := IndicatorsMapping[request.GetCollectType()]
+	if !ok {
+		return nil, nil, cmierror.New(cmierror.InvalidArgument, "unsupported collect type [%s]",
+			request.GetCollectType())
+	}
+
+	if IsHistoryRequest(request) {
+		return nil, nil, cmierror.New(cmierror.InvalidArgument,
+			"historical performance data is not supported by distributed storage")
+	}
+
+	data, err := client.GetPerformance(ctx, objectType, utils.MapStringToInt(request.GetIndicators()))
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect performance data of distributed storage failed, error: %v", err)
+		return nil, nil, err
+	}
+
+	performances, err := utils.MapToStruct[[]map[string]interface{}, []PerformanceIndicators](data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(performances) == 0 {
+		return nil, nil, nil
+	}
+
+	nameMapping, err := GetCachedMapping(ctx, request.GetBackendName(), constants.DistributedStorage,
+		request.GetCollectType(), client, GetPerformanceObjectIds(performances))
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect object name mapping data failed, error: %v", err)
+		return nil, nil, err
+	}
+	return performances, nameMapping, nil
+}
+
+// GetDistributedStoragePoolNameMapping get storage pool name mapping of distributed storage
+func GetDistributedStoragePoolNameMapping(ctx context.Context,
+	client *distributedstorage.DistributedClient) (map[string]string, error) {
+	data, err := client.GetStoragePools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return doDistributedNameMapping[StoragePoolObject, DistributedStoragePool](data)
+}
+
+// GetDistributedLunNameMapping get volume name mapping of distributed storage,
+// the volumes of the successful pages are still mapped with a PartialResultError when only some pages fail
+func GetDistributedLunNameMapping(ctx context.Context,
+	client *distributedstorage.DistributedClient) (map[string]string, error) {
+	data, err := ConcurrentPaginate(ctx, client.GetVolumeCount, client.GetVolumes)
+	if err != nil && !IsPartialResult(err) {
+		return nil, err
+	}
+	nameMapping, mappingErr := doDistributedNameMapping[LunObject, DistributedVolume](data)
+	if mappingErr != nil {
+		return nil, mappingErr
+	}
+	return nameMapping, err
+}
+
+// GetDistributedFilesystemNameMapping get namespace name mapping of distributed storage,
+// the namespaces of the successful pages are still mapped with a PartialResultError when only some pages fail
+func GetDistributedFilesystemNameMapping(ctx context.Context,
+	client *distributedstorage.DistributedClient) (map[string]string, error) {
+	data, err := ConcurrentPaginate(ctx, client.GetFileSystemCount, client.GetFileSystems)
+	if err != nil && !IsPartialResult(err) {
+		return nil, err
+	}
+	nameMapping, mappingErr := doDistributedNameMapping[FileSystemObject, DistributedNamespace](data)
+	if mappingErr != nil {
+		return nil, mappingErr
+	}
+	return nameMapping, err
+}
+
+// convertDistributedObjects convert the data of distributed storage to the objects of ocean storage
+func convertDistributedObjects[T any, D distributedObject[T], I any](input I) ([]T, error) {
+	sources, err := utils.MapToStructSlice[I, D](input)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]T, 0, len(sources))
+	for _, source := range sources {
+		objects = append(objects, source.ToObject())
+	}
+	return objects, nil
+}
+
+// convertDistributedResponse convert the data of distributed storage to response
+func convertDistributedResponse[T any, D distributedObject[T], I any](input I,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	objects, err := convertDistributedObjects[T, D](input)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertToResponse[[]T, T](objects, request)
+}
+
+// doDistributedNameMapping parse name mapping from the data of distributed storage
+func doDistributedNameMapping[T any, D distributedObject[T]](
+	data []map[string]interface{}) (map[string]string, error) {
+	objects, err := convertDistributedObjects[T, D](data)
+	if err != nil {
+		return nil, err
+	}
+
+	nameMapping := map[string]string{}
+	for _, object := range objects {
+		fields := utils.StructToMap(object)
+		nameMapping[fields[constants.Id]] = fields[constants.Name]
+	}
+	return nameMapping, nil
+}
+
+// convertMBToSectors convert the capacity in MB to sectors, empty string will be returned if it is invalid
+func convertMBToSectors(capacity json.Number) string {
+	value, err := capacity.Float64()
+	if err != nil {
+		return ""
+	}
+	return formatMBToSectors(value)
+}
+
+func formatMBToSectors(capacity float64) string {
+	return strconv.FormatFloat(capacity*sectorsPerMB, 'f', 0, 64)
+}
@@ -0,0 +1,156 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/backend"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/distributedstorage"
+)
+
+func TestCollectDistributedObjects(t *testing.T) {
+	// arrange
+	client := &distributedstorage.DistributedClient{}
+	pools := []map[string]interface{}{
+		{"poolId": float64(0), "poolName": "pool-1", "totalCapacity": float64(1024), "usedCapacity": float64(256)},
+	}
+	volumes := []map[string]interface{}{
+		{"id": float64(1), "name": "vol-1", "capacity": float64(1024), "used_capacity": float64(512)},
+	}
+	namespaces := []map[string]interface{}{{"id": "2", "name": "fs-1", "capacity": float64(1024)}}
+
+	// mock
+	patches := gomonkey.ApplyMethod(client, "GetStoragePools",
+		func(*distributedstorage.DistributedClient, context.Context) ([]map[string]interface{}, error) {
+			return pools, nil
+		}).
+		ApplyMethod(client, "GetVolumeCount",
+			func(*distributedstorage.DistributedClient, context.Context) (int, error) { return len(volumes), nil }).
+		ApplyMethod(client, "GetVolumes", func(*distributedstorage.DistributedClient, context.Context, int,
+			int) ([]map[string]interface{}, error) {
+			return volumes, nil
+		}).
+		ApplyMethod(client, "GetFileSystemCount",
+			func(*distributedstorage.DistributedClient, context.Context) (int, error) { return len(namespaces), nil }).
+		ApplyMethod(client, "GetFileSystems", func(*distributedstorage.DistributedClient, context.Context, int,
+			int) ([]map[string]interface{}, error) {
+			return namespaces, nil
+		})
+	defer patches.Reset()
+
+	tests := []struct {
+		name    string
+		collect func(context.Context, *distributedstorage.DistributedClient,
+			*cmi.CollectRequest) (*cmi.CollectResponse, error)
+		want []map[string]string
+	}{
+		{name: "storage pool", collect: CollectDistributedStoragePool, want: []map[string]string{
+			{"ID": "0", "NAME": "pool-1", "USERTOTALCAPACITY": "2097152", "USERCONSUMEDCAPACITY": "524288",
+				"USERFREECAPACITY": "1572864", "USERCONSUMEDCAPACITYPERCENTAGE": "25"},
+		}},
+		{name: "lun", collect: CollectDistributedLun, want: []map[string]string{
+			{"ID": "1", "NAME": "vol-1", "CAPACITY": "2097152", "ALLOCCAPACITY": "1048576"},
+		}},
+		{name: "filesystem", collect: CollectDistributedFilesystem, want: []map[string]string{
+			{"ID": "2", "NAME": "fs-1", "CAPACITY": "2097152"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got, err := tt.collect(context.Background(), client, &cmi.CollectRequest{MetricsType: "object"})
+
+			// assert
+			if err != nil {
+				t.Errorf("collect %s error = %v", tt.name, err)
+				return
+			}
+			assertDetailsContain(t, tt.name, got, tt.want)
+		})
+	}
+}
+
+func TestCollectDistributedPerformance(t *testing.T) {
+	// arrange
+	client := &distributedstorage.DistributedClient{}
+	request := &cmi.CollectRequest{CollectType: "lun", MetricsType: "performance", Indicators: []string{"22"}}
+	want := []map[string]string{{"ObjectId": "1", "ObjectName": "vol-1", "22": "10.0000"}}
+
+	// mock
+	patches := gomonkey.ApplyMethod(client, "GetPerformance", func(*distributedstorage.DistributedClient,
+		context.Context, int, []int) ([]map[string]interface{}, error) {
+		return []map[string]interface{}{
+			{"object_id": "1", "indicators": []int{22}, "indicator_values": []float64{10}},
+			{"object_id": "2", "indicators": []int{22}, "indicator_values": []float64{20}},
+		}, nil
+	}).
+		ApplyFunc(GetDistributedLunNameMapping, func(context.Context,
+			*distributedstorage.DistributedClient) (map[string]string, error) {
+			return map[string]string{"1": "vol-1"}, nil
+		})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectDistributedPerformance(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectDistributedPerformance() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectDistributedPerformance()", got, want)
+}
+
+func TestPerformanceCollector_GetCollectCapabilities_with_distributed(t *testing.T) {
+	// arrange
+	collector := &PerformanceCollector{}
+	clientInfo := backend.ClientInfo{StorageType: constants.DistributedStorage,
+		Client: &distributedstorage.DistributedClient{}, Profile: backend.StorageProfile{Detected: true}}
+
+	// mock
+	patches := gomonkey.ApplyFunc(GetClient, func(context.Context, string,
+		func(context.Context, string) (backend.ClientInfo, error)) (backend.ClientInfo, error) {
+		return clientInfo, nil
+	})
+	defer patches.Reset()
+
+	// action
+	got, err := collector.GetCollectCapabilities(context.Background(),
+		&cmi.GetCollectCapabilitiesRequest{BackendName: "pacific"})
+
+	// assert
+	if err != nil {
+		t.Errorf("GetCollectCapabilities() error = %v", err)
+		return
+	}
+	if len(got.GetPerformanceTypes()) != len(distributedPerformanceIndicators) {
+		t.Errorf("GetCollectCapabilities() got %d performance types, want %d", len(got.GetPerformanceTypes()),
+			len(distributedPerformanceIndicators))
+	}
+	for _, capability := range got.GetPerformanceTypes() {
+		if len(capability.GetIndicators()) == 0 {
+			t.Errorf("GetCollectCapabilities() got empty indicators of [%s]", capability.GetCollectType())
+		}
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	cmiConfig "github.com/huawei/csm/v2/config/cmi"
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// QueryObjectFunc query one object by id or name, e.g. query lun by name, empty result means not found
+type QueryObjectFunc func(context.Context, string) (map[string]interface{}, error)
+
+// ObjectFilter filter objects by the object ids, object names and name prefix in the collect request
+// An object matches the filter only when it matches all the specified conditions.
+type ObjectFilter struct {
+	ids        map[string]struct{}
+	names      map[string]struct{}
+	namePrefix string
+}
+
+// NewObjectFilter build an object filter from the collect request
+func NewObjectFilter(request *cmi.CollectRequest) *ObjectFilter {
+	return &ObjectFilter{
+		ids:        toSet(request.GetObjectIds()),
+		names:      toSet(request.GetObjectNames()),
+		namePrefix: request.GetNamePrefix(),
+	}
+}
+
+// IsEmpty whether there is no filter condition
+func (f *ObjectFilter) IsEmpty() bool {
+	return len(f.ids) == 0 && len(f.names) == 0 && f.namePrefix == ""
+}
+
+// Match whether the object with the id and name matches the filter
+func (f *ObjectFilter) Match(id, name string) bool {
+	if len(f.ids) != 0 {
+		if _, ok := f.ids[id]; !ok {
+			return false
+		}
+	}
+	return f.MatchName(name)
+}
+
+// MatchName whether the name matches the names and name prefix of the filter, the ids are not checked
+func (f *ObjectFilter) MatchName(name string) bool {
+	if len(f.names) != 0 {
+		if _, ok := f.names[name]; !ok {
+			return false
+		}
+	}
+
+	if f.namePrefix != "" && (name == "" || !strings.HasPrefix(name, f.namePrefix)) {
+		return false
+	}
+	return true
+}
+
+// CanQueryByObject whether the objects can be queried one by one instead of querying all objects in pages,
+// which is only allowed when the ids or names are specified, and their number is not more than one page
+func (f *ObjectFilter) CanQueryByObject() bool {
+	targets := len(f.ids)
+	if targets == 0 {
+		targets = len(f.names)
+	}
+	return targets != 0 && targets <= cmiConfig.GetQueryStoragePageSize()
+}
+
+// QueryByObject query the objects one by one, by ids if specified, otherwise by names.
+// The objects not found in storage will be ignored, see QueryObjects for the errors.
+func (f *ObjectFilter) QueryByObject(ctx context.Context, queryById,
+	queryByName QueryObjectFunc) ([]map[string]interface{}, error) {
+	keys, query := f.ids, queryById
+	if len(keys) == 0 {
+		keys, query = f.names, queryByName
+	}
+	return QueryObjects(ctx, sortedKeys(keys), query)
+}
+
+// MatchId whether the id matches the ids of the filter, the names are not checked
+func (f *ObjectFilter) MatchId(id string) bool {
+	if len(f.ids) == 0 {
+		return true
+	}
+	_, ok := f.ids[id]
+	return ok
+}
+
+// QueryObjects query the objects by the keys concurrently with the page query workers,
+// the objects not found in storage will be ignored and the result is returned in the order of keys.
+// If the context is done, the context error is returned, and if only some objects fail,
+// the successful objects are returned with a PartialResultError
+func QueryObjects(ctx context.Context, keys []string, query QueryObjectFunc) ([]map[string]interface{}, error) {
+	objectQuery := func(ctx context.Context, start, _ int) ([]map[string]interface{}, error) {
+		data, err := query(ctx, keys[start])
+		if err != nil {
+			log.AddContext(ctx).Errorf("query object [%s] failed, error: %v", keys[start], err)
+			return nil, err
+		}
+		if len(data) == 0 {
+			log.AddContext(ctx).Infof("object [%s] is not found in storage, skip it", keys[start])
+			return nil, nil
+		}
+		return []map[string]interface{}{data}, nil
+	}
+
+	data, err := ReadQueryResult(dispatchQuery(ctx, len(keys), 1, objectQuery))
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return data, err
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
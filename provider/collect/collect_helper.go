@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,22 +19,21 @@ package collect
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
 
 	cmiConfig "github.com/huawei/csm/v2/config/cmi"
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/provider/utils"
+	storageUtils "github.com/huawei/csm/v2/storage/utils"
 )
 
 var (
 	// IndicatorsMapping storage indicators mapping
-	IndicatorsMapping = map[string]int{
-		constants.Filesystem:  40,
-		constants.Lun:         11,
-		constants.Controller:  207,
-		constants.StoragePool: 216,
-	}
+	IndicatorsMapping = constants.PerformanceObjectTypes
 )
 
 // CountFunc count function, e.g. query total filesystem number in storage
@@ -46,6 +45,12 @@ type QueryFunc func(context.Context) ([]map[string]interface{}, error)
 // PageFunc page query function, e.g. page query filesystem information
 type PageFunc func(context.Context, int, int) ([]map[string]interface{}, error)
 
+// PageConsumer page consume function, it will be called once a page of storage data is queried
+type PageConsumer func([]map[string]interface{}) error
+
+// ResponseSender send function, e.g. send a part of collect response by the grpc stream
+type ResponseSender func(*cmi.CollectResponse) error
+
 // BuildResponse build a collect response
 func BuildResponse(request *cmi.CollectRequest) *cmi.CollectResponse {
 	return &cmi.CollectResponse{
@@ -70,20 +75,82 @@ func AddCollectDetailWithMap(data map[string]string, response *cmi.CollectRespon
 }
 
 // ConvertToResponse convert input to response
+// The objects which do not match the object filter in request will be discarded
 func ConvertToResponse[I, T any](input I, request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return ConvertToResponseByName[I, T](input, request, constants.Name)
+}
+
+// ConvertToResponseByName convert input to response, the objects are matched with the object filter
+// by the value of nameKey, e.g. the pairs without name are matched by the name of local object
+func ConvertToResponseByName[I, T any](input I, request *cmi.CollectRequest,
+	nameKey string) (*cmi.CollectResponse, error) {
 	targets, err := utils.MapToStructSlice[I, T](input)
 	if err != nil {
 		return nil, err
 	}
 
+	filter := NewObjectFilter(request)
 	response := BuildResponse(request)
 	for _, target := range targets {
-		AddCollectDetail(target, response)
+		data := utils.StructToMap(target)
+		if !filter.Match(data[constants.Id], data[nameKey]) {
+			continue
+		}
+		AddCollectDetailWithMap(data, response)
 	}
 
 	return response, nil
 }
 
+// PartialResultError is returned by the concurrent page query when some pages fail, the data of the
+// successful pages is returned along with it, so the caller can decide whether an incomplete result is acceptable
+type PartialResultError struct {
+	FailedPages int
+	TotalPages  int
+	Err         error
+}
+
+// Error returns the failed pages and the first error
+func (e *PartialResultError) Error() string {
+	return fmt.Sprintf("%d of %d pages query failed, first error: %v", e.FailedPages, e.TotalPages, e.Err)
+}
+
+// Unwrap returns the error of the first failed page
+func (e *PartialResultError) Unwrap() error {
+	return e.Err
+}
+
+type pageWorkersKey struct{}
+
+// semaphoreHolder the storage client which limits its concurrent calls by a semaphore
+type semaphoreHolder interface {
+	GetSemaphore() *storageUtils.Semaphore
+}
+
+// WithPageWorkers bind the number of page query workers to the context,
+// the number is the permits of the storage client semaphore, so a page query never holds more goroutines
+// than the client can serve at the same time
+func WithPageWorkers(ctx context.Context, client interface{}) context.Context {
+	limited, ok := client.(semaphoreHolder)
+	if !ok || limited.GetSemaphore() == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, pageWorkersKey{}, limited.GetSemaphore().Permits())
+}
+
+// getPageWorkers get the number of page query workers bound to the context,
+// the client max threads is used if no number is bound
+func getPageWorkers(ctx context.Context) int {
+	workers, ok := ctx.Value(pageWorkersKey{}).(int)
+	if !ok {
+		workers = cmiConfig.GetClientMaxThreads()
+	}
+	if workers <= 0 {
+		return 1
+	}
+	return workers
+}
+
 // BuildFailedPageResult build a failed paginated result
 func BuildFailedPageResult(err error) PageResultTuple {
 	return PageResultTuple{
@@ -98,22 +165,117 @@ func BuildSuccessPageResult(data []map[string]interface{}) PageResultTuple {
 }
 
 // ConcurrentPaginate a universal concurrent paging query function
-// Each page will use a goroutine to query
+// The pages are queried by a bounded number of workers and the result is returned in page order.
+// If the context is done, the context error is returned, and if only some pages fail,
+// the data of the successful pages is returned with a PartialResultError
 func ConcurrentPaginate(ctx context.Context, count CountFunc, query PageFunc) ([]map[string]interface{}, error) {
 	total, err := count(ctx)
 	if err != nil {
 		return []map[string]interface{}{}, err
 	}
 
+	data, err := ReadQueryResult(dispatchPageQuery(ctx, total, query))
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return data, err
+}
+
+// IsPartialResult whether the error means only some pages fail, the data of the successful pages is still valid
+func IsPartialResult(err error) bool {
+	var partialErr *PartialResultError
+	return errors.As(err, &partialErr)
+}
+
+// ConcurrentPaginateStream a universal concurrent paging query function in stream mode
+// The pages are queried by a bounded number of workers, and each page will be consumed as soon as it is queried,
+// so that all storage data will not be kept in memory at the same time.
+// Once a page fails to be queried or consumed, the pages that have not been queried yet are cancelled
+func ConcurrentPaginateStream(ctx context.Context, count CountFunc, query PageFunc, consume PageConsumer) error {
+	total, err := count(ctx)
+	if err != nil {
+		return err
+	}
+
+	queryCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cancelOnError := func(err error) error {
+		if err != nil {
+			cancel()
+		}
+		return err
+	}
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		data, err := query(ctx, start, end)
+		return data, cancelOnError(err)
+	}
+	err = ConsumeQueryResult(dispatchPageQuery(queryCtx, total, pageFunc), func(data []map[string]interface{}) error {
+		return cancelOnError(consume(data))
+	})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// SequentialPaginate query the pages one by one until a page is not full, it is used for the small lists
+// associated with one object, e.g. the luns mapped to a host, so that no count query is needed
+func SequentialPaginate(ctx context.Context, query PageFunc) ([]map[string]interface{}, error) {
+	pageSize := cmiConfig.GetQueryStoragePageSize()
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("invalid page size [%d]", pageSize)
+	}
+
+	var data []map[string]interface{}
+	for start := 0; ; start += pageSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		pageData, err := query(ctx, start, start+pageSize)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, pageData...)
+		if len(pageData) < pageSize {
+			return data, nil
+		}
+	}
+}
+
+// dispatchPageQuery query the pages by a bounded number of workers,
+// the result of all pages will be written to the returned channel, and the channel is closed after all pages
+// have a result, the pages which are not queried before the context is done get the context error
+func dispatchPageQuery(ctx context.Context, total int, query PageFunc) <-chan PageResultTuple {
+	var pages, pageSize = 0, cmiConfig.GetQueryStoragePageSize()
+	if total > 0 && pageSize > 0 {
+		pages = (total + pageSize - 1) / pageSize
+	}
+	return dispatchQuery(ctx, pages, pageSize, query)
+}
+
+// dispatchQuery query the pages of the page size by a bounded number of workers, see dispatchPageQuery
+func dispatchQuery(ctx context.Context, pages, pageSize int, query PageFunc) <-chan PageResultTuple {
+	indexes := make(chan int, pages)
+	for index := 0; index < pages; index++ {
+		indexes <- index
+	}
+	close(indexes)
+
+	workers := getPageWorkers(ctx)
+	if workers > pages {
+		workers = pages
+	}
+
 	var wg sync.WaitGroup
-	var out = make(chan PageResultTuple)
-	var start, pageSize = 0, cmiConfig.GetQueryStoragePageSize()
-	for total > 0 {
-		end := start + pageSize
+	var out = make(chan PageResultTuple, workers)
+	for i := 0; i < workers; i++ {
 		wg.Add(1)
-		go pageQuery(ctx, start, end, &wg, query, out)
-		start = end
-		total -= pageSize
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				out <- pageQuery(ctx, index, pageSize, query)
+			}
+		}()
 	}
 
 	go func() {
@@ -121,29 +283,99 @@ func ConcurrentPaginate(ctx context.Context, count CountFunc, query PageFunc) ([
 		close(out)
 	}()
 
-	return ReadQueryResult(out)
+	return out
 }
 
-// pageQuery page query storage data
-func pageQuery(ctx context.Context, start, end int, wg *sync.WaitGroup, query PageFunc, ch chan<- PageResultTuple) {
-	defer wg.Done()
+// pageQuery page query storage data, the page is not queried if the context is done
+func pageQuery(ctx context.Context, index, pageSize int, query PageFunc) PageResultTuple {
 	var result PageResultTuple
-	pageData, err := query(ctx, start, end)
-	if err != nil {
+	if err := ctx.Err(); err != nil {
+		result = BuildFailedPageResult(err)
+	} else if pageData, err := query(ctx, index*pageSize, (index+1)*pageSize); err != nil {
 		result = BuildFailedPageResult(err)
+	} else {
+		result = BuildSuccessPageResult(pageData)
 	}
-	result = BuildSuccessPageResult(pageData)
-	ch <- result
+	result.Index = index
+	return result
 }
 
-// ReadQueryResult read query result form channel
+// ReadQueryResult read query result form channel, all results are read so that no query goroutine is blocked,
+// and the data is returned in page order. If all pages fail, the first error is returned,
+// and if only some pages fail, the data of the successful pages is returned with a PartialResultError
 func ReadQueryResult(input <-chan PageResultTuple) ([]map[string]interface{}, error) {
-	var result []map[string]interface{}
+	var tuples []PageResultTuple
 	for tuple := range input {
+		tuples = append(tuples, tuple)
+	}
+	sort.SliceStable(tuples, func(i, j int) bool {
+		return tuples[i].Index < tuples[j].Index
+	})
+
+	var result []map[string]interface{}
+	var firstErr error
+	var failed int
+	for _, tuple := range tuples {
 		if tuple.Error != nil {
-			return nil, tuple.Error
+			if firstErr == nil {
+				firstErr = tuple.Error
+			}
+			failed++
+			continue
 		}
 		result = append(result, tuple.Data...)
 	}
-	return result, nil
+
+	if failed == 0 {
+		return result, nil
+	}
+	if failed == len(tuples) {
+		return nil, firstErr
+	}
+	return result, &PartialResultError{FailedPages: failed, TotalPages: len(tuples), Err: firstErr}
+}
+
+// ConsumeQueryResult read query result from channel and consume it page by page
+// After an error occurs, the remaining results will be discarded, so that no query goroutine is blocked
+func ConsumeQueryResult(input <-chan PageResultTuple, consume PageConsumer) error {
+	var err error
+	for tuple := range input {
+		if err != nil {
+			continue
+		}
+		if tuple.Error != nil {
+			err = tuple.Error
+			continue
+		}
+		err = consume(tuple.Data)
+	}
+	return err
+}
+
+// SendResponseInPages split the details of the response into pages, and send them one by one
+func SendResponseInPages(response *cmi.CollectResponse, send ResponseSender) error {
+	details := response.GetDetails()
+	pageSize := cmiConfig.GetQueryStoragePageSize()
+	if len(details) == 0 || pageSize <= 0 {
+		return send(response)
+	}
+
+	for start := 0; start < len(details); start += pageSize {
+		end := start + pageSize
+		if end > len(details) {
+			end = len(details)
+		}
+
+		page := &cmi.CollectResponse{
+			BackendName: response.GetBackendName(),
+			CollectType: response.GetCollectType(),
+			MetricsType: response.GetMetricsType(),
+			Details:     details[start:end],
+			Partial:     response.GetPartial(),
+		}
+		if err := send(page); err != nil {
+			return err
+		}
+	}
+	return nil
 }
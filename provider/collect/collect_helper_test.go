@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -18,10 +18,18 @@
 package collect
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client"
+	storageUtils "github.com/huawei/csm/v2/storage/utils"
 )
 
 func Test_AddCollectDetail_Success(t *testing.T) {
@@ -101,3 +109,267 @@ func TestConvertToResponse(t *testing.T) {
 		t.Errorf("TestConvertToResponse() failed, error = %v", err)
 	}
 }
+
+func TestConsumeQueryResult_with_error(t *testing.T) {
+	// arrange
+	input := make(chan PageResultTuple, 3)
+	input <- BuildSuccessPageResult([]map[string]interface{}{{"ID": "1"}})
+	input <- BuildFailedPageResult(errors.New("query failed"))
+	input <- BuildSuccessPageResult([]map[string]interface{}{{"ID": "2"}})
+	close(input)
+	var consumed int
+
+	// action
+	err := ConsumeQueryResult(input, func(data []map[string]interface{}) error {
+		consumed += len(data)
+		return nil
+	})
+
+	// assert
+	if err == nil || err.Error() != "query failed" {
+		t.Errorf("TestConsumeQueryResult_with_error() failed, want error = query failed, but got = %v", err)
+	}
+	if consumed != 1 {
+		t.Errorf("TestConsumeQueryResult_with_error() failed, want consumed = 1, but got = %d", consumed)
+	}
+	if len(input) != 0 {
+		t.Errorf("TestConsumeQueryResult_with_error() failed, want input drained, but left = %d", len(input))
+	}
+}
+
+func TestConcurrentPaginate_with_order(t *testing.T) {
+	// arrange
+	countFunc := func(ctx context.Context) (int, error) {
+		return 1000, nil
+	}
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		// the later pages return earlier
+		time.Sleep(time.Duration(1000-start) * time.Microsecond * 10)
+		return []map[string]interface{}{{"ID": strconv.Itoa(start)}}, nil
+	}
+
+	// action
+	data, err := ConcurrentPaginate(context.Background(), countFunc, pageFunc)
+
+	// assert
+	if err != nil {
+		t.Errorf("TestConcurrentPaginate_with_order() failed, error = %v", err)
+		return
+	}
+	var got []string
+	for _, item := range data {
+		got = append(got, item["ID"].(string))
+	}
+	want := []string{"0", "100", "200", "300", "400", "500", "600", "700", "800", "900"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TestConcurrentPaginate_with_order() failed, want = %v, but got = %v", want, got)
+	}
+}
+
+func TestConcurrentPaginate_with_partial_error(t *testing.T) {
+	// arrange
+	countFunc := func(ctx context.Context) (int, error) {
+		return 1000, nil
+	}
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		if start == 200 {
+			return nil, errors.New("query failed")
+		}
+		return []map[string]interface{}{{"ID": strconv.Itoa(start)}}, nil
+	}
+
+	// action
+	data, err := ConcurrentPaginate(context.Background(), countFunc, pageFunc)
+
+	// assert
+	var partialErr *PartialResultError
+	if !errors.As(err, &partialErr) {
+		t.Errorf("TestConcurrentPaginate_with_partial_error() failed, want partial error, but got = %v", err)
+		return
+	}
+	if partialErr.FailedPages != 1 || partialErr.TotalPages != 10 || partialErr.Err.Error() != "query failed" {
+		t.Errorf("TestConcurrentPaginate_with_partial_error() failed, got error = %v", partialErr)
+	}
+	if len(data) != 9 {
+		t.Errorf("TestConcurrentPaginate_with_partial_error() failed, want data = 9, but got = %d", len(data))
+	}
+}
+
+func TestIsPartialResult(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "partial result", err: &PartialResultError{FailedPages: 1, TotalPages: 10}, want: true},
+		{name: "wrapped partial result", err: fmt.Errorf("query failed: %w", &PartialResultError{}), want: true},
+		{name: "other error", err: errors.New("query failed"), want: false},
+		{name: "no error", err: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPartialResult(tt.err); got != tt.want {
+				t.Errorf("IsPartialResult() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConcurrentPaginate_with_all_pages_failed(t *testing.T) {
+	// arrange
+	countFunc := func(ctx context.Context) (int, error) {
+		return 1000, nil
+	}
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		return nil, errors.New("query failed")
+	}
+
+	// action
+	data, err := ConcurrentPaginate(context.Background(), countFunc, pageFunc)
+
+	// assert
+	if err == nil || err.Error() != "query failed" || data != nil {
+		t.Errorf("TestConcurrentPaginate_with_all_pages_failed() failed, want error = query failed, "+
+			"but got data = %v, error = %v", data, err)
+	}
+}
+
+func TestConcurrentPaginate_with_cancel(t *testing.T) {
+	// arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	countFunc := func(ctx context.Context) (int, error) {
+		return 1000, nil
+	}
+	var queried int32
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		if atomic.AddInt32(&queried, 1) == 1 {
+			cancel()
+		}
+		return []map[string]interface{}{{"ID": strconv.Itoa(start)}}, nil
+	}
+	ctx = context.WithValue(ctx, pageWorkersKey{}, 1)
+
+	// action
+	data, err := ConcurrentPaginate(ctx, countFunc, pageFunc)
+
+	// assert
+	if !errors.Is(err, context.Canceled) || data != nil {
+		t.Errorf("TestConcurrentPaginate_with_cancel() failed, want canceled, but got data = %v, error = %v",
+			data, err)
+	}
+	if got := atomic.LoadInt32(&queried); got != 1 {
+		t.Errorf("TestConcurrentPaginate_with_cancel() failed, want queried pages = 1, but got = %d", got)
+	}
+}
+
+func TestConcurrentPaginate_with_bounded_workers(t *testing.T) {
+	// arrange
+	countFunc := func(ctx context.Context) (int, error) {
+		return 2000, nil
+	}
+	var running, maxRunning int32
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			old := atomic.LoadInt32(&maxRunning)
+			if current <= old || atomic.CompareAndSwapInt32(&maxRunning, old, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return []map[string]interface{}{{"ID": strconv.Itoa(start)}}, nil
+	}
+	ctx := WithPageWorkers(context.Background(), &client.Client{Semaphore: storageUtils.NewSemaphore(2)})
+
+	// action
+	data, err := ConcurrentPaginate(ctx, countFunc, pageFunc)
+
+	// assert
+	if err != nil || len(data) != 20 {
+		t.Errorf("TestConcurrentPaginate_with_bounded_workers() failed, got data = %d, error = %v", len(data), err)
+	}
+	if got := atomic.LoadInt32(&maxRunning); got > 2 {
+		t.Errorf("TestConcurrentPaginate_with_bounded_workers() failed, want max running <= 2, but got = %d", got)
+	}
+}
+
+func TestConcurrentPaginateStream_with_consume_error(t *testing.T) {
+	// arrange
+	countFunc := func(ctx context.Context) (int, error) {
+		return 1000, nil
+	}
+	var queried int32
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		atomic.AddInt32(&queried, 1)
+		return []map[string]interface{}{{"ID": strconv.Itoa(start)}}, nil
+	}
+	ctx := context.WithValue(context.Background(), pageWorkersKey{}, 1)
+
+	// action
+	err := ConcurrentPaginateStream(ctx, countFunc, pageFunc, func(data []map[string]interface{}) error {
+		return errors.New("consume failed")
+	})
+
+	// assert
+	if err == nil || err.Error() != "consume failed" {
+		t.Errorf("TestConcurrentPaginateStream_with_consume_error() failed, want error = consume failed, "+
+			"but got = %v", err)
+	}
+	if got := atomic.LoadInt32(&queried); got >= 10 {
+		t.Errorf("TestConcurrentPaginateStream_with_consume_error() failed, want remaining pages cancelled, "+
+			"but queried = %d", got)
+	}
+}
+
+func TestSendResponseInPages(t *testing.T) {
+	// arrange
+	response := &cmi.CollectResponse{BackendName: "test-backend"}
+	for i := 0; i < 250; i++ {
+		AddCollectDetailWithMap(map[string]string{"ID": "1"}, response)
+	}
+	var pageSizes []int
+
+	// action
+	err := SendResponseInPages(response, func(page *cmi.CollectResponse) error {
+		pageSizes = append(pageSizes, len(page.GetDetails()))
+		return nil
+	})
+
+	// assert
+	want := []int{100, 100, 50}
+	if err != nil || !reflect.DeepEqual(pageSizes, want) {
+		t.Errorf("TestSendResponseInPages() failed, want = %v, got = %v, error = %v", want, pageSizes, err)
+	}
+}
+
+func TestObjectFilter_Match(t *testing.T) {
+	// arrange
+	filter := NewObjectFilter(&cmi.CollectRequest{
+		ObjectIds:  []string{"1", "2"},
+		NamePrefix: "pvc-",
+	})
+	tests := []struct {
+		name       string
+		objectId   string
+		objectName string
+		want       bool
+	}{
+		{name: "match all conditions", objectId: "1", objectName: "pvc-1", want: true},
+		{name: "id not match", objectId: "3", objectName: "pvc-3", want: false},
+		{name: "name prefix not match", objectId: "2", objectName: "lun-2", want: false},
+		{name: "object without name", objectId: "2", objectName: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := filter.Match(tt.objectId, tt.objectName)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("Match() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
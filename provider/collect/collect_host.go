@@ -0,0 +1,307 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	// initiatorOnline is the running status of an online initiator
+	initiatorOnline = "27"
+
+	parentIdKey           = "PARENTID"
+	runningStatusKey      = "RUNNINGSTATUS"
+	initiatorNumKey       = "initiatorNum"
+	onlineInitiatorNumKey = "onlineInitiatorNum"
+	mappedLunNumKey       = "mappedLunNum"
+	mappedLunIdKey        = "mappedLunIds"
+	mappedLunNameKey      = "mappedLunNames"
+	initiatorIdKey        = "initiatorIds"
+	onlineInitiatorIdKey  = "onlineInitiatorIds"
+	hostNumKey            = "hostNum"
+	hostGroupIdKey        = "hostGroupId"
+	hostGroupNameKey      = "hostGroupName"
+	lunGroupIdKey         = "lunGroupId"
+	lunGroupNameKey       = "lunGroupName"
+	groupSeparator        = ","
+)
+
+// initiatorCount the initiator numbers of a host, and the ids of the initiators, i.e. the wwn or iqn
+type initiatorCount struct {
+	total     int
+	online    int
+	ids       []string
+	onlineIds []string
+}
+
+// CollectHost collect object data of host in storage, the initiators and mapped luns of each host
+// are collected together
+func CollectHost(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	filter := NewObjectFilter(request)
+	var hosts []map[string]interface{}
+	var err error
+	if filter.CanQueryByObject() {
+		hosts, err = filter.QueryByObject(ctx, client.GetHostById, client.GetHostByName)
+	} else {
+		hosts, err = ConcurrentPaginate(ctx, client.GetHostCount, client.GetHosts)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect host failed, error: %v", err)
+		return nil, err
+	}
+
+	hosts = filterObjects(hosts, filter)
+	if err = fillHostInitiators(ctx, client, hosts); err != nil {
+		return nil, err
+	}
+	if err = fillHostMappedLuns(ctx, client, hosts); err != nil {
+		return nil, err
+	}
+	return ConvertToResponse[[]map[string]interface{}, HostObject](hosts, request)
+}
+
+// CollectHostGroup collect object data of host group in storage, the host number of each host group is
+// collected together
+func CollectHostGroup(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	hostGroups, err := ConcurrentPaginate(ctx, client.GetHostGroupCount, client.GetHostGroups)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect host group failed, error: %v", err)
+		return nil, err
+	}
+
+	hostGroups = filterObjects(hostGroups, NewObjectFilter(request))
+	if len(hostGroups) != 0 {
+		hosts, err := ConcurrentPaginate(ctx, client.GetHostCount, client.GetHosts)
+		if err != nil {
+			log.AddContext(ctx).Errorf("collect hosts of host group failed, error: %v", err)
+			return nil, err
+		}
+
+		hostNums := make(map[string]int)
+		for _, host := range hosts {
+			hostNums[getStringValue(host, parentIdKey)]++
+		}
+		for _, hostGroup := range hostGroups {
+			hostGroup[hostNumKey] = strconv.Itoa(hostNums[getStringValue(hostGroup, constants.Id)])
+		}
+	}
+	return ConvertToResponse[[]map[string]interface{}, HostGroupObject](hostGroups, request)
+}
+
+// CollectMappingView collect object data of mapping view in storage, the host groups and lun groups of each
+// mapping view are collected together
+func CollectMappingView(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	mappingViews, err := ConcurrentPaginate(ctx, client.GetMappingViewCount, client.GetMappingViews)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect mapping view failed, error: %v", err)
+		return nil, err
+	}
+
+	mappingViews = filterObjects(mappingViews, NewObjectFilter(request))
+	err = forEachObject(ctx, mappingViews, func(ctx context.Context, mappingView map[string]interface{}) error {
+		id := getStringValue(mappingView, constants.Id)
+		hostGroups, err := client.GetHostGroupsByMappingView(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get host groups of mapping view [%s] failed, error: %w", id, err)
+		}
+		lunGroups, err := client.GetLunGroupsByMappingView(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get lun groups of mapping view [%s] failed, error: %w", id, err)
+		}
+
+		mappingView[hostGroupIdKey], mappingView[hostGroupNameKey] = joinIdAndName(hostGroups)
+		mappingView[lunGroupIdKey], mappingView[lunGroupNameKey] = joinIdAndName(lunGroups)
+		return nil
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect mapping view failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponse[[]map[string]interface{}, MappingViewObject](mappingViews, request)
+}
+
+// fillHostInitiators count the fc and iscsi initiators of each host by the parent id of initiators,
+// the ids of the initiators are kept with the numbers
+func fillHostInitiators(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	hosts []map[string]interface{}) error {
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	fcInitiators, err := ConcurrentPaginate(ctx, client.GetFCInitiatorCount, client.GetFCInitiators)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect fc initiators failed, error: %v", err)
+		return err
+	}
+	iscsiInitiators, err := ConcurrentPaginate(ctx, client.GetISCSIInitiatorCount, client.GetISCSIInitiators)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect iscsi initiators failed, error: %v", err)
+		return err
+	}
+
+	counts := make(map[string]*initiatorCount)
+	for _, initiator := range append(fcInitiators, iscsiInitiators...) {
+		hostId := getStringValue(initiator, parentIdKey)
+		if hostId == "" {
+			continue
+		}
+		count, ok := counts[hostId]
+		if !ok {
+			count = &initiatorCount{}
+			counts[hostId] = count
+		}
+		count.total++
+		count.ids = append(count.ids, getStringValue(initiator, constants.Id))
+		if getStringValue(initiator, runningStatusKey) == initiatorOnline {
+			count.online++
+			count.onlineIds = append(count.onlineIds, getStringValue(initiator, constants.Id))
+		}
+	}
+
+	for _, host := range hosts {
+		count, ok := counts[getStringValue(host, constants.Id)]
+		if !ok {
+			count = &initiatorCount{}
+		}
+		host[initiatorNumKey] = strconv.Itoa(count.total)
+		host[onlineInitiatorNumKey] = strconv.Itoa(count.online)
+		host[initiatorIdKey] = strings.Join(count.ids, groupSeparator)
+		host[onlineInitiatorIdKey] = strings.Join(count.onlineIds, groupSeparator)
+	}
+	return nil
+}
+
+// fillHostMappedLuns query the luns mapped to each host, the ids and names of the luns are kept with the number,
+// so that the hosts which a lun is mapped to can be found
+func fillHostMappedLuns(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	hosts []map[string]interface{}) error {
+	return forEachObject(ctx, hosts, func(ctx context.Context, host map[string]interface{}) error {
+		id := getStringValue(host, constants.Id)
+		luns, err := SequentialPaginate(ctx, func(ctx context.Context, start, end int) ([]map[string]interface{},
+			error) {
+			return client.GetHostLuns(ctx, id, start, end)
+		})
+		if err != nil {
+			return fmt.Errorf("get mapped luns of host [%s] failed, error: %w", id, err)
+		}
+		host[mappedLunNumKey] = strconv.Itoa(len(luns))
+		host[mappedLunIdKey], host[mappedLunNameKey] = joinIdAndName(luns)
+		return nil
+	})
+}
+
+// forEachObject call the function for each object by a pool of workers, the number of workers is got by
+// getPageWorkers. Once the function fails, the objects which are not handled yet are skipped, and the first
+// error will be returned
+func forEachObject(ctx context.Context, objects []map[string]interface{},
+	fn func(context.Context, map[string]interface{}) error) error {
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	indexes := make(chan int, len(objects))
+	for index := range objects {
+		indexes <- index
+	}
+	close(indexes)
+
+	workers := getPageWorkers(ctx)
+	if workers > len(objects) {
+		workers = len(objects)
+	}
+
+	var once sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if workerCtx.Err() != nil {
+					return
+				}
+				if err := fn(workerCtx, objects[index]); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// filterObjects discard the objects which do not match the filter
+func filterObjects(objects []map[string]interface{}, filter *ObjectFilter) []map[string]interface{} {
+	return filterObjectsByName(objects, filter, constants.Name)
+}
+
+// filterObjectsByName discard the objects which do not match the filter, the object name is got by the nameKey
+func filterObjectsByName(objects []map[string]interface{}, filter *ObjectFilter,
+	nameKey string) []map[string]interface{} {
+	if filter.IsEmpty() {
+		return objects
+	}
+
+	var matched []map[string]interface{}
+	for _, object := range objects {
+		if filter.Match(getStringValue(object, constants.Id), getStringValue(object, nameKey)) {
+			matched = append(matched, object)
+		}
+	}
+	return matched
+}
+
+// joinIdAndName join the ids and names of the objects with comma
+func joinIdAndName(objects []map[string]interface{}) (string, string) {
+	ids := make([]string, 0, len(objects))
+	names := make([]string, 0, len(objects))
+	for _, object := range objects {
+		ids = append(ids, getStringValue(object, constants.Id))
+		names = append(names, getStringValue(object, constants.Name))
+	}
+	return strings.Join(ids, groupSeparator), strings.Join(names, groupSeparator)
+}
+
+func getStringValue(object map[string]interface{}, key string) string {
+	value, ok := object[key].(string)
+	if !ok {
+		return ""
+	}
+	return value
+}
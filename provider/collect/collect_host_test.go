@@ -0,0 +1,186 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func mockPageQuery(data []map[string]interface{}) (func(*centralizedstorage.CentralizedClient,
+	context.Context) (int, error), func(*centralizedstorage.CentralizedClient, context.Context, int,
+	int) ([]map[string]interface{}, error)) {
+	return func(*centralizedstorage.CentralizedClient, context.Context) (int, error) {
+			return len(data), nil
+		}, func(*centralizedstorage.CentralizedClient, context.Context, int,
+			int) ([]map[string]interface{}, error) {
+			return data, nil
+		}
+}
+
+func TestCollectHost(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "host", MetricsType: "object"}
+	hosts := []map[string]interface{}{{"ID": "1", "NAME": "host-1"}, {"ID": "2", "NAME": "host-2"}}
+	fcInitiators := []map[string]interface{}{
+		{"ID": "wwn-1", "PARENTID": "1", "RUNNINGSTATUS": "27"},
+		{"ID": "wwn-2", "PARENTID": "1", "RUNNINGSTATUS": "28"},
+		{"ID": "wwn-3", "RUNNINGSTATUS": "27"},
+	}
+	iscsiInitiators := []map[string]interface{}{{"ID": "iqn-1", "PARENTID": "2", "RUNNINGSTATUS": "27"}}
+	hostLuns := map[string][]map[string]interface{}{
+		"1": {{"ID": "11", "NAME": "lun-11"}, {"ID": "12", "NAME": "lun-12"}, {"ID": "13", "NAME": "lun-13"}},
+	}
+	want := []map[string]string{
+		{"ID": "1", "NAME": "host-1", "initiatorNum": "2", "onlineInitiatorNum": "1", "mappedLunNum": "3",
+			"initiatorIds": "wwn-1,wwn-2", "onlineInitiatorIds": "wwn-1", "mappedLunIds": "11,12,13",
+			"mappedLunNames": "lun-11,lun-12,lun-13"},
+		{"ID": "2", "NAME": "host-2", "initiatorNum": "1", "onlineInitiatorNum": "1", "mappedLunNum": "0",
+			"initiatorIds": "iqn-1", "onlineInitiatorIds": "iqn-1", "mappedLunIds": "", "mappedLunNames": ""},
+	}
+
+	// mock
+	hostCount, hostPage := mockPageQuery(hosts)
+	fcCount, fcPage := mockPageQuery(fcInitiators)
+	iscsiCount, iscsiPage := mockPageQuery(iscsiInitiators)
+	patches := gomonkey.ApplyMethod(client, "GetHostCount", hostCount).
+		ApplyMethod(client, "GetHosts", hostPage).
+		ApplyMethod(client, "GetFCInitiatorCount", fcCount).
+		ApplyMethod(client, "GetFCInitiators", fcPage).
+		ApplyMethod(client, "GetISCSIInitiatorCount", iscsiCount).
+		ApplyMethod(client, "GetISCSIInitiators", iscsiPage).
+		ApplyMethod(client, "GetHostLuns", func(_ *centralizedstorage.CentralizedClient, _ context.Context,
+			hostId string, start, end int) ([]map[string]interface{}, error) {
+			return hostLuns[hostId], nil
+		})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectHost(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectHost() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectHost()", got, want)
+}
+
+func TestCollectMappingView(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "mappingview", MetricsType: "object", ObjectNames: []string{"mv-1"}}
+	mappingViews := []map[string]interface{}{{"ID": "1", "NAME": "mv-1"}, {"ID": "2", "NAME": "mv-2"}}
+	want := []map[string]string{{"ID": "1", "NAME": "mv-1", "hostGroupId": "11", "hostGroupName": "hg-1",
+		"lunGroupId": "21,22", "lunGroupName": "lg-1,lg-2"}}
+
+	// mock
+	mvCount, mvPage := mockPageQuery(mappingViews)
+	patches := gomonkey.ApplyMethod(client, "GetMappingViewCount", mvCount).
+		ApplyMethod(client, "GetMappingViews", mvPage).
+		ApplyMethod(client, "GetHostGroupsByMappingView", func(_ *centralizedstorage.CentralizedClient,
+			_ context.Context, id string) ([]map[string]interface{}, error) {
+			return []map[string]interface{}{{"ID": "11", "NAME": "hg-1"}}, nil
+		}).
+		ApplyMethod(client, "GetLunGroupsByMappingView", func(_ *centralizedstorage.CentralizedClient,
+			_ context.Context, id string) ([]map[string]interface{}, error) {
+			return []map[string]interface{}{{"ID": "21", "NAME": "lg-1"}, {"ID": "22", "NAME": "lg-2"}}, nil
+		})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectMappingView(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectMappingView() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectMappingView()", got, want)
+}
+
+func TestForEachObject_CancelAfterError(t *testing.T) {
+	// arrange
+	ctx := context.WithValue(context.Background(), pageWorkersKey{}, 1)
+	objects := make([]map[string]interface{}, 10)
+	var called int32
+
+	// action
+	err := forEachObject(ctx, objects, func(ctx context.Context, object map[string]interface{}) error {
+		atomic.AddInt32(&called, 1)
+		return errors.New("handle failed")
+	})
+
+	// assert
+	if err == nil || err.Error() != "handle failed" {
+		t.Errorf("forEachObject() want error = handle failed, but got = %v", err)
+	}
+	if got := atomic.LoadInt32(&called); got != 1 {
+		t.Errorf("forEachObject() want the remaining objects skipped, but called = %d", got)
+	}
+}
+
+func TestForEachObject_LimitWorkers(t *testing.T) {
+	// arrange
+	ctx := context.WithValue(context.Background(), pageWorkersKey{}, 2)
+	objects := make([]map[string]interface{}, 10)
+	var running, maxRunning int32
+
+	// action
+	err := forEachObject(ctx, objects, func(ctx context.Context, object map[string]interface{}) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			old := atomic.LoadInt32(&maxRunning)
+			if current <= old || atomic.CompareAndSwapInt32(&maxRunning, old, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+
+	// assert
+	if err != nil || atomic.LoadInt32(&maxRunning) > 2 {
+		t.Errorf("forEachObject() want at most 2 workers, but got = %d, error = %v", maxRunning, err)
+	}
+}
+
+func assertDetailsContain(t *testing.T, name string, got *cmi.CollectResponse, want []map[string]string) {
+	if len(got.GetDetails()) != len(want) {
+		t.Errorf("%s got %d details, want %d", name, len(got.GetDetails()), len(want))
+		return
+	}
+	for i, detail := range got.GetDetails() {
+		for key, value := range want[i] {
+			if detail.GetData()[key] != value {
+				t.Errorf("%s got %s = %s, want %s, detail = %v", name, key, detail.GetData()[key], value,
+					detail.GetData())
+			}
+		}
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -36,11 +36,52 @@ func init() {
 	RegisterObjectHandler(constants.OceanStorage, constants.Controller, CollectController)
 	RegisterObjectHandler(constants.OceanStorage, constants.Filesystem, CollectFilesystem)
 	RegisterObjectHandler(constants.OceanStorage, constants.StoragePool, CollectStoragePool)
+	RegisterObjectHandler(constants.OceanStorage, constants.FCPort, CollectFCPort)
+	RegisterObjectHandler(constants.OceanStorage, constants.ETHPort, CollectETHPort)
+	RegisterObjectHandler(constants.OceanStorage, constants.Enclosure, CollectEnclosure)
+	RegisterObjectHandler(constants.OceanStorage, constants.PowerSupply, CollectPowerSupply)
+	RegisterObjectHandler(constants.OceanStorage, constants.Fan, CollectFan)
+	RegisterObjectHandler(constants.OceanStorage, constants.BBU, CollectBBU)
+	RegisterObjectHandler(constants.OceanStorage, constants.InterfaceModule, CollectInterfaceModule)
+	RegisterObjectHandler(constants.OceanStorage, constants.Disk, CollectDisk)
+	RegisterObjectHandler(constants.OceanStorage, constants.Host, CollectHost)
+	RegisterObjectHandler(constants.OceanStorage, constants.HostGroup, CollectHostGroup)
+	RegisterObjectHandler(constants.OceanStorage, constants.MappingView, CollectMappingView)
+	RegisterObjectHandler(constants.OceanStorage, constants.LunSnapshot, CollectLunSnapshot)
+	RegisterObjectHandler(constants.OceanStorage, constants.FSSnapshot, CollectFSSnapshot)
+	RegisterObjectHandler(constants.OceanStorage, constants.HyperMetroDomain, CollectHyperMetroDomain)
+	RegisterObjectHandler(constants.OceanStorage, constants.HyperMetroPair, CollectHyperMetroPair)
+	RegisterObjectHandler(constants.OceanStorage, constants.ReplicationPair, CollectReplicationPair)
+	RegisterObjectHandler(constants.OceanStorage, constants.NFSShare, CollectNFSShare)
+	RegisterObjectHandler(constants.OceanStorage, constants.CIFSShare, CollectCIFSShare)
+	RegisterObjectHandler(constants.OceanStorage, constants.DTree, CollectDTree)
+	RegisterObjectHandler(constants.OceanStorage, constants.Quota, CollectQuota)
+	RegisterObjectHandler(constants.OceanStorage, constants.QoS, CollectQoS)
+	RegisterObjectHandler(constants.OceanStorage, constants.Alarm, CollectAlarm)
+	RegisterObjectHandler(constants.OceanStorage, constants.VStore, CollectVStore)
+
+	RegisterObjectStreamHandler(constants.OceanStorage, constants.Lun, StreamLun)
+	RegisterObjectStreamHandler(constants.OceanStorage, constants.Filesystem, StreamFilesystem)
 }
 
 // ObjectCollector object data collector
 type ObjectCollector struct{}
 
+// GetCollectCapabilities get the collect types which have object data handler
+func (o *ObjectCollector) GetCollectCapabilities(ctx context.Context,
+	request *cmi.GetCollectCapabilitiesRequest) (*cmi.GetCollectCapabilitiesResponse, error) {
+	clientInfo, err := GetClient(ctx, request.GetBackendName(), backend.GetClientByBackendName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("objectCollector get client failed, error: [%v]", err)
+		return nil, err
+	}
+
+	return &cmi.GetCollectCapabilitiesResponse{
+		BackendName: request.GetBackendName(),
+		ObjectTypes: GetObjectCollectTypes(clientInfo.StorageType),
+	}, nil
+}
+
 // Collect this purpose of this function is to find a handler and invoke it
 func (o *ObjectCollector) Collect(ctx context.Context, request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
 	clientInfo, err := GetClient(ctx, request.GetBackendName(), backend.GetClientByBackendName)
@@ -55,7 +96,36 @@ func (o *ObjectCollector) Collect(ctx context.Context, request *cmi.CollectReque
 		return nil, err
 	}
 
-	return handler(ctx, clientInfo.Client, request)
+	return handler(WithPageWorkers(ctx, clientInfo.Client), clientInfo.Client, request)
+}
+
+// CollectStream this purpose of this function is to find a stream handler and invoke it
+// If there is no stream handler, the object handler will be invoked and the response will be sent in pages
+func (o *ObjectCollector) CollectStream(request *cmi.CollectRequest, stream cmi.Collector_CollectStreamServer) error {
+	ctx := stream.Context()
+	clientInfo, err := GetClient(ctx, request.GetBackendName(), backend.GetClientByBackendName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("objectCollector get client failed, error: [%v]", err)
+		return err
+	}
+
+	ctx = WithPageWorkers(ctx, clientInfo.Client)
+	streamHandler, err := GetObjectStreamHandler(clientInfo.StorageType, request.GetCollectType())
+	if err == nil {
+		return streamHandler(ctx, clientInfo.Client, request, stream.Send)
+	}
+
+	handler, err := GetObjectHandler(clientInfo.StorageType, request.GetCollectType())
+	if err != nil {
+		log.AddContext(ctx).Errorf("objectCollector get handler function failed, error: [%v]", err)
+		return err
+	}
+
+	response, err := handler(ctx, clientInfo.Client, request)
+	if err != nil {
+		return err
+	}
+	return SendResponseInPages(response, stream.Send)
 }
 
 // CollectArray collect object data of array in storage
@@ -76,18 +146,89 @@ func CollectStoragePool(ctx context.Context, client *centralizedstorage.Centrali
 	return DoCollect[[]map[string]interface{}, StoragePoolObject](ctx, request, client.GetStoragePools)
 }
 
+// CollectFCPort collect object data of fc port in storage
+func CollectFCPort(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return DoPageCollect[FCPortObject](ctx, request, client.GetFCPortCount, client.GetFCPorts)
+}
+
+// CollectETHPort collect object data of ethernet port in storage
+func CollectETHPort(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return DoPageCollect[ETHPortObject](ctx, request, client.GetETHPortCount, client.GetETHPorts)
+}
+
+// CollectEnclosure collect object data of enclosure in storage
+func CollectEnclosure(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return DoPageCollect[EnclosureObject](ctx, request, client.GetEnclosureCount, client.GetEnclosures)
+}
+
+// CollectPowerSupply collect object data of power supply unit in storage
+func CollectPowerSupply(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return DoPageCollect[PowerSupplyObject](ctx, request, client.GetPowerSupplyCount, client.GetPowerSupplies)
+}
+
+// CollectFan collect object data of fan in storage
+func CollectFan(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return DoPageCollect[FanObject](ctx, request, client.GetFanCount, client.GetFans)
+}
+
+// CollectBBU collect object data of backup battery unit in storage
+func CollectBBU(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return DoPageCollect[BBUObject](ctx, request, client.GetBBUCount, client.GetBBUs)
+}
+
+// CollectInterfaceModule collect object data of interface module in storage
+func CollectInterfaceModule(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	return DoPageCollect[InterfaceModuleObject](ctx, request, client.GetInterfaceModuleCount, client.GetInterfaceModules)
+}
+
 // CollectLun collect object data of lun in storage
 func CollectLun(ctx context.Context, client *centralizedstorage.CentralizedClient,
 	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	filter := NewObjectFilter(request)
+	if filter.CanQueryByObject() {
+		return DoCollectByObject[LunObject](ctx, request, filter, client.GetLunById, client.GetLunByName)
+	}
 	return DoPageCollect[LunObject](ctx, request, client.GetLunCount, client.GetLuns)
 }
 
 // CollectFilesystem collect object data of filesystem in storage
 func CollectFilesystem(ctx context.Context, client *centralizedstorage.CentralizedClient,
 	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	filter := NewObjectFilter(request)
+	if filter.CanQueryByObject() {
+		return DoCollectByObject[FileSystemObject](ctx, request, filter, client.GetFileSystemById,
+			client.GetFileSystemByName)
+	}
 	return DoPageCollect[FileSystemObject](ctx, request, client.GetFilesystemCount, client.GetFilesystem)
 }
 
+// StreamLun collect object data of lun in storage, each page of lun will be sent once it is queried
+func StreamLun(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest, send ResponseSender) error {
+	if NewObjectFilter(request).CanQueryByObject() {
+		return DoCollectStream(ctx, client, request, send, CollectLun)
+	}
+	return DoPageCollectStream[LunObject](ctx, request, client.GetLunCount, client.GetLuns, send)
+}
+
+// StreamFilesystem collect object data of filesystem in storage, each page of filesystem will be sent
+// once it is queried
+func StreamFilesystem(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest, send ResponseSender) error {
+	if NewObjectFilter(request).CanQueryByObject() {
+		return DoCollectStream(ctx, client, request, send, CollectFilesystem)
+	}
+	return DoPageCollectStream[FileSystemObject](ctx, request, client.GetFilesystemCount, client.GetFilesystem,
+		send)
+}
+
 // DoCollect collect data in storage
 func DoCollect[I, T any](ctx context.Context, request *cmi.CollectRequest,
 	fn func(context.Context) (I, error)) (*cmi.CollectResponse, error) {
@@ -99,13 +240,66 @@ func DoCollect[I, T any](ctx context.Context, request *cmi.CollectRequest,
 	return ConvertToResponse[I, T](data, request)
 }
 
+// DoCollectByObject collect data in storage by querying the objects in filter concurrently
+// If only some objects fail, the successful objects are returned and the response is marked as partial
+func DoCollectByObject[T any](ctx context.Context, request *cmi.CollectRequest, filter *ObjectFilter,
+	queryById, queryByName QueryObjectFunc) (*cmi.CollectResponse, error) {
+	data, err := filter.QueryByObject(ctx, queryById, queryByName)
+	return convertPageResult[T](ctx, request, data, err)
+}
+
+// DoCollectStream collect data in storage by the object handler, then send the response in pages
+func DoCollectStream[T any](ctx context.Context, client T, request *cmi.CollectRequest, send ResponseSender,
+	handler TObjectHandler[T]) error {
+	response, err := handler(ctx, client, request)
+	if err != nil {
+		return err
+	}
+	return SendResponseInPages(response, send)
+}
+
 // DoPageCollect page collect data in storage
+// If only some pages fail, the data of the successful pages is returned and the response is marked as partial
 func DoPageCollect[T any](ctx context.Context, request *cmi.CollectRequest,
 	countFunc CountFunc, pageFunc PageFunc) (*cmi.CollectResponse, error) {
 	data, err := ConcurrentPaginate(ctx, countFunc, pageFunc)
-	if err != nil {
+	return convertPageResult[T](ctx, request, data, err)
+}
+
+// convertPageResult convert the result of the concurrent queries to response,
+// the data of a PartialResultError is still converted and the response is marked as partial
+func convertPageResult[T any](ctx context.Context, request *cmi.CollectRequest, data []map[string]interface{},
+	err error) (*cmi.CollectResponse, error) {
+	partial := IsPartialResult(err)
+	if err != nil && !partial {
 		log.AddContext(ctx).Errorf("do page collect failed, error: %v", err)
 		return nil, err
 	}
-	return ConvertToResponse[[]map[string]interface{}, T](data, request)
+	if partial {
+		log.AddContext(ctx).Warningf("do page collect got partial result, error: %v", err)
+	}
+
+	response, err := ConvertToResponse[[]map[string]interface{}, T](data, request)
+	if err != nil {
+		return nil, err
+	}
+	response.Partial = partial
+	return response, nil
+}
+
+// DoPageCollectStream page collect data in storage, each page will be converted and sent once it is queried
+func DoPageCollectStream[T any](ctx context.Context, request *cmi.CollectRequest,
+	countFunc CountFunc, pageFunc PageFunc, send ResponseSender) error {
+	err := ConcurrentPaginateStream(ctx, countFunc, pageFunc, func(data []map[string]interface{}) error {
+		response, err := ConvertToResponse[[]map[string]interface{}, T](data, request)
+		if err != nil {
+			return err
+		}
+		return send(response)
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("do page collect stream failed, error: %v", err)
+		return err
+	}
+	return nil
 }
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -20,12 +20,14 @@ package collect
 import (
 	"context"
 	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/provider/backend"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
 )
 
 func TestObjectCollector_Collect_with_client_not_exist(t *testing.T) {
@@ -161,3 +163,195 @@ func TestDoPageCollect(t *testing.T) {
 		t.Errorf("TestDoPageCollect() failed, error = %v", err)
 	}
 }
+
+func TestDoPageCollect_with_partial_result(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{
+		BackendName: "test-backend",
+		CollectType: "test-collect",
+		MetricsType: "test-metrics",
+	}
+
+	countFunc := func(ctx context.Context) (int, error) {
+		return 300, nil
+	}
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		if start == 100 {
+			return nil, errors.New("query failed")
+		}
+		return []map[string]interface{}{{"ID": strconv.Itoa(start), "NAME": "name-" + strconv.Itoa(start)}}, nil
+	}
+
+	// action
+	got, err := DoPageCollect[LunObject](context.Background(), request, countFunc, pageFunc)
+
+	// assert
+	if err != nil {
+		t.Errorf("TestDoPageCollect_with_partial_result() failed, error = %v", err)
+		return
+	}
+	if !got.GetPartial() || len(got.GetDetails()) != 2 {
+		t.Errorf("TestDoPageCollect_with_partial_result() failed, want partial response with 2 details, "+
+			"but got partial = %v, details = %d", got.GetPartial(), len(got.GetDetails()))
+	}
+}
+
+func TestDoCollectByObject_with_partial_result(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{CollectType: "lun", MetricsType: "object", ObjectIds: []string{"3", "1", "2", "4"}}
+	filter := NewObjectFilter(request)
+	queryById := func(ctx context.Context, id string) (map[string]interface{}, error) {
+		switch id {
+		case "2":
+			return nil, errors.New("query failed")
+		case "4":
+			return nil, nil
+		default:
+			return map[string]interface{}{"ID": id, "NAME": "name-" + id}, nil
+		}
+	}
+
+	// action
+	got, err := DoCollectByObject[LunObject](context.WithValue(context.Background(), pageWorkersKey{}, 4),
+		request, filter, queryById, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("TestDoCollectByObject_with_partial_result() failed, error = %v", err)
+		return
+	}
+	if !got.GetPartial() || len(got.GetDetails()) != 2 || got.GetDetails()[0].GetData()["ID"] != "1" ||
+		got.GetDetails()[1].GetData()["ID"] != "3" {
+		t.Errorf("TestDoCollectByObject_with_partial_result() failed, want partial response with objects 1 and 3, "+
+			"but got partial = %v, details = %v", got.GetPartial(), got.GetDetails())
+	}
+}
+
+func TestDoPageCollectStream(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{
+		BackendName: "test-backend",
+		CollectType: "test-collect",
+		MetricsType: "test-metrics",
+	}
+
+	countFunc := func(ctx context.Context) (int, error) {
+		return 1000, nil
+	}
+	pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+		var result []map[string]interface{}
+		for i := start; i < end; i++ {
+			result = append(result, map[string]interface{}{
+				"ID":   "123",
+				"NAME": "name-1",
+			})
+		}
+		return result, nil
+	}
+	var pages, details int
+	send := func(response *cmi.CollectResponse) error {
+		pages++
+		details += len(response.GetDetails())
+		return nil
+	}
+
+	// action
+	err := DoPageCollectStream[LunObject](context.Background(), request, countFunc, pageFunc, send)
+
+	// assert
+	if err != nil {
+		t.Errorf("TestDoPageCollectStream() failed, error = %v", err)
+		return
+	}
+	if pages != 10 || details != 1000 {
+		t.Errorf("TestDoPageCollectStream() failed, want pages = 10, details = 1000, "+
+			"but got pages = %d, details = %d", pages, details)
+	}
+}
+
+func TestCollectPorts(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	ports := []map[string]interface{}{{"ID": "1", "NAME": "CTE0.A.IOM0.P0", "RUNNINGSTATUS": "10",
+		"WWN": "2000000000000001", "RUNSPEED": "16000", "IPV4ADDR": "192.168.1.1", "SPEED": "25000"}}
+	tests := []struct {
+		name    string
+		handler TObjectHandler[*centralizedstorage.CentralizedClient]
+		want    map[string]string
+	}{
+		{name: "collect fc ports", handler: CollectFCPort,
+			want: map[string]string{"ID": "1", "RUNNINGSTATUS": "10", "WWN": "2000000000000001", "RUNSPEED": "16000"}},
+		{name: "collect ethernet ports", handler: CollectETHPort,
+			want: map[string]string{"ID": "1", "RUNNINGSTATUS": "10", "IPV4ADDR": "192.168.1.1", "SPEED": "25000"}},
+	}
+
+	// mock
+	portCount, portPage := mockPageQuery(ports)
+	patches := gomonkey.ApplyMethod(client, "GetFCPortCount", portCount).
+		ApplyMethod(client, "GetFCPorts", portPage).
+		ApplyMethod(client, "GetETHPortCount", portCount).
+		ApplyMethod(client, "GetETHPorts", portPage)
+	defer patches.Reset()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got, err := tt.handler(context.Background(), client, &cmi.CollectRequest{})
+
+			// assert
+			if err != nil {
+				t.Errorf("%s error = %v", tt.name, err)
+				return
+			}
+			assertDetailsContain(t, tt.name, got, []map[string]string{tt.want})
+		})
+	}
+}
+
+func TestCollectHardwareComponents(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	components := []map[string]interface{}{{"ID": "0", "NAME": "CTE0", "LOCATION": "CTE0", "MODEL": "2U",
+		"HEALTHSTATUS": "1", "RUNNINGSTATUS": "2", "TEMPERATURE": "35"}}
+	status := map[string]string{"ID": "0", "NAME": "CTE0", "HEALTHSTATUS": "1", "RUNNINGSTATUS": "2"}
+	withTemperature := map[string]string{"ID": "0", "HEALTHSTATUS": "1", "RUNNINGSTATUS": "2", "TEMPERATURE": "35"}
+	tests := []struct {
+		name    string
+		handler TObjectHandler[*centralizedstorage.CentralizedClient]
+		want    map[string]string
+	}{
+		{name: "collect enclosures", handler: CollectEnclosure, want: withTemperature},
+		{name: "collect power supplies", handler: CollectPowerSupply, want: status},
+		{name: "collect fans", handler: CollectFan, want: status},
+		{name: "collect bbus", handler: CollectBBU, want: status},
+		{name: "collect interface modules", handler: CollectInterfaceModule, want: withTemperature},
+	}
+
+	// mock
+	componentCount, componentPage := mockPageQuery(components)
+	patches := gomonkey.ApplyMethod(client, "GetEnclosureCount", componentCount).
+		ApplyMethod(client, "GetEnclosures", componentPage).
+		ApplyMethod(client, "GetPowerSupplyCount", componentCount).
+		ApplyMethod(client, "GetPowerSupplies", componentPage).
+		ApplyMethod(client, "GetFanCount", componentCount).
+		ApplyMethod(client, "GetFans", componentPage).
+		ApplyMethod(client, "GetBBUCount", componentCount).
+		ApplyMethod(client, "GetBBUs", componentPage).
+		ApplyMethod(client, "GetInterfaceModuleCount", componentCount).
+		ApplyMethod(client, "GetInterfaceModules", componentPage)
+	defer patches.Reset()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got, err := tt.handler(context.Background(), client, &cmi.CollectRequest{})
+
+			// assert
+			if err != nil {
+				t.Errorf("%s error = %v", tt.name, err)
+				return
+			}
+			assertDetailsContain(t, tt.name, got, []map[string]string{tt.want})
+		})
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -23,11 +23,14 @@ import (
 	"strconv"
 	"time"
 
+	cmiConfig "github.com/huawei/csm/v2/config/cmi"
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/provider/backend"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/provider/utils"
 	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/storage/client/distributedstorage"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -36,6 +39,10 @@ func init() {
 	RegisterPerformanceHandler(constants.OceanStorage, constants.Filesystem, GetFilesystemNameMapping)
 	RegisterPerformanceHandler(constants.OceanStorage, constants.Controller, GetControllerNameMapping)
 	RegisterPerformanceHandler(constants.OceanStorage, constants.StoragePool, GetStoragePoolNameMapping)
+	RegisterPerformanceHandler(constants.OceanStorage, constants.FCPort, GetFCPortNameMapping)
+	RegisterPerformanceHandler(constants.OceanStorage, constants.ETHPort, GetETHPortNameMapping)
+	RegisterNameLookupHandler(constants.OceanStorage, constants.Lun, LookupLunNames)
+	RegisterNameLookupHandler(constants.OceanStorage, constants.Filesystem, LookupFilesystemNames)
 }
 
 // PerformanceCollector performance data collector
@@ -49,94 +56,254 @@ func (p *PerformanceCollector) Collect(ctx context.Context, request *cmi.Collect
 		return nil, err
 	}
 
-	client, ok := clientInfo.Client.(*centralizedstorage.CentralizedClient)
-	if !ok {
-		return nil, errors.New("convert Client to centralizedClient failed")
+	ctx = WithPageWorkers(ctx, clientInfo.Client)
+	switch client := clientInfo.Client.(type) {
+	case *centralizedstorage.CentralizedClient:
+		return CollectPerformance(ctx, client, clientInfo.Profile, request)
+	case *distributedstorage.DistributedClient:
+		return CollectDistributedPerformance(ctx, client, request)
+	default:
+		return nil, errors.New("convert Client to storage client failed")
+	}
+}
+
+// CollectStream collect performance data and send the response in pages
+// The details of each page are converted only when the page is sent, so the whole converted response
+// is never held in memory
+func (p *PerformanceCollector) CollectStream(request *cmi.CollectRequest,
+	stream cmi.Collector_CollectStreamServer) error {
+	ctx := stream.Context()
+	clientInfo, err := GetClient(ctx, request.GetBackendName(), backend.GetClientByBackendName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("performanceCollector get client failed, error: %v", err)
+		return err
+	}
+
+	ctx = WithPageWorkers(ctx, clientInfo.Client)
+	var performances []PerformanceIndicators
+	var nameMapping map[string]string
+	switch client := clientInfo.Client.(type) {
+	case *centralizedstorage.CentralizedClient:
+		performances, nameMapping, err = QueryPerformance(ctx, client, clientInfo.Profile, request)
+	case *distributedstorage.DistributedClient:
+		performances, nameMapping, err = QueryDistributedPerformance(ctx, client, request)
+	default:
+		return errors.New("convert Client to storage client failed")
+	}
+	if err != nil {
+		return err
+	}
+	return SendPerformanceInPages(performances, nameMapping, request, stream.Send)
+}
+
+// GetCollectCapabilities get the collect types which have performance data handler and are supported by
+// the storage profile, and the indicators reported as available by the storage
+func (p *PerformanceCollector) GetCollectCapabilities(ctx context.Context,
+	request *cmi.GetCollectCapabilitiesRequest) (*cmi.GetCollectCapabilitiesResponse, error) {
+	clientInfo, err := GetClient(ctx, request.GetBackendName(), backend.GetClientByBackendName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("performanceCollector get client failed, error: %v", err)
+		return nil, err
 	}
 
-	return CollectPerformance(ctx, client, request)
+	// only the centralized storage supports querying the available indicators,
+	// the indicators of distributed storage are fixed
+	response := &cmi.GetCollectCapabilitiesResponse{BackendName: request.GetBackendName()}
+	for _, collectType := range GetPerformanceCollectTypes(clientInfo.StorageType) {
+		objectType, ok := IndicatorsMapping[collectType]
+		if !ok || !clientInfo.Profile.SupportPerformanceCollectType(collectType) {
+			continue
+		}
+
+		capability := &cmi.PerformanceCapability{CollectType: collectType, Indicators: []string{}}
+		switch client := clientInfo.Client.(type) {
+		case *centralizedstorage.CentralizedClient:
+			capability.Indicators = GetAvailableIndicators(ctx, client, objectType)
+		case *distributedstorage.DistributedClient:
+			capability.Indicators = GetDistributedIndicators(collectType)
+		}
+		response.PerformanceTypes = append(response.PerformanceTypes, capability)
+	}
+	return response, nil
+}
+
+// GetAvailableIndicators query the indicators reported as available by the storage
+// Some storage versions do not support the query, an empty result will be returned then.
+func GetAvailableIndicators(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	objectType int) []string {
+	data, err := client.GetPerformanceIndicators(ctx, objectType)
+	if err != nil {
+		log.AddContext(ctx).Warningf("query available indicators of object type [%d] failed, error: %v",
+			objectType, err)
+		return []string{}
+	}
+
+	capabilities, err := utils.MapToStructSlice[[]map[string]interface{}, PerformanceIndicatorsCapability](data)
+	if err != nil {
+		log.AddContext(ctx).Warningf("convert available indicators failed, error: %v", err)
+		return []string{}
+	}
+
+	indicators := []string{}
+	for _, capability := range capabilities {
+		if capability.ObjectType != objectType {
+			continue
+		}
+		for _, indicator := range capability.Indicators {
+			indicators = append(indicators, strconv.Itoa(indicator))
+		}
+	}
+	return indicators
 }
 
 // CollectPerformance collect performance data
 func CollectPerformance(ctx context.Context, client *centralizedstorage.CentralizedClient,
-	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	profile backend.StorageProfile, request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	performances, nameMapping, err := QueryPerformance(ctx, client, profile, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// merge performance data and object name.
+	return MergePerformance(performances, nameMapping, request), nil
+}
+
+// QueryPerformance query performance data and the name mapping of the objects in the performance data
+// The collect types which are not supported by the storage according to the profile get empty data
+func QueryPerformance(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	profile backend.StorageProfile, request *cmi.CollectRequest) ([]PerformanceIndicators, map[string]string,
+	error) {
+	profile, err := refreshProfile(ctx, request.GetBackendName(), client, profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !profile.SupportPerformanceCollectType(request.GetCollectType()) {
+		log.AddContext(ctx).Infof("collect type [%s] has no available performance indicators in the storage",
+			request.GetCollectType())
+		return nil, nil, nil
+	}
+
 	// get all performance data.
-	performances, err := GetPerformanceData(ctx, client, request)
+	performances, err := GetPerformanceData(ctx, client, profile, request)
 	if err != nil {
 		log.AddContext(ctx).Errorf("collect performance data failed, error: %v", err)
-		return nil, err
+		return nil, nil, err
 	}
 
+	// the objects which do not match the ids in request are discarded before querying their names
+	getMapping := GetCachedMapping
+	if len(request.GetObjectIds()) != 0 {
+		performances = FilterPerformanceByIds(performances, NewObjectFilter(request))
+		getMapping = GetFilteredMapping
+	}
 	if len(performances) == 0 {
-		return BuildResponse(request), nil
+		return nil, nil, nil
 	}
 
 	// get all objects id and name.
-	nameMapping, err := GetMapping(ctx, request.GetCollectType(), client)
+	nameMapping, err := getMapping(ctx, request.GetBackendName(), constants.OceanStorage,
+		request.GetCollectType(), client, GetPerformanceObjectIds(performances))
 	if err != nil {
 		log.AddContext(ctx).Errorf("collect object name mapping data failed, error: %v", err)
-		return nil, err
+		return nil, nil, err
 	}
+	return performances, nameMapping, nil
+}
 
-	// merge performance data and object name.
-	return MergePerformance(performances, nameMapping, request), nil
+// refreshProfile detect the profile of the storage again if it is stale and save it into the cached client info.
+// If the detection fails, the former profile is kept for another profileMaxAge if it has been detected,
+// otherwise the error is returned because the storage version is unknown.
+func refreshProfile(ctx context.Context, backendName string, client *centralizedstorage.CentralizedClient,
+	profile backend.StorageProfile) (backend.StorageProfile, error) {
+	if !profile.IsStale() {
+		return profile, nil
+	}
+
+	detected, err := backend.DetectCentralizedProfile(ctx, client)
+	if err != nil && !profile.Detected {
+		log.AddContext(ctx).Errorf("detect storage profile failed, error: %v", err)
+		return profile, err
+	}
+	if err != nil {
+		log.AddContext(ctx).Warningf("refresh storage profile failed, keep the former profile, error: %v", err)
+		detected = profile
+		detected.DetectedAt = time.Now()
+	}
+	UpdateClientProfile(backendName, client, detected)
+	return detected, nil
 }
 
 // GetPerformanceData query performance data
+// The profile detected after login decides whether the post request is used, and the profile is detected
+// again if it is stale. For storage earlier than 6.1.2, the data is queried by the sampler, and the data of
+// a former sample has the sample time as its timestamp.
 func GetPerformanceData(ctx context.Context, client *centralizedstorage.CentralizedClient,
-	request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
+	profile backend.StorageProfile, request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
 	objectType, ok := IndicatorsMapping[request.CollectType]
 	if !ok {
-		return nil, errors.New("illegalArgumentErrorunsupported collect type")
+		return nil, cmierror.New(cmierror.InvalidArgument, "unsupported collect type [%s]", request.CollectType)
 	}
 
-	storageInfo, err := client.GetSystemInfo(ctx)
-	if err != nil {
-		log.AddContext(ctx).Errorf("get storage system info failed, error: %v", err)
-		return nil, err
-	}
-
-	var mapData []map[string]interface{}
-	var postEnable bool
 	indicators := utils.MapStringToInt(request.Indicators)
-	version, ok := storageInfo["pointRelease"].(string)
-	if !ok {
-		// storage of V3 or V5 not has the pointRelease field
-		postEnable = false
+	if IsHistoryRequest(request) {
+		return GetHistoryPerformanceData(ctx, client, objectType, indicators, request)
 	}
 
-	if utils.CompareVersions(version, constants.MinVersionSupportPost) != -1 {
-		// 6.1.2 and later versions support the Post request
-		postEnable = true
+	profile, err := refreshProfile(ctx, request.GetBackendName(), client, profile)
+	if err != nil {
+		return nil, err
 	}
 
-	if postEnable {
+	var mapData []map[string]interface{}
+	var sampledAt time.Time
+	if profile.SupportPerformancePost {
 		mapData, err = client.GetPerformanceByPost(ctx, objectType, indicators)
 	} else {
-		for i := 0; i < 5; i++ {
-			mapData, err = client.GetPerformance(ctx, objectType, indicators)
-			// For storage v6 earlier 6.1.2, if it can not return the performance data caused by concurrency,
-			// both the mapData and err are nil. But in the same conditions for storage v3 or v5, the mapData
-			// is nil while the err is not nil.
-			if err != nil {
-				break
-			}
-			if len(mapData) != 0 {
-				break
-			}
-			time.Sleep(5 * time.Second)
-		}
+		// For storage v6 earlier 6.1.2, if it can not return the performance data caused by concurrency,
+		// both the mapData and err are nil. But in the same conditions for storage v3 or v5, the mapData
+		// is nil while the err is not nil.
+		mapData, sampledAt, err = SamplePerformance(ctx, request.GetBackendName(), objectType, indicators,
+			func(ctx context.Context) ([]map[string]interface{}, error) {
+				return client.GetPerformance(ctx, objectType, indicators)
+			})
 	}
 	if err != nil {
 		log.AddContext(ctx).Errorf("invoke the get performance method of storage client failed, error: %v", err)
 		return nil, err
 	}
 
-	// For storage v6 earlier 6.1.2, the storage may return empty data even after 5 time retries.
+	// For storage v6 earlier 6.1.2, the storage may return empty data before the sampler gets the data.
 	if len(mapData) == 0 {
 		log.AddContext(ctx).Warningln("get empty data by the get performance method of storage client")
 	}
 
+	performances, err := utils.MapToStruct[[]map[string]interface{}, []PerformanceIndicators](mapData)
+	if err != nil || sampledAt.IsZero() {
+		return performances, err
+	}
+	log.AddContext(ctx).Infof("use the performance data sampled at %v", sampledAt)
+	for i := range performances {
+		performances[i].Timestamp = sampledAt.Unix()
+	}
+	return performances, nil
+}
+
+// IsHistoryRequest whether the request is to collect the historical performance data in a time range
+func IsHistoryRequest(request *cmi.CollectRequest) bool {
+	return request.GetStartTime() != 0 || request.GetEndTime() != 0
+}
+
+// GetHistoryPerformanceData query the historical performance data in the time range of request
+func GetHistoryPerformanceData(ctx context.Context, client *centralizedstorage.CentralizedClient, objectType int,
+	indicators []int, request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
+	mapData, err := client.GetHistoryPerformance(ctx, objectType, indicators,
+		request.GetStartTime(), request.GetEndTime(), request.GetInterval())
+	if err != nil {
+		log.AddContext(ctx).Errorf("get history performance data failed, start time: %d, end time: %d, "+
+			"error: %v", request.GetStartTime(), request.GetEndTime(), err)
+		return nil, err
+	}
+
 	return utils.MapToStruct[[]map[string]interface{}, []PerformanceIndicators](mapData)
 }
 
@@ -144,9 +311,9 @@ func GetPerformanceData(ctx context.Context, client *centralizedstorage.Centrali
 // result map key is object id.
 //
 //	result map value is object name.
-func GetMapping(ctx context.Context, collectType string,
-	client *centralizedstorage.CentralizedClient) (map[string]string, error) {
-	handler, err := GetPerformanceHandler(constants.OceanStorage, collectType)
+func GetMapping(ctx context.Context, storageType, collectType string,
+	client interface{}) (map[string]string, error) {
+	handler, err := GetPerformanceHandler(storageType, collectType)
 	if err != nil {
 		log.AddContext(ctx).Errorf("get performance handler failed, error: %v", err)
 		return nil, err
@@ -155,23 +322,90 @@ func GetMapping(ctx context.Context, collectType string,
 	return handler(ctx, client)
 }
 
+// FilterPerformanceByIds get the performance data of the objects which match the ids of filter
+func FilterPerformanceByIds(performances []PerformanceIndicators, filter *ObjectFilter) []PerformanceIndicators {
+	filtered := make([]PerformanceIndicators, 0, len(performances))
+	for _, performance := range performances {
+		if filter.MatchId(performance.ObjectId) {
+			filtered = append(filtered, performance)
+		}
+	}
+	return filtered
+}
+
+// GetPerformanceObjectIds get the distinct object ids of the performance data
+func GetPerformanceObjectIds(performances []PerformanceIndicators) []string {
+	ids := make([]string, 0, len(performances))
+	seen := make(map[string]struct{}, len(performances))
+	for _, performance := range performances {
+		if _, ok := seen[performance.ObjectId]; ok {
+			continue
+		}
+		seen[performance.ObjectId] = struct{}{}
+		ids = append(ids, performance.ObjectId)
+	}
+	return ids
+}
+
 // MergePerformance merge performance data
+// The objects which do not match the object filter in request will be discarded, and the timestamps of
+// the historical performance data are kept in the details
 func MergePerformance(performances []PerformanceIndicators, nameMapping map[string]string,
 	request *cmi.CollectRequest) *cmi.CollectResponse {
 
+	filter := NewObjectFilter(request)
 	response := BuildResponse(request)
 	for _, performance := range performances {
-		mapData := performance.ToMap()
-		objectName, ok := nameMapping[performance.ObjectId]
+		detail, ok := mergePerformanceDetail(performance, nameMapping, filter)
+		if ok {
+			response.Details = append(response.Details, detail)
+		}
+	}
+
+	return response
+}
+
+// SendPerformanceInPages merge performance data in pages, each page is sent once it is merged
+func SendPerformanceInPages(performances []PerformanceIndicators, nameMapping map[string]string,
+	request *cmi.CollectRequest, send ResponseSender) error {
+	pageSize := cmiConfig.GetQueryStoragePageSize()
+	if pageSize <= 0 || len(performances) <= pageSize {
+		return send(MergePerformance(performances, nameMapping, request))
+	}
+
+	filter := NewObjectFilter(request)
+	page := BuildResponse(request)
+	for _, performance := range performances {
+		detail, ok := mergePerformanceDetail(performance, nameMapping, filter)
 		if !ok {
 			continue
 		}
-		mapData[constants.ObjectName] = objectName
-		mapData[constants.ObjectId] = performance.ObjectId
-		AddCollectDetailWithMap(mapData, response)
+		page.Details = append(page.Details, detail)
+		if len(page.Details) < pageSize {
+			continue
+		}
+		if err := send(page); err != nil {
+			return err
+		}
+		page = BuildResponse(request)
 	}
 
-	return response
+	if len(page.Details) == 0 {
+		return nil
+	}
+	return send(page)
+}
+
+func mergePerformanceDetail(performance PerformanceIndicators, nameMapping map[string]string,
+	filter *ObjectFilter) (*cmi.CollectDetail, bool) {
+	objectName, ok := nameMapping[performance.ObjectId]
+	if !ok || !filter.Match(performance.ObjectId, objectName) {
+		return nil, false
+	}
+	mapData := performance.ToMap()
+	mapData[constants.ObjectName] = objectName
+	mapData[constants.ObjectId] = performance.ObjectId
+	return &cmi.CollectDetail{Data: mapData, Timestamp: performance.Timestamp}, true
 }
 
 // ToMap Parse performance data and convert it into a map
@@ -199,13 +433,14 @@ func GetNameMapping(ctx context.Context, queryFunc QueryFunc) (map[string]string
 }
 
 // GetNameMappingWithPage A universal function for obtaining name mapping with page query
+// The objects of the successful pages are still mapped with a PartialResultError when only some pages fail
 func GetNameMappingWithPage(ctx context.Context, countFunc CountFunc, pageFunc PageFunc) (map[string]string, error) {
 	data, err := ConcurrentPaginate(ctx, countFunc, pageFunc)
-	if err != nil {
+	if err != nil && !IsPartialResult(err) {
 		log.AddContext(ctx).Errorf("concurrent Paginate failed, error: %v", err)
 		return nil, err
 	}
-	return DoNameMapping(data), nil
+	return DoNameMapping(data), err
 }
 
 // DoNameMapping A universal function for parsing name mapping
@@ -255,3 +490,46 @@ func GetStoragePoolNameMapping(ctx context.Context,
 	client *centralizedstorage.CentralizedClient) (map[string]string, error) {
 	return GetNameMapping(ctx, client.GetStoragePools)
 }
+
+// GetFCPortNameMapping get fc port name mapping
+// Key is fc port id.
+// Value is fc port name.
+func GetFCPortNameMapping(ctx context.Context,
+	client *centralizedstorage.CentralizedClient) (map[string]string, error) {
+	return GetNameMappingWithPage(ctx, client.GetFCPortCount, client.GetFCPorts)
+}
+
+// GetETHPortNameMapping get ethernet port name mapping
+// Key is ethernet port id.
+// Value is ethernet port name.
+func GetETHPortNameMapping(ctx context.Context,
+	client *centralizedstorage.CentralizedClient) (map[string]string, error) {
+	return GetNameMappingWithPage(ctx, client.GetETHPortCount, client.GetETHPorts)
+}
+
+// LookupLunNames look up the names of the specified luns
+// Key is lun id.
+// Value is lun name.
+func LookupLunNames(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	ids []string) (map[string]string, error) {
+	return LookupNamesById(ctx, ids, client.GetLunById)
+}
+
+// LookupFilesystemNames look up the names of the specified filesystems
+// Key is filesystem id.
+// Value is filesystem name.
+func LookupFilesystemNames(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	ids []string) (map[string]string, error) {
+	return LookupNamesById(ctx, ids, client.GetFileSystemById)
+}
+
+// LookupNamesById A universal function for looking up the names of the objects concurrently,
+// the objects which do not exist are not in the result, and an error is returned if any object fails
+func LookupNamesById(ctx context.Context, ids []string,
+	queryFunc func(context.Context, string) (map[string]interface{}, error)) (map[string]string, error) {
+	data, err := QueryObjects(ctx, ids, queryFunc)
+	if err != nil {
+		return nil, err
+	}
+	return DoNameMapping(data), nil
+}
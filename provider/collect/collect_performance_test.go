@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -20,12 +20,15 @@ package collect
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/backend"
 	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/provider/utils"
 	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
@@ -131,13 +134,15 @@ func TestCollectPerformance_with_get_performance_data_error(t *testing.T) {
 
 	// mock
 	applyFunc := gomonkey.ApplyFunc(GetPerformanceData, func(ctx context.Context,
-		client *centralizedstorage.CentralizedClient, request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
+		client *centralizedstorage.CentralizedClient, profile backend.StorageProfile,
+		request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
 		return []PerformanceIndicators{}, errors.New("GetPerformanceData error")
 	})
 	defer applyFunc.Reset()
 
 	// action
-	_, err := CollectPerformance(context.Background(), client, request)
+	_, err := CollectPerformance(context.Background(), client,
+		backend.StorageProfile{Detected: true, DetectedAt: time.Now()}, request)
 
 	// assert
 	if err == nil {
@@ -153,17 +158,19 @@ func TestCollectPerformance_with_get_mapping_error(t *testing.T) {
 	// mock
 	applyFunc := gomonkey.
 		ApplyFunc(GetPerformanceData, func(ctx context.Context,
-			client *centralizedstorage.CentralizedClient, request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
+			client *centralizedstorage.CentralizedClient, profile backend.StorageProfile,
+			request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
 			return []PerformanceIndicators{{}}, nil
 		}).
-		ApplyFunc(GetMapping, func(ctx context.Context, collectType string,
-			client *centralizedstorage.CentralizedClient) (map[string]string, error) {
+		ApplyFunc(GetMapping, func(ctx context.Context, storageType, collectType string,
+			client interface{}) (map[string]string, error) {
 			return nil, errors.New("GetMapping error")
 		})
 	defer applyFunc.Reset()
 
 	// action
-	_, err := CollectPerformance(context.Background(), client, request)
+	_, err := CollectPerformance(context.Background(), client,
+		backend.StorageProfile{Detected: true, DetectedAt: time.Now()}, request)
 
 	// assert
 	if err == nil {
@@ -179,11 +186,12 @@ func TestCollectPerformance_with_success(t *testing.T) {
 	// mock
 	applyFunc := gomonkey.
 		ApplyFunc(GetPerformanceData, func(ctx context.Context,
-			client *centralizedstorage.CentralizedClient, request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
+			client *centralizedstorage.CentralizedClient, profile backend.StorageProfile,
+			request *cmi.CollectRequest) ([]PerformanceIndicators, error) {
 			return []PerformanceIndicators{}, nil
 		}).
-		ApplyFunc(GetMapping, func(ctx context.Context, collectType string,
-			client *centralizedstorage.CentralizedClient) (map[string]string, error) {
+		ApplyFunc(GetMapping, func(ctx context.Context, storageType, collectType string,
+			client interface{}) (map[string]string, error) {
 			return map[string]string{}, nil
 		}).
 		ApplyFunc(MergePerformance, func(performances []PerformanceIndicators, nameMapping map[string]string,
@@ -193,7 +201,8 @@ func TestCollectPerformance_with_success(t *testing.T) {
 	defer applyFunc.Reset()
 
 	// action
-	_, err := CollectPerformance(context.Background(), client, request)
+	_, err := CollectPerformance(context.Background(), client,
+		backend.StorageProfile{Detected: true, DetectedAt: time.Now()}, request)
 
 	// assert
 	if err != nil {
@@ -217,6 +226,10 @@ func TestGetPerformanceData_with_storage_V7_success(t *testing.T) {
 				"pointRelease": "V700R001C00",
 			}, nil
 		}).
+		ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+			objectType int) ([]map[string]interface{}, error) {
+			return nil, errors.New("not supported")
+		}).
 		ApplyMethodFunc(client, "GetPerformanceByPost", func(ctx context.Context,
 			objectType int, indicators []int) ([]map[string]interface{}, error) {
 			return []map[string]interface{}{
@@ -230,7 +243,7 @@ func TestGetPerformanceData_with_storage_V7_success(t *testing.T) {
 	defer applyFunc.Reset()
 
 	// action
-	_, err := GetPerformanceData(context.Background(), client, request)
+	_, err := GetPerformanceData(context.Background(), client, backend.StorageProfile{}, request)
 
 	// assert
 	if err != nil {
@@ -254,6 +267,10 @@ func TestGetPerformanceData_with_storage_greater_V612_success(t *testing.T) {
 				"pointRelease": "6.1.7",
 			}, nil
 		}).
+		ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+			objectType int) ([]map[string]interface{}, error) {
+			return nil, errors.New("not supported")
+		}).
 		ApplyMethodFunc(client, "GetPerformanceByPost", func(ctx context.Context,
 			objectType int, indicators []int) ([]map[string]interface{}, error) {
 			return []map[string]interface{}{
@@ -267,7 +284,7 @@ func TestGetPerformanceData_with_storage_greater_V612_success(t *testing.T) {
 	defer applyFunc.Reset()
 
 	// action
-	_, err := GetPerformanceData(context.Background(), client, request)
+	_, err := GetPerformanceData(context.Background(), client, backend.StorageProfile{}, request)
 
 	// assert
 	if err != nil {
@@ -291,6 +308,10 @@ func TestGetPerformanceData_with_storage_V610_success(t *testing.T) {
 				"pointRelease": "6.1.0",
 			}, nil
 		}).
+		ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+			objectType int) ([]map[string]interface{}, error) {
+			return nil, errors.New("not supported")
+		}).
 		ApplyMethodFunc(client, "GetPerformance", func(ctx context.Context,
 			objectType int, indicators []int) ([]map[string]interface{}, error) {
 			return []map[string]interface{}{
@@ -304,7 +325,7 @@ func TestGetPerformanceData_with_storage_V610_success(t *testing.T) {
 	defer applyFunc.Reset()
 
 	// action
-	_, err := GetPerformanceData(context.Background(), client, request)
+	_, err := GetPerformanceData(context.Background(), client, backend.StorageProfile{}, request)
 
 	// assert
 	if err != nil {
@@ -314,7 +335,7 @@ func TestGetPerformanceData_with_storage_V610_success(t *testing.T) {
 
 func TestGetPerformanceData_with_storage_V610_empty_return_success(t *testing.T) {
 	// arrange
-	request := &cmi.CollectRequest{CollectType: constants.Filesystem}
+	request := &cmi.CollectRequest{CollectType: constants.Filesystem, BackendName: "test-backend-v610-empty"}
 	client := &centralizedstorage.CentralizedClient{}
 
 	// mock
@@ -328,14 +349,21 @@ func TestGetPerformanceData_with_storage_V610_empty_return_success(t *testing.T)
 				"pointRelease": "6.1.0",
 			}, nil
 		}).
+		ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+			objectType int) ([]map[string]interface{}, error) {
+			return nil, errors.New("not supported")
+		}).
 		ApplyMethodFunc(client, "GetPerformance", func(ctx context.Context,
 			objectType int, indicators []int) ([]map[string]interface{}, error) {
 			return nil, nil
 		})
 	defer applyFunc.Reset()
+	defer InvalidatePerformanceSamples(request.GetBackendName())
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
 
 	// action
-	_, err := GetPerformanceData(context.Background(), client, request)
+	_, err := GetPerformanceData(ctx, client, backend.StorageProfile{}, request)
 
 	// assert
 	if err != nil {
@@ -357,6 +385,10 @@ func TestGetPerformanceData_with_storage_V3orV5_success(t *testing.T) {
 			map[string]interface{}, error) {
 			return map[string]interface{}{}, nil
 		}).
+		ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+			objectType int) ([]map[string]interface{}, error) {
+			return nil, errors.New("not supported")
+		}).
 		ApplyMethodFunc(client, "GetPerformance", func(ctx context.Context,
 			objectType int, indicators []int) ([]map[string]interface{}, error) {
 			return []map[string]interface{}{
@@ -370,7 +402,7 @@ func TestGetPerformanceData_with_storage_V3orV5_success(t *testing.T) {
 	defer applyFunc.Reset()
 
 	// action
-	_, err := GetPerformanceData(context.Background(), client, request)
+	_, err := GetPerformanceData(context.Background(), client, backend.StorageProfile{}, request)
 
 	// assert
 	if err != nil {
@@ -378,13 +410,165 @@ func TestGetPerformanceData_with_storage_V3orV5_success(t *testing.T) {
 	}
 }
 
+func TestGetPerformanceData_with_detected_profile(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{CollectType: constants.Lun, Indicators: []string{"22"}}
+	client := &centralizedstorage.CentralizedClient{}
+	profile := backend.StorageProfile{Detected: true, DetectedAt: time.Now(), PointRelease: "6.1.7",
+		SupportPerformancePost: true}
+
+	// mock
+	var systemInfoCalled bool
+	applyFunc := gomonkey.
+		ApplyMethodFunc(client, "GetSystemInfo", func(ctx context.Context) (map[string]interface{}, error) {
+			systemInfoCalled = true
+			return nil, errors.New("system info should not be queried")
+		}).
+		ApplyMethodFunc(client, "GetPerformanceByPost", func(ctx context.Context,
+			objectType int, indicators []int) ([]map[string]interface{}, error) {
+			return []map[string]interface{}{
+				{"indicators": []int{22}, "indicator_values": []float64{1.0}, "object_id": "1"},
+			}, nil
+		})
+	defer applyFunc.Reset()
+
+	// action
+	got, err := GetPerformanceData(context.Background(), client, profile, request)
+
+	// assert
+	if err != nil || len(got) != 1 {
+		t.Errorf("TestGetPerformanceData_with_detected_profile() want 1 data, but got = %v, error = %v", got, err)
+	}
+	if systemInfoCalled {
+		t.Error("TestGetPerformanceData_with_detected_profile() want system info not queried, but it is queried")
+	}
+}
+
+func TestGetPerformanceData_with_profile_saved(t *testing.T) {
+	// arrange
+	backendName := "test-backend-profile-saved"
+	request := &cmi.CollectRequest{CollectType: constants.Lun, Indicators: []string{"22"}, BackendName: backendName}
+	client := &centralizedstorage.CentralizedClient{}
+	RegisterClient(backendName, backend.ClientInfo{StorageName: backendName, Client: client})
+	defer RemoveClient(backendName)
+
+	// mock
+	applyFunc := gomonkey.
+		ApplyMethodFunc(client, "GetSystemInfo", func(ctx context.Context) (map[string]interface{}, error) {
+			return map[string]interface{}{"pointRelease": "6.1.7"}, nil
+		}).
+		ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+			objectType int) ([]map[string]interface{}, error) {
+			return nil, errors.New("not supported")
+		}).
+		ApplyMethodFunc(client, "GetPerformanceByPost", func(ctx context.Context,
+			objectType int, indicators []int) ([]map[string]interface{}, error) {
+			return []map[string]interface{}{}, nil
+		})
+	defer applyFunc.Reset()
+
+	// action
+	_, err := GetPerformanceData(context.Background(), client, backend.StorageProfile{}, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("TestGetPerformanceData_with_profile_saved() error = %v", err)
+		return
+	}
+	if profile := clientCache[backendName].Profile; profile.IsStale() || !profile.SupportPerformancePost {
+		t.Errorf("TestGetPerformanceData_with_profile_saved() want detected profile saved, but got = %+v", profile)
+	}
+}
+
+func TestGetPerformanceData_with_former_sample(t *testing.T) {
+	// arrange
+	backendName := "test-backend-former-sample"
+	request := &cmi.CollectRequest{CollectType: constants.Lun, Indicators: []string{"22"}, BackendName: backendName}
+	client := &centralizedstorage.CentralizedClient{}
+	profile := backend.StorageProfile{Detected: true, DetectedAt: time.Now(), PointRelease: "6.1.0"}
+	performanceSampler.store(backendName, fmt.Sprintf("%d:%v", IndicatorsMapping[constants.Lun], []int{22}),
+		[]map[string]interface{}{{"indicators": []int{22}, "indicator_values": []float64{1.0}, "object_id": "1"}})
+	defer InvalidatePerformanceSamples(backendName)
+
+	// mock
+	applyFunc := gomonkey.ApplyMethodFunc(client, "GetPerformance", func(ctx context.Context,
+		objectType int, indicators []int) ([]map[string]interface{}, error) {
+		return nil, nil
+	})
+	defer applyFunc.Reset()
+
+	// action
+	got, err := GetPerformanceData(context.Background(), client, profile, request)
+
+	// assert
+	if err != nil || len(got) != 1 || got[0].Timestamp == 0 {
+		t.Errorf("TestGetPerformanceData_with_former_sample() want 1 data with timestamp, "+
+			"but got = %v, error = %v", got, err)
+	}
+}
+
+func TestCollectPerformance_with_unsupported_collect_type(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{CollectType: constants.FCPort}
+	client := &centralizedstorage.CentralizedClient{}
+	profile := backend.StorageProfile{Detected: true, DetectedAt: time.Now(),
+		PerformanceCollectTypes: []string{constants.Lun}}
+
+	// action
+	got, err := CollectPerformance(context.Background(), client, profile, request)
+
+	// assert
+	if err != nil || len(got.GetDetails()) != 0 {
+		t.Errorf("TestCollectPerformance_with_unsupported_collect_type() want empty response, "+
+			"but got = %v, error = %v", got, err)
+	}
+}
+
+func TestGetPerformanceData_with_history_success(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{CollectType: constants.Lun, Indicators: []string{"22"},
+		StartTime: 1700000000, EndTime: 1700000300, Interval: 60}
+	client := &centralizedstorage.CentralizedClient{}
+	want := []PerformanceIndicators{
+		{Indicators: []int{22}, IndicatorValues: []float64{1.0}, ObjectId: "1", Timestamp: 1700000060},
+		{Indicators: []int{22}, IndicatorValues: []float64{2.0}, ObjectId: "1", Timestamp: 1700000120},
+	}
+
+	// mock
+	var gotArgs []int64
+	applyFunc := gomonkey.ApplyMethodFunc(client, "GetHistoryPerformance", func(ctx context.Context,
+		objectType int, indicators []int, startTime, endTime, interval int64) ([]map[string]interface{}, error) {
+		gotArgs = []int64{startTime, endTime, interval}
+		return []map[string]interface{}{
+			{"indicators": []int{22}, "indicator_values": []float64{1.0}, "object_id": "1",
+				"timestamp": 1700000060},
+			{"indicators": []int{22}, "indicator_values": []float64{2.0}, "object_id": "1",
+				"timestamp": 1700000120},
+		}, nil
+	})
+	defer applyFunc.Reset()
+
+	// action
+	got, err := GetPerformanceData(context.Background(), client, backend.StorageProfile{}, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("TestGetPerformanceData_with_history_success() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(want, got) || !reflect.DeepEqual([]int64{1700000000, 1700000300, 60}, gotArgs) {
+		t.Errorf("TestGetPerformanceData_with_history_success() want = %v, got = %v, args = %v",
+			want, got, gotArgs)
+	}
+}
+
 func TestGetPerformanceData_with_collect_type_not_exist_error(t *testing.T) {
 	// arrange
 	request := &cmi.CollectRequest{CollectType: "not-exist"}
 	client := &centralizedstorage.CentralizedClient{}
 
 	// action
-	_, err := GetPerformanceData(context.Background(), client, request)
+	_, err := GetPerformanceData(context.Background(), client, backend.StorageProfile{}, request)
 
 	// assert
 	if err == nil {
@@ -413,6 +597,83 @@ func TestMergePerformance(t *testing.T) {
 	}
 }
 
+func TestMergePerformance_with_timestamp(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{CollectType: constants.Lun}
+	nameMapping := map[string]string{"1": "lun-1"}
+	performances := []PerformanceIndicators{
+		{Indicators: []int{22}, IndicatorValues: []float64{1.0}, ObjectId: "1", Timestamp: 1700000060},
+		{Indicators: []int{22}, IndicatorValues: []float64{2.0}, ObjectId: "1", Timestamp: 1700000120},
+	}
+
+	// action
+	response := MergePerformance(performances, nameMapping, request)
+
+	// assert
+	var got []int64
+	for _, detail := range response.GetDetails() {
+		got = append(got, detail.GetTimestamp())
+	}
+	if want := []int64{1700000060, 1700000120}; !reflect.DeepEqual(want, got) {
+		t.Errorf("TestMergePerformance_with_timestamp() want timestamps = %v, got = %v", want, got)
+	}
+}
+
+func TestSendPerformanceInPages(t *testing.T) {
+	// arrange
+	request := &cmi.CollectRequest{CollectType: constants.Lun}
+	nameMapping := map[string]string{"1": "lun-1"}
+	var performances []PerformanceIndicators
+	for i := 0; i < 250; i++ {
+		performances = append(performances,
+			PerformanceIndicators{Indicators: []int{22}, IndicatorValues: []float64{1.0}, ObjectId: "1"})
+	}
+	// the objects without name are discarded
+	performances = append(performances,
+		PerformanceIndicators{Indicators: []int{22}, IndicatorValues: []float64{1.0}, ObjectId: "2"})
+	var pageSizes []int
+
+	// action
+	err := SendPerformanceInPages(performances, nameMapping, request, func(page *cmi.CollectResponse) error {
+		pageSizes = append(pageSizes, len(page.GetDetails()))
+		return nil
+	})
+
+	// assert
+	want := []int{100, 100, 50}
+	if err != nil || !reflect.DeepEqual(pageSizes, want) {
+		t.Errorf("TestSendPerformanceInPages() failed, want = %v, got = %v, error = %v", want, pageSizes, err)
+	}
+}
+
+func TestGetMapping_with_port(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	want := map[string]string{"1": "CTE0.A.IOM0.P0"}
+
+	// mock
+	portCount, portPage := mockPageQuery([]map[string]interface{}{{"ID": "1", "NAME": "CTE0.A.IOM0.P0"}})
+	patches := gomonkey.ApplyMethod(client, "GetFCPortCount", portCount).
+		ApplyMethod(client, "GetFCPorts", portPage).
+		ApplyMethod(client, "GetETHPortCount", portCount).
+		ApplyMethod(client, "GetETHPorts", portPage)
+	defer patches.Reset()
+
+	for _, collectType := range []string{constants.FCPort, constants.ETHPort} {
+		// action
+		got, err := GetMapping(context.Background(), constants.OceanStorage, collectType, client)
+
+		// assert
+		if err != nil {
+			t.Errorf("TestGetMapping_with_port() collect type [%s] error = %v", collectType, err)
+			continue
+		}
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("TestGetMapping_with_port() collect type [%s] want = %v, but got = %v", collectType, want, got)
+		}
+	}
+}
+
 func TestPerformanceIndicators_ToMap(t *testing.T) {
 	// arrange
 	performances := PerformanceIndicators{
@@ -434,3 +695,69 @@ func TestPerformanceIndicators_ToMap(t *testing.T) {
 		t.Errorf("TestGetPerformanceData() want = %v, but got = %v", want, got)
 	}
 }
+
+func TestGetAvailableIndicators(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	want := []string{"22", "25"}
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+		objectType int) ([]map[string]interface{}, error) {
+		return []map[string]interface{}{
+			{"object_type": float64(11), "indicators": []interface{}{float64(22), float64(25)}},
+			{"object_type": float64(40), "indicators": []interface{}{float64(182)}},
+		}, nil
+	})
+	defer patches.Reset()
+
+	// action
+	got := GetAvailableIndicators(context.Background(), client, 11)
+
+	// assert
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TestGetAvailableIndicators() got = %v, want %v", got, want)
+	}
+}
+
+func TestGetAvailableIndicators_with_query_error(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+
+	// mock
+	patches := gomonkey.ApplyMethodFunc(client, "GetPerformanceIndicators", func(ctx context.Context,
+		objectType int) ([]map[string]interface{}, error) {
+		return nil, errors.New("not support")
+	})
+	defer patches.Reset()
+
+	// action
+	got := GetAvailableIndicators(context.Background(), client, 11)
+
+	// assert
+	if len(got) != 0 {
+		t.Errorf("TestGetAvailableIndicators_with_query_error() got = %v, want empty", got)
+	}
+}
+
+func TestLookupNamesById(t *testing.T) {
+	// arrange
+	objects := map[string]map[string]interface{}{
+		"1": {"ID": "1", "NAME": "name-1"},
+	}
+	queryFunc := func(ctx context.Context, id string) (map[string]interface{}, error) {
+		if object, ok := objects[id]; ok {
+			return object, nil
+		}
+		return map[string]interface{}{}, nil
+	}
+
+	// action
+	got, err := LookupNamesById(context.Background(), []string{"1", "2"}, queryFunc)
+
+	// assert
+	want := map[string]string{"1": "name-1"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TestLookupNamesById() want = %v, but got = %v, error = %v", want, got, err)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	qosLunListKey        = "LUNLIST"
+	qosFilesystemListKey = "FSLIST"
+	qosLunIdsKey         = "lunIds"
+	qosFilesystemIdsKey  = "filesystemIds"
+)
+
+// CollectQoS collect object data of smart qos policy in storage,
+// the lun and filesystem lists in json array format are converted to ids separated by comma
+func CollectQoS(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	policies, err := ConcurrentPaginate(ctx, client.GetQoSPolicyCount, client.GetQoSPolicies)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect qos policy failed, error: %v", err)
+		return nil, err
+	}
+
+	policies = filterObjects(policies, NewObjectFilter(request))
+	for _, policy := range policies {
+		policy[qosLunIdsKey] = parseQoSAssociatedIds(ctx, policy, qosLunListKey)
+		policy[qosFilesystemIdsKey] = parseQoSAssociatedIds(ctx, policy, qosFilesystemListKey)
+	}
+	return ConvertToResponse[[]map[string]interface{}, QoSObject](policies, request)
+}
+
+// parseQoSAssociatedIds parse the associated object list of the policy, e.g. ["1","2"] is converted to 1,2
+func parseQoSAssociatedIds(ctx context.Context, policy map[string]interface{}, listKey string) string {
+	list := getStringValue(policy, listKey)
+	if list == "" {
+		return ""
+	}
+
+	var ids []string
+	if err := json.Unmarshal([]byte(list), &ids); err != nil {
+		log.AddContext(ctx).Warningf("parse %s [%s] of qos policy [%s] failed, error: %v",
+			listKey, list, getStringValue(policy, constants.Name), err)
+		return ""
+	}
+	return strings.Join(ids, groupSeparator)
+}
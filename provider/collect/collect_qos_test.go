@@ -0,0 +1,63 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestCollectQoS(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "qos", MetricsType: "object"}
+	policies := []map[string]interface{}{
+		{"ID": "1", "NAME": "qos-1", "ENABLESTATUS": "true", "MAXIOPS": "1000", "LUNLIST": `["1","2"]`,
+			"FSLIST": "[]"},
+		{"ID": "2", "NAME": "qos-2", "ENABLESTATUS": "false", "MAXBANDWIDTH": "100", "FSLIST": `["3"]`},
+		{"ID": "3", "NAME": "qos-3", "LUNLIST": "invalid"},
+	}
+	want := []map[string]string{
+		{"ID": "1", "NAME": "qos-1", "ENABLESTATUS": "true", "MAXIOPS": "1000", "lunIds": "1,2",
+			"filesystemIds": ""},
+		{"ID": "2", "NAME": "qos-2", "ENABLESTATUS": "false", "MAXBANDWIDTH": "100", "lunIds": "",
+			"filesystemIds": "3"},
+		{"ID": "3", "NAME": "qos-3", "lunIds": "", "filesystemIds": ""},
+	}
+
+	// mock
+	policyCount, policyPage := mockPageQuery(policies)
+	patches := gomonkey.ApplyMethod(client, "GetQoSPolicyCount", policyCount).
+		ApplyMethod(client, "GetQoSPolicies", policyPage)
+	defer patches.Reset()
+
+	// action
+	got, err := CollectQoS(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectQoS() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectQoS()", got, want)
+}
@@ -0,0 +1,132 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	// asyncReplicationModel is the replication model of asynchronous remote replication
+	asyncReplicationModel = "2"
+
+	decimalBase  = 10
+	int64BitSize = 64
+
+	sanDomainType         = "san"
+	nasDomainType         = "nas"
+	domainTypeKey         = "domainType"
+	localObjectNameKey    = "LOCALOBJNAME"
+	localResourceNameKey  = "LOCALRESNAME"
+	replicationModelKey   = "REPLICATIONMODEL"
+	replicationEndTimeKey = "ENDTIME"
+	systemUtcTimeKey      = "CMO_SYS_UTC_TIME"
+	syncLagKey            = "syncLag"
+)
+
+// CollectHyperMetroDomain collect object data of hypermetro domain in storage, both the domains of san and nas
+// are collected, the nas domains will be ignored if they can not be queried, e.g. the storage does not support
+func CollectHyperMetroDomain(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	sanDomains, err := client.GetHyperMetroDomains(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect hypermetro domain failed, error: %v", err)
+		return nil, err
+	}
+	for _, domain := range sanDomains {
+		domain[domainTypeKey] = sanDomainType
+	}
+
+	nasDomains, err := client.GetFSHyperMetroDomains(ctx)
+	if err != nil {
+		log.AddContext(ctx).Warningf("collect hypermetro domain of nas failed, ignore it, error: %v", err)
+	}
+	for _, domain := range nasDomains {
+		domain[domainTypeKey] = nasDomainType
+	}
+
+	domains := filterObjects(append(sanDomains, nasDomains...), NewObjectFilter(request))
+	return ConvertToResponse[[]map[string]interface{}, HyperMetroDomainObject](domains, request)
+}
+
+// CollectHyperMetroPair collect object data of hypermetro pair in storage,
+// the pairs have no name, so the names in request are matched with the name of local lun or filesystem
+func CollectHyperMetroPair(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	pairs, err := ConcurrentPaginate(ctx, client.GetHyperMetroPairCount, client.GetHyperMetroPairs)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect hypermetro pair failed, error: %v", err)
+		return nil, err
+	}
+
+	return ConvertToResponseByName[[]map[string]interface{}, HyperMetroPairObject](pairs, request,
+		localObjectNameKey)
+}
+
+// CollectReplicationPair collect object data of remote replication pair in storage,
+// the pairs have no name, so the names in request are matched with the name of local lun or filesystem
+func CollectReplicationPair(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	pairs, err := ConcurrentPaginate(ctx, client.GetReplicationPairCount, client.GetReplicationPairs)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect replication pair failed, error: %v", err)
+		return nil, err
+	}
+
+	now, err := getStorageUtcTime(ctx, client)
+	if err != nil {
+		log.AddContext(ctx).Warningf("get utc time of storage failed, skip the sync lag of replication pairs, "+
+			"error: %v", err)
+	}
+	for _, pair := range pairs {
+		pair[syncLagKey] = getReplicationSyncLag(pair, now)
+	}
+	return ConvertToResponseByName[[]map[string]interface{}, ReplicationPairObject](pairs, request,
+		localResourceNameKey)
+}
+
+// getStorageUtcTime the ENDTIME of replication pair is recorded by the clock of storage, so the lag is measured
+// against the utc time of storage rather than the host, which may be out of sync with the storage
+func getStorageUtcTime(ctx context.Context, client *centralizedstorage.CentralizedClient) (int64, error) {
+	utcTime, err := client.GetSystemUtcTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(getStringValue(utcTime, systemUtcTimeKey), decimalBase, int64BitSize)
+}
+
+// getReplicationSyncLag the lag of asynchronous replication is the seconds since the latest synchronization ended,
+// which reflects the actual RPO, empty string is returned for synchronous replication, never synchronized pair
+// or unknown storage time
+func getReplicationSyncLag(pair map[string]interface{}, now int64) string {
+	if now <= 0 || getStringValue(pair, replicationModelKey) != asyncReplicationModel {
+		return ""
+	}
+
+	endTime, err := strconv.ParseInt(getStringValue(pair, replicationEndTimeKey), decimalBase, int64BitSize)
+	if err != nil || endTime <= 0 || endTime > now {
+		return ""
+	}
+	return strconv.FormatInt(now-endTime, decimalBase)
+}
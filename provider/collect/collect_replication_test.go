@@ -0,0 +1,180 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestCollectHyperMetroDomain(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "hypermetrodomain", MetricsType: "object"}
+	want := []map[string]string{
+		{"ID": "1", "NAME": "san-domain", "domainType": "san"},
+	}
+
+	// mock
+	patches := gomonkey.ApplyMethod(client, "GetHyperMetroDomains",
+		func(*centralizedstorage.CentralizedClient, context.Context) ([]map[string]interface{}, error) {
+			return []map[string]interface{}{{"ID": "1", "NAME": "san-domain"}}, nil
+		}).
+		ApplyMethod(client, "GetFSHyperMetroDomains",
+			func(*centralizedstorage.CentralizedClient, context.Context) ([]map[string]interface{}, error) {
+				return nil, errors.New("unsupported")
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectHyperMetroDomain(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectHyperMetroDomain() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectHyperMetroDomain()", got, want)
+}
+
+func TestCollectHyperMetroPair(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "hypermetropair", MetricsType: "object",
+		ObjectNames: []string{"pvc-1"}}
+	pairs := []map[string]interface{}{
+		{"ID": "1", "LOCALOBJNAME": "pvc-1", "HCRESOURCETYPE": "1", "SYNCPROGRESS": "50"},
+		{"ID": "2", "LOCALOBJNAME": "pvc-2", "HCRESOURCETYPE": "2", "SYNCPROGRESS": "100"},
+	}
+	want := []map[string]string{{"ID": "1", "LOCALOBJNAME": "pvc-1", "HCRESOURCETYPE": "1", "SYNCPROGRESS": "50"}}
+
+	// mock
+	pairCount, pairPage := mockPageQuery(pairs)
+	patches := gomonkey.ApplyMethod(client, "GetHyperMetroPairCount", pairCount).
+		ApplyMethod(client, "GetHyperMetroPairs", pairPage)
+	defer patches.Reset()
+
+	// action
+	got, err := CollectHyperMetroPair(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectHyperMetroPair() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectHyperMetroPair()", got, want)
+}
+
+func TestCollectReplicationPair(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "replicationpair", MetricsType: "object",
+		ObjectNames: []string{"pvc-1"}}
+	pairs := []map[string]interface{}{
+		{"ID": "1", "LOCALRESNAME": "pvc-1", "REPLICATIONMODEL": "2", "ENDTIME": "4102444200"},
+		{"ID": "2", "LOCALRESNAME": "pvc-2", "REPLICATIONMODEL": "2", "ENDTIME": "4102444200"},
+	}
+	want := []map[string]string{{"ID": "1", "LOCALRESNAME": "pvc-1", "ENDTIME": "4102444200", "syncLag": "600"}}
+
+	// mock
+	pairCount, pairPage := mockPageQuery(pairs)
+	patches := gomonkey.ApplyMethod(client, "GetReplicationPairCount", pairCount).
+		ApplyMethod(client, "GetReplicationPairs", pairPage).
+		ApplyMethod(client, "GetSystemUtcTime",
+			func(*centralizedstorage.CentralizedClient, context.Context) (map[string]interface{}, error) {
+				return map[string]interface{}{"CMO_SYS_UTC_TIME": "4102444800"}, nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectReplicationPair(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectReplicationPair() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectReplicationPair()", got, want)
+}
+
+func TestCollectReplicationPair_GetUtcTimeFailed(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "replicationpair", MetricsType: "object"}
+	pairs := []map[string]interface{}{
+		{"ID": "1", "LOCALRESNAME": "pvc-1", "REPLICATIONMODEL": "2", "ENDTIME": "1700000000"},
+	}
+	want := []map[string]string{{"ID": "1", "ENDTIME": "1700000000", "syncLag": ""}}
+
+	// mock
+	pairCount, pairPage := mockPageQuery(pairs)
+	patches := gomonkey.ApplyMethod(client, "GetReplicationPairCount", pairCount).
+		ApplyMethod(client, "GetReplicationPairs", pairPage).
+		ApplyMethod(client, "GetSystemUtcTime",
+			func(*centralizedstorage.CentralizedClient, context.Context) (map[string]interface{}, error) {
+				return nil, errors.New("unsupported")
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectReplicationPair(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectReplicationPair() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectReplicationPair()", got, want)
+}
+
+func TestGetReplicationSyncLag(t *testing.T) {
+	now := int64(1700000600)
+	tests := []struct {
+		name string
+		pair map[string]interface{}
+		now  int64
+		want string
+	}{
+		{name: "asynchronous replication", pair: map[string]interface{}{"REPLICATIONMODEL": "2",
+			"ENDTIME": "1700000000"}, now: now, want: "600"},
+		{name: "synchronous replication", pair: map[string]interface{}{"REPLICATIONMODEL": "1",
+			"ENDTIME": "1700000000"}, now: now, want: ""},
+		{name: "never synchronized", pair: map[string]interface{}{"REPLICATIONMODEL": "2",
+			"ENDTIME": "0"}, now: now, want: ""},
+		{name: "unknown storage time", pair: map[string]interface{}{"REPLICATIONMODEL": "2",
+			"ENDTIME": "1700000000"}, now: 0, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := getReplicationSyncLag(tt.pair, tt.now)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("getReplicationSyncLag() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
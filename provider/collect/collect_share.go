@@ -0,0 +1,255 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	// filesystemParentType and dtreeParentType are the parent types of quota
+	filesystemParentType = "40"
+	dtreeParentType      = "16445"
+
+	sharePathKey       = "SHAREPATH"
+	parentNameKey      = "PARENTNAME"
+	clientCountKey     = "clientCount"
+	aclCountKey        = "aclCount"
+	parentTypeKey      = "parentType"
+	quotaParentKey     = "parentName"
+	quotaFilesystemKey = "filesystemName"
+	childIdSeparator   = "@"
+)
+
+// CollectNFSShare collect object data of nfs share in storage,
+// the nfs shares have no name, so the names in request are matched with the share path
+func CollectNFSShare(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	shares, err := ConcurrentPaginate(ctx, client.GetNFSShareCount, client.GetNFSShares)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect nfs share failed, error: %v", err)
+		return nil, err
+	}
+
+	shares = filterObjectsByName(shares, NewObjectFilter(request), sharePathKey)
+	err = forEachObject(ctx, shares, func(ctx context.Context, share map[string]interface{}) error {
+		id := getStringValue(share, constants.Id)
+		count, err := client.GetNFSShareClientCount(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get client count of nfs share [%s] failed, error: %w", id, err)
+		}
+		share[clientCountKey] = strconv.Itoa(count)
+		return nil
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect nfs share failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponseByName[[]map[string]interface{}, NFSShareObject](shares, request, sharePathKey)
+}
+
+// CollectCIFSShare collect object data of cifs share in storage
+func CollectCIFSShare(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	shares, err := ConcurrentPaginate(ctx, client.GetCIFSShareCount, client.GetCIFSShares)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect cifs share failed, error: %v", err)
+		return nil, err
+	}
+
+	shares = filterObjects(shares, NewObjectFilter(request))
+	err = forEachObject(ctx, shares, func(ctx context.Context, share map[string]interface{}) error {
+		id := getStringValue(share, constants.Id)
+		count, err := client.GetCIFSShareACLCount(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get acl count of cifs share [%s] failed, error: %w", id, err)
+		}
+		share[aclCountKey] = strconv.Itoa(count)
+		return nil
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect cifs share failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponse[[]map[string]interface{}, CIFSShareObject](shares, request)
+}
+
+// CollectDTree collect object data of dtree in storage,
+// the dtrees can only be queried by the parent filesystem. If the ids are specified in request, only the dtrees of
+// the filesystems in the ids are queried, otherwise the dtrees of all filesystems are queried
+func CollectDTree(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	filter := NewObjectFilter(request)
+	var dtrees []map[string]interface{}
+	var err error
+	if filesystems := getParentFilesystems(filter); len(filesystems) != 0 {
+		dtrees, err = getDTreesOfFilesystems(ctx, client, filesystems)
+	} else {
+		dtrees, err = getAllDTrees(ctx, client)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect dtree failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponse[[]map[string]interface{}, DTreeObject](filterObjects(dtrees, filter), request)
+}
+
+// getParentFilesystems get the parent filesystems of the dtree or filesystem snapshot ids in filter,
+// these ids are composed of the filesystem id and the index in filesystem, e.g. 1@1
+func getParentFilesystems(filter *ObjectFilter) []map[string]interface{} {
+	var filesystems []map[string]interface{}
+	var seen = make(map[string]struct{}, len(filter.ids))
+	for id := range filter.ids {
+		filesystemId, _, found := strings.Cut(id, childIdSeparator)
+		if !found {
+			continue
+		}
+		if _, ok := seen[filesystemId]; ok {
+			continue
+		}
+		seen[filesystemId] = struct{}{}
+		filesystems = append(filesystems, map[string]interface{}{constants.Id: filesystemId})
+	}
+	return filesystems
+}
+
+// CollectQuota collect object data of quota in storage, both the quotas of filesystems and dtrees are collected,
+// the quotas have no name, so the names in request are matched with the name of the parent filesystem or dtree,
+// and only the quotas of the matched parents are queried
+func CollectQuota(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	parents, err := getQuotaParents(ctx, client, NewObjectFilter(request))
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect parents of quota failed, error: %v", err)
+		return nil, err
+	}
+
+	var mutex sync.Mutex
+	var quotas []map[string]interface{}
+	err = forEachObject(ctx, parents, func(ctx context.Context, parent map[string]interface{}) error {
+		parentType, id := getStringValue(parent, parentTypeKey), getStringValue(parent, constants.Id)
+		countFunc := func(ctx context.Context) (int, error) {
+			return client.GetQuotaCount(ctx, parentType, id)
+		}
+		pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+			return client.GetQuotas(ctx, parentType, id, start, end)
+		}
+		parentQuotas, err := ConcurrentPaginate(ctx, countFunc, pageFunc)
+		if err != nil {
+			return fmt.Errorf("get quotas of parent [%s] failed, error: %w", id, err)
+		}
+
+		for _, quota := range parentQuotas {
+			quota[quotaParentKey] = parent[constants.Name]
+			quota[quotaFilesystemKey] = parent[quotaFilesystemKey]
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		quotas = append(quotas, parentQuotas...)
+		return nil
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect quota failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponseByName[[]map[string]interface{}, QuotaObject](quotas, request, quotaParentKey)
+}
+
+// getAllDTrees query the dtrees of all filesystems
+func getAllDTrees(ctx context.Context, client *centralizedstorage.CentralizedClient) ([]map[string]interface{},
+	error) {
+	filesystems, err := ConcurrentPaginate(ctx, client.GetFilesystemCount, client.GetFilesystem)
+	if err != nil {
+		return nil, fmt.Errorf("get filesystems of dtree failed, error: %w", err)
+	}
+	return getDTreesOfFilesystems(ctx, client, filesystems)
+}
+
+// getDTreesOfFilesystems query the dtrees of the filesystems
+func getDTreesOfFilesystems(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	filesystems []map[string]interface{}) ([]map[string]interface{}, error) {
+	var mutex sync.Mutex
+	var dtrees []map[string]interface{}
+	err := forEachObject(ctx, filesystems, func(ctx context.Context, filesystem map[string]interface{}) error {
+		id := getStringValue(filesystem, constants.Id)
+		countFunc := func(ctx context.Context) (int, error) {
+			return client.GetDTreeCount(ctx, id)
+		}
+		pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+			return client.GetDTrees(ctx, id, start, end)
+		}
+		fsDTrees, err := ConcurrentPaginate(ctx, countFunc, pageFunc)
+		if err != nil {
+			return fmt.Errorf("get dtrees of filesystem [%s] failed, error: %w", id, err)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		dtrees = append(dtrees, fsDTrees...)
+		return nil
+	})
+	return dtrees, err
+}
+
+// getQuotaParents query the filesystems and dtrees which may have quotas and match the names of filter,
+// the parent type, id and name of each parent and the name of filesystem which the parent belongs to are returned
+func getQuotaParents(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	filter *ObjectFilter) ([]map[string]interface{}, error) {
+	filesystems, err := ConcurrentPaginate(ctx, client.GetFilesystemCount, client.GetFilesystem)
+	if err != nil {
+		return nil, fmt.Errorf("get filesystems of quota failed, error: %w", err)
+	}
+	dtrees, err := getDTreesOfFilesystems(ctx, client, filesystems)
+	if err != nil {
+		return nil, err
+	}
+
+	var parents []map[string]interface{}
+	for _, filesystem := range filesystems {
+		if !filter.MatchName(getStringValue(filesystem, constants.Name)) {
+			continue
+		}
+		parents = append(parents, map[string]interface{}{
+			parentTypeKey:      filesystemParentType,
+			constants.Id:       getStringValue(filesystem, constants.Id),
+			constants.Name:     getStringValue(filesystem, constants.Name),
+			quotaFilesystemKey: getStringValue(filesystem, constants.Name),
+		})
+	}
+	for _, dtree := range dtrees {
+		if !filter.MatchName(getStringValue(dtree, constants.Name)) {
+			continue
+		}
+		parents = append(parents, map[string]interface{}{
+			parentTypeKey:      dtreeParentType,
+			constants.Id:       getStringValue(dtree, constants.Id),
+			constants.Name:     getStringValue(dtree, constants.Name),
+			quotaFilesystemKey: getStringValue(dtree, parentNameKey),
+		})
+	}
+	return parents, nil
+}
@@ -0,0 +1,184 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestCollectNFSShare(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "nfsshare", MetricsType: "object", ObjectNames: []string{"/fs-1/"}}
+	shares := []map[string]interface{}{
+		{"ID": "1", "SHAREPATH": "/fs-1/", "FSID": "10"},
+		{"ID": "2", "SHAREPATH": "/fs-2/", "FSID": "11"},
+	}
+	want := []map[string]string{{"ID": "1", "SHAREPATH": "/fs-1/", "FSID": "10", "clientCount": "3"}}
+
+	// mock
+	shareCount, sharePage := mockPageQuery(shares)
+	var queriedShares []string
+	patches := gomonkey.ApplyMethod(client, "GetNFSShareCount", shareCount).
+		ApplyMethod(client, "GetNFSShares", sharePage).
+		ApplyMethod(client, "GetNFSShareClientCount",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+				queriedShares = append(queriedShares, id)
+				return 3, nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectNFSShare(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectNFSShare() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectNFSShare()", got, want)
+	if len(queriedShares) != 1 {
+		t.Errorf("CollectNFSShare() queried client count of shares %v, want only the matched share", queriedShares)
+	}
+}
+
+func TestCollectCIFSShare(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "cifsshare", MetricsType: "object"}
+	shares := []map[string]interface{}{{"ID": "1", "NAME": "share-1", "SHAREPATH": "/fs-1/", "FSID": "10"}}
+	want := []map[string]string{{"ID": "1", "NAME": "share-1", "aclCount": "2"}}
+
+	// mock
+	shareCount, sharePage := mockPageQuery(shares)
+	patches := gomonkey.ApplyMethod(client, "GetCIFSShareCount", shareCount).
+		ApplyMethod(client, "GetCIFSShares", sharePage).
+		ApplyMethod(client, "GetCIFSShareACLCount",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+				return 2, nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectCIFSShare(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectCIFSShare() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectCIFSShare()", got, want)
+}
+
+func TestCollectQuota(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "quota", MetricsType: "object", ObjectNames: []string{"dtree-1"}}
+	filesystems := []map[string]interface{}{{"ID": "1", "NAME": "fs-1"}}
+	dtrees := []map[string]interface{}{{"ID": "1@1", "NAME": "dtree-1", "PARENTID": "1", "PARENTNAME": "fs-1"}}
+	quotas := map[string][]map[string]interface{}{
+		"40-1":      {{"ID": "q1", "PARENTID": "1", "SPACEHARDQUOTA": "1073741824"}},
+		"16445-1@1": {{"ID": "q2", "PARENTID": "1@1", "SPACEHARDQUOTA": "2147483648", "SPACEUSED": "1024"}},
+	}
+	want := []map[string]string{{"ID": "q2", "parentName": "dtree-1", "filesystemName": "fs-1",
+		"SPACEHARDQUOTA": "2147483648", "SPACEUSED": "1024"}}
+
+	// mock
+	var queriedParents []string
+	fsCount, fsPage := mockPageQuery(filesystems)
+	patches := gomonkey.ApplyMethod(client, "GetFilesystemCount", fsCount).
+		ApplyMethod(client, "GetFilesystem", fsPage).
+		ApplyMethod(client, "GetDTreeCount",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+				return len(dtrees), nil
+			}).
+		ApplyMethod(client, "GetDTrees",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string,
+				_, _ int) ([]map[string]interface{}, error) {
+				return dtrees, nil
+			}).
+		ApplyMethod(client, "GetQuotaCount",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, parentType, id string) (int, error) {
+				queriedParents = append(queriedParents, parentType+"-"+id)
+				return len(quotas[parentType+"-"+id]), nil
+			}).
+		ApplyMethod(client, "GetQuotas",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, parentType, id string,
+				_, _ int) ([]map[string]interface{}, error) {
+				return quotas[parentType+"-"+id], nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectQuota(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectQuota() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectQuota()", got, want)
+	if !reflect.DeepEqual(queriedParents, []string{"16445-1@1"}) {
+		t.Errorf("CollectQuota() queried quotas of parents %v, want only the matched parent", queriedParents)
+	}
+}
+
+func TestCollectDTree_WithIds(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "dtree", MetricsType: "object", ObjectIds: []string{"2@1"}}
+	dtrees := map[string][]map[string]interface{}{
+		"2": {{"ID": "2@1", "NAME": "dtree-1", "PARENTID": "2"}, {"ID": "2@2", "NAME": "dtree-2", "PARENTID": "2"}},
+	}
+	want := []map[string]string{{"ID": "2@1", "NAME": "dtree-1", "PARENTID": "2"}}
+
+	// mock
+	var queriedFilesystems []string
+	patches := gomonkey.ApplyMethod(client, "GetDTreeCount",
+		func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+			queriedFilesystems = append(queriedFilesystems, id)
+			return len(dtrees[id]), nil
+		}).
+		ApplyMethod(client, "GetDTrees",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string,
+				_, _ int) ([]map[string]interface{}, error) {
+				return dtrees[id], nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectDTree(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectDTree() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectDTree()", got, want)
+	if !reflect.DeepEqual(queriedFilesystems, []string{"2"}) {
+		t.Errorf("CollectDTree() queried dtrees of filesystems %v, want only the filesystem of ids",
+			queriedFilesystems)
+	}
+}
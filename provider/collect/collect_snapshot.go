@@ -0,0 +1,89 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// CollectLunSnapshot collect object data of lun snapshot in storage
+func CollectLunSnapshot(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	filter := NewObjectFilter(request)
+	if filter.CanQueryByObject() {
+		return DoCollectByObject[LunSnapshotObject](ctx, request, filter, client.GetSnapshotById,
+			client.GetSnapshotByName)
+	}
+
+	snapshots, err := ConcurrentPaginate(ctx, client.GetSnapshotCount, client.GetSnapshots)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect lun snapshot failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponse[[]map[string]interface{}, LunSnapshotObject](filterObjects(snapshots, filter), request)
+}
+
+// CollectFSSnapshot collect object data of filesystem snapshot in storage,
+// the filesystem snapshots can only be queried by the parent filesystem. If the ids are specified in request,
+// only the snapshots of the filesystems in the ids are queried, otherwise the snapshots of all filesystems are queried
+func CollectFSSnapshot(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	filter := NewObjectFilter(request)
+	filesystems := getParentFilesystems(filter)
+	if len(filesystems) == 0 {
+		var err error
+		filesystems, err = ConcurrentPaginate(ctx, client.GetFilesystemCount, client.GetFilesystem)
+		if err != nil {
+			log.AddContext(ctx).Errorf("collect filesystems of snapshot failed, error: %v", err)
+			return nil, err
+		}
+	}
+
+	var mutex sync.Mutex
+	var snapshots []map[string]interface{}
+	err := forEachObject(ctx, filesystems, func(ctx context.Context, filesystem map[string]interface{}) error {
+		id := getStringValue(filesystem, constants.Id)
+		countFunc := func(ctx context.Context) (int, error) {
+			return client.GetFSSnapshotCount(ctx, id)
+		}
+		pageFunc := func(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+			return client.GetFSSnapshots(ctx, id, start, end)
+		}
+		fsSnapshots, err := ConcurrentPaginate(ctx, countFunc, pageFunc)
+		if err != nil {
+			return fmt.Errorf("get snapshots of filesystem [%s] failed, error: %w", id, err)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		snapshots = append(snapshots, filterObjects(fsSnapshots, filter)...)
+		return nil
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect filesystem snapshot failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponse[[]map[string]interface{}, FSSnapshotObject](snapshots, request)
+}
@@ -0,0 +1,138 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestCollectLunSnapshot(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "lunsnapshot", MetricsType: "object", NamePrefix: "snapshot-"}
+	snapshots := []map[string]interface{}{
+		{"ID": "1", "NAME": "snapshot-1", "PARENTID": "10", "CONSUMEDCAPACITY": "2048", "RUNNINGSTATUS": "43"},
+		{"ID": "2", "NAME": "manual-snap", "PARENTID": "11", "CONSUMEDCAPACITY": "0", "RUNNINGSTATUS": "43"},
+	}
+	want := []map[string]string{
+		{"ID": "1", "NAME": "snapshot-1", "PARENTID": "10", "CONSUMEDCAPACITY": "2048", "RUNNINGSTATUS": "43"},
+	}
+
+	// mock
+	snapshotCount, snapshotPage := mockPageQuery(snapshots)
+	patches := gomonkey.ApplyMethod(client, "GetSnapshotCount", snapshotCount).
+		ApplyMethod(client, "GetSnapshots", snapshotPage)
+	defer patches.Reset()
+
+	// action
+	got, err := CollectLunSnapshot(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectLunSnapshot() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectLunSnapshot()", got, want)
+}
+
+func TestCollectFSSnapshot(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "fssnapshot", MetricsType: "object", ObjectNames: []string{"snapshot-2"}}
+	filesystems := []map[string]interface{}{{"ID": "1", "NAME": "fs-1"}, {"ID": "2", "NAME": "fs-2"}}
+	fsSnapshots := map[string][]map[string]interface{}{
+		"1": {{"ID": "1@1", "NAME": "snapshot-1", "PARENTID": "1"}},
+		"2": {{"ID": "2@1", "NAME": "snapshot-2", "PARENTID": "2", "CONSUMEDCAPACITY": "4096"}},
+	}
+	want := []map[string]string{{"ID": "2@1", "NAME": "snapshot-2", "PARENTID": "2", "CONSUMEDCAPACITY": "4096"}}
+
+	// mock
+	fsCount, fsPage := mockPageQuery(filesystems)
+	patches := gomonkey.ApplyMethod(client, "GetFilesystemCount", fsCount).
+		ApplyMethod(client, "GetFilesystem", fsPage).
+		ApplyMethod(client, "GetFSSnapshotCount",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+				return len(fsSnapshots[id]), nil
+			}).
+		ApplyMethod(client, "GetFSSnapshots",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string,
+				_, _ int) ([]map[string]interface{}, error) {
+				return fsSnapshots[id], nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectFSSnapshot(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectFSSnapshot() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectFSSnapshot()", got, want)
+}
+
+func TestCollectFSSnapshot_WithIds(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "fssnapshot", MetricsType: "object", ObjectIds: []string{"2@1"}}
+	fsSnapshots := map[string][]map[string]interface{}{
+		"2": {{"ID": "2@1", "NAME": "snapshot-1", "PARENTID": "2"}, {"ID": "2@2", "NAME": "snapshot-2", "PARENTID": "2"}},
+	}
+	want := []map[string]string{{"ID": "2@1", "NAME": "snapshot-1", "PARENTID": "2"}}
+
+	// mock
+	var queriedFilesystems []string
+	patches := gomonkey.ApplyMethod(client, "GetFilesystemCount",
+		func(_ *centralizedstorage.CentralizedClient, _ context.Context) (int, error) {
+			t.Errorf("CollectFSSnapshot() should not query all filesystems when the ids are specified")
+			return 0, nil
+		}).
+		ApplyMethod(client, "GetFSSnapshotCount",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+				queriedFilesystems = append(queriedFilesystems, id)
+				return len(fsSnapshots[id]), nil
+			}).
+		ApplyMethod(client, "GetFSSnapshots",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string,
+				_, _ int) ([]map[string]interface{}, error) {
+				return fsSnapshots[id], nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectFSSnapshot(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectFSSnapshot() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectFSSnapshot()", got, want)
+	if !reflect.DeepEqual(queriedFilesystems, []string{"2"}) {
+		t.Errorf("CollectFSSnapshot() queried snapshots of filesystems %v, want only the filesystem of ids",
+			queriedFilesystems)
+	}
+}
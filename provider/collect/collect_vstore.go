@@ -0,0 +1,65 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/constants"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	vstoreLunCountKey        = "lunCount"
+	vstoreFilesystemCountKey = "filesystemCount"
+)
+
+// CollectVStore collect object data of vstore in storage, the luns and filesystems of each vstore are counted
+func CollectVStore(ctx context.Context, client *centralizedstorage.CentralizedClient,
+	request *cmi.CollectRequest) (*cmi.CollectResponse, error) {
+	vstores, err := ConcurrentPaginate(ctx, client.GetVStoreCount, client.GetVStores)
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect vstore failed, error: %v", err)
+		return nil, err
+	}
+
+	vstores = filterObjects(vstores, NewObjectFilter(request))
+	err = forEachObject(ctx, vstores, func(ctx context.Context, vstore map[string]interface{}) error {
+		id := getStringValue(vstore, constants.Id)
+		lunCount, err := client.GetLunCountByVStore(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get lun count of vstore [%s] failed, error: %w", id, err)
+		}
+		filesystemCount, err := client.GetFilesystemCountByVStore(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get filesystem count of vstore [%s] failed, error: %w", id, err)
+		}
+		vstore[vstoreLunCountKey] = strconv.Itoa(lunCount)
+		vstore[vstoreFilesystemCountKey] = strconv.Itoa(filesystemCount)
+		return nil
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("collect vstore failed, error: %v", err)
+		return nil, err
+	}
+	return ConvertToResponse[[]map[string]interface{}, VStoreObject](vstores, request)
+}
@@ -0,0 +1,66 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+)
+
+func TestCollectVStore(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.CollectRequest{CollectType: "vstore", MetricsType: "object"}
+	vstores := []map[string]interface{}{
+		{"ID": "1", "NAME": "vstore-1", "RUNNINGSTATUS": "53", "sanCapacityQuota": "2097152",
+			"sanFreeCapacityQuota": "1048576"},
+	}
+	want := []map[string]string{
+		{"ID": "1", "NAME": "vstore-1", "RUNNINGSTATUS": "53", "sanCapacityQuota": "2097152",
+			"sanFreeCapacityQuota": "1048576", "lunCount": "3", "filesystemCount": "2"},
+	}
+
+	// mock
+	vstoreCount, vstorePage := mockPageQuery(vstores)
+	patches := gomonkey.ApplyMethod(client, "GetVStoreCount", vstoreCount).
+		ApplyMethod(client, "GetVStores", vstorePage).
+		ApplyMethod(client, "GetLunCountByVStore",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+				return 3, nil
+			}).
+		ApplyMethod(client, "GetFilesystemCountByVStore",
+			func(_ *centralizedstorage.CentralizedClient, _ context.Context, id string) (int, error) {
+				return 2, nil
+			})
+	defer patches.Reset()
+
+	// action
+	got, err := CollectVStore(context.Background(), client, request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CollectVStore() error = %v", err)
+		return
+	}
+	assertDetailsContain(t, "CollectVStore()", got, want)
+}
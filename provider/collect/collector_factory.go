@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -18,10 +18,8 @@
 package collect
 
 import (
-	"errors"
-	"fmt"
-
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/constants"
 )
 
@@ -36,6 +34,5 @@ func GetCollector(metricsType string) (cmi.CollectorServer, error) {
 	if ok {
 		return collector, nil
 	}
-	errMsg := fmt.Sprintf("not found collector, metricsType type is [%s] ", metricsType)
-	return nil, errors.New(errMsg)
+	return nil, cmierror.New(cmierror.InvalidArgument, "not found collector, metrics type is [%s]", metricsType)
 }
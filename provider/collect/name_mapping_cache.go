@@ -0,0 +1,229 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cmiConfig "github.com/huawei/csm/v2/config/cmi"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// maxLookupIds the max number of ids missing from the cached name mapping which are looked up one by one,
+// the whole name mapping is queried again if more ids are missing
+const maxLookupIds = 20
+
+// nameMappingCache caches the object name mappings used by the performance collect
+// key is backend name and collect type,
+// e.g.
+//
+//	|-----------------|---------------|------------------------------|
+//	| backendName     | collectType   | name mapping                 |
+//	|-----------------|---------------|------------------------------|
+//	| test-backend    | lun           | {"1": "pvc-1", "2": "pvc-2"} |
+//	|-----------------|---------------|------------------------------|
+var nameMappingCache = &NameMappingCache{entries: map[string]map[string]*nameMappingEntry{}}
+
+// NameMappingCache the object name mappings per backend and collect type
+type NameMappingCache struct {
+	lock    sync.RWMutex
+	entries map[string]map[string]*nameMappingEntry
+}
+
+// nameMappingEntry the cached name mapping of one collect type, the maps are never modified after cached,
+// the absent ids are the ids which have been looked up but do not exist in the storage
+type nameMappingEntry struct {
+	names    map[string]string
+	absent   map[string]struct{}
+	expireAt time.Time
+}
+
+// GetCachedMapping get the object name mapping from the cache
+// The whole name mapping is queried when it is not cached or expired. If some ids are missing from the cached
+// name mapping, only these ids are looked up when the collect type has a name lookup handler.
+// A partial name mapping is still returned but never cached, so it is queried again by the next collect.
+func GetCachedMapping(ctx context.Context, backendName, storageType, collectType string, client interface{},
+	ids []string) (map[string]string, error) {
+	return getCachedMapping(ctx, backendName, storageType, collectType, client, ids, false)
+}
+
+// GetFilteredMapping get the name mapping of the objects filtered by ids in the request
+// Unlike GetCachedMapping, the ids are looked up even if the name mapping is not cached or expired, so the
+// whole name mapping is not queried for a few objects. The looked up names are cached for the ttl.
+func GetFilteredMapping(ctx context.Context, backendName, storageType, collectType string, client interface{},
+	ids []string) (map[string]string, error) {
+	return getCachedMapping(ctx, backendName, storageType, collectType, client, ids, true)
+}
+
+func getCachedMapping(ctx context.Context, backendName, storageType, collectType string, client interface{},
+	ids []string, filtered bool) (map[string]string, error) {
+	lookup, lookupErr := GetNameLookupHandler(storageType, collectType)
+	canLookup := filtered && lookupErr == nil && len(ids) <= maxLookupIds
+	ttl := cmiConfig.GetNameMappingTTL()
+	if ttl <= 0 || backendName == "" {
+		if canLookup {
+			names, err := lookup(ctx, client, ids)
+			if err == nil {
+				return names, nil
+			}
+			log.AddContext(ctx).Warningf("look up names of %d %s failed, query the whole name mapping, error: %v",
+				len(ids), collectType, err)
+		}
+		return getWholeMapping(ctx, storageType, collectType, client)
+	}
+
+	entry := nameMappingCache.get(backendName, collectType)
+	if entry == nil || time.Now().After(entry.expireAt) {
+		if !canLookup {
+			return refreshNameMapping(ctx, backendName, storageType, collectType, client, ttl)
+		}
+		entry = &nameMappingEntry{names: map[string]string{}, absent: map[string]struct{}{},
+			expireAt: time.Now().Add(ttl)}
+		nameMappingCache.set(backendName, collectType, entry)
+	}
+
+	missing := entry.missingIds(ids)
+	if len(missing) == 0 {
+		return entry.names, nil
+	}
+
+	if lookupErr != nil || len(missing) > maxLookupIds {
+		return refreshNameMapping(ctx, backendName, storageType, collectType, client, ttl)
+	}
+
+	names, err := lookup(ctx, client, missing)
+	if err != nil {
+		log.AddContext(ctx).Warningf("look up names of %d %s failed, query the whole name mapping, error: %v",
+			len(missing), collectType, err)
+		return refreshNameMapping(ctx, backendName, storageType, collectType, client, ttl)
+	}
+
+	return nameMappingCache.merge(backendName, collectType, entry, missing, names).names, nil
+}
+
+// InvalidateNameMapping remove the cached name mappings of the backend
+func InvalidateNameMapping(backendName string) {
+	nameMappingCache.invalidate(backendName)
+}
+
+// getWholeMapping query the whole name mapping, the partial name mapping is accepted with a warning
+func getWholeMapping(ctx context.Context, storageType, collectType string,
+	client interface{}) (map[string]string, error) {
+	names, err := GetMapping(ctx, storageType, collectType, client)
+	if IsPartialResult(err) {
+		log.AddContext(ctx).Warningf("use partial name mapping of %s, error: %v", collectType, err)
+		return names, nil
+	}
+	return names, err
+}
+
+// refreshNameMapping query the whole name mapping and cache it,
+// the partial name mapping is not cached, so that the whole name mapping will be queried again next time
+func refreshNameMapping(ctx context.Context, backendName, storageType, collectType string, client interface{},
+	ttl time.Duration) (map[string]string, error) {
+	names, err := GetMapping(ctx, storageType, collectType, client)
+	if IsPartialResult(err) {
+		log.AddContext(ctx).Warningf("use partial name mapping of %s without caching it, error: %v",
+			collectType, err)
+		return names, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nameMappingCache.set(backendName, collectType, &nameMappingEntry{
+		names:    names,
+		absent:   map[string]struct{}{},
+		expireAt: time.Now().Add(ttl),
+	})
+	return names, nil
+}
+
+// missingIds get the ids which are neither in the name mapping nor known to be absent
+func (e *nameMappingEntry) missingIds(ids []string) []string {
+	var missing []string
+	for _, id := range ids {
+		if _, ok := e.names[id]; ok {
+			continue
+		}
+		if _, ok := e.absent[id]; ok {
+			continue
+		}
+		missing = append(missing, id)
+	}
+	return missing
+}
+
+func (c *NameMappingCache) get(backendName, collectType string) *nameMappingEntry {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.entries[backendName][collectType]
+}
+
+func (c *NameMappingCache) set(backendName, collectType string, entry *nameMappingEntry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entries, ok := c.entries[backendName]
+	if !ok {
+		entries = map[string]*nameMappingEntry{}
+		c.entries[backendName] = entries
+	}
+	entries[collectType] = entry
+}
+
+// merge add the looked up names to a copy of the entry, the ids which are not found are recorded as absent.
+// The copy replaces the cached entry only if the entry is not refreshed or invalidated in the meantime
+func (c *NameMappingCache) merge(backendName, collectType string, entry *nameMappingEntry, ids []string,
+	names map[string]string) *nameMappingEntry {
+	merged := &nameMappingEntry{
+		names:    make(map[string]string, len(entry.names)+len(names)),
+		absent:   make(map[string]struct{}, len(entry.absent)),
+		expireAt: entry.expireAt,
+	}
+	for id, name := range entry.names {
+		merged.names[id] = name
+	}
+	for id := range entry.absent {
+		merged.absent[id] = struct{}{}
+	}
+	for _, id := range ids {
+		if name, ok := names[id]; ok {
+			merged.names[id] = name
+		} else {
+			merged.absent[id] = struct{}{}
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.entries[backendName][collectType] == entry {
+		c.entries[backendName][collectType] = merged
+	}
+	return merged
+}
+
+func (c *NameMappingCache) invalidate(backendName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, backendName)
+}
@@ -0,0 +1,232 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const (
+	mappingTestStorage = "test-name-mapping-storage"
+	mappingTestType    = "test-name-mapping-type"
+)
+
+type mappingTestClient struct {
+	names       map[string]string
+	partial     bool
+	queryTimes  int
+	lookupTimes int
+	lookupIds   []string
+}
+
+func init() {
+	RegisterPerformanceHandler(mappingTestStorage, mappingTestType,
+		func(ctx context.Context, client *mappingTestClient) (map[string]string, error) {
+			client.queryTimes++
+			names := map[string]string{}
+			for id, name := range client.names {
+				names[id] = name
+			}
+			if client.partial {
+				return names, &PartialResultError{FailedPages: 1, TotalPages: 2}
+			}
+			return names, nil
+		})
+	RegisterNameLookupHandler(mappingTestStorage, mappingTestType,
+		func(ctx context.Context, client *mappingTestClient, ids []string) (map[string]string, error) {
+			client.lookupTimes++
+			client.lookupIds = append(client.lookupIds, ids...)
+			names := map[string]string{}
+			for _, id := range ids {
+				if name, ok := client.names[id]; ok {
+					names[id] = name
+				}
+			}
+			return names, nil
+		})
+}
+
+func TestGetCachedMapping_with_cache_hit(t *testing.T) {
+	// arrange
+	backendName := "test-backend-cache-hit"
+	client := &mappingTestClient{names: map[string]string{"1": "name-1", "2": "name-2"}}
+	defer InvalidateNameMapping(backendName)
+
+	// action
+	_, err := GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1", "2"})
+	if err != nil {
+		t.Errorf("TestGetCachedMapping_with_cache_hit() error = %v", err)
+		return
+	}
+	got, err := GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1", "2"})
+
+	// assert
+	want := map[string]string{"1": "name-1", "2": "name-2"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TestGetCachedMapping_with_cache_hit() want = %v, but got = %v, error = %v", want, got, err)
+	}
+	if client.queryTimes != 1 {
+		t.Errorf("TestGetCachedMapping_with_cache_hit() want query times = 1, but got = %d", client.queryTimes)
+	}
+}
+
+func TestGetCachedMapping_with_missing_ids(t *testing.T) {
+	// arrange
+	backendName := "test-backend-missing-ids"
+	client := &mappingTestClient{names: map[string]string{"1": "name-1"}}
+	defer InvalidateNameMapping(backendName)
+	_, err := GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1"})
+	if err != nil {
+		t.Errorf("TestGetCachedMapping_with_missing_ids() error = %v", err)
+		return
+	}
+	client.names["2"] = "name-2"
+
+	// action
+	got, err := GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1", "2", "3"})
+	if err != nil {
+		t.Errorf("TestGetCachedMapping_with_missing_ids() error = %v", err)
+		return
+	}
+	_, err = GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"3"})
+
+	// assert
+	want := map[string]string{"1": "name-1", "2": "name-2"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TestGetCachedMapping_with_missing_ids() want = %v, but got = %v, error = %v", want, got, err)
+	}
+	if client.queryTimes != 1 || client.lookupTimes != 1 {
+		t.Errorf("TestGetCachedMapping_with_missing_ids() want query times = 1 and lookup times = 1, "+
+			"but got = %d and %d", client.queryTimes, client.lookupTimes)
+	}
+	if !reflect.DeepEqual(client.lookupIds, []string{"2", "3"}) {
+		t.Errorf("TestGetCachedMapping_with_missing_ids() want lookup ids = [2 3], but got = %v",
+			client.lookupIds)
+	}
+}
+
+func TestGetCachedMapping_with_expired(t *testing.T) {
+	// arrange
+	backendName := "test-backend-expired"
+	client := &mappingTestClient{names: map[string]string{"1": "name-1"}}
+	defer InvalidateNameMapping(backendName)
+	nameMappingCache.set(backendName, mappingTestType, &nameMappingEntry{
+		names:    map[string]string{"1": "old-name-1"},
+		absent:   map[string]struct{}{},
+		expireAt: time.Now().Add(-time.Second),
+	})
+
+	// action
+	got, err := GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1"})
+
+	// assert
+	want := map[string]string{"1": "name-1"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TestGetCachedMapping_with_expired() want = %v, but got = %v, error = %v", want, got, err)
+	}
+	if client.queryTimes != 1 {
+		t.Errorf("TestGetCachedMapping_with_expired() want query times = 1, but got = %d", client.queryTimes)
+	}
+}
+
+func TestInvalidateNameMapping(t *testing.T) {
+	// arrange
+	backendName := "test-backend-invalidate"
+	client := &mappingTestClient{names: map[string]string{"1": "name-1"}}
+	defer InvalidateNameMapping(backendName)
+	_, err := GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1"})
+	if err != nil {
+		t.Errorf("TestInvalidateNameMapping() error = %v", err)
+		return
+	}
+
+	// action
+	InvalidateNameMapping(backendName)
+	_, err = GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1"})
+
+	// assert
+	if err != nil || client.queryTimes != 2 {
+		t.Errorf("TestInvalidateNameMapping() want query times = 2, but got = %d, error = %v",
+			client.queryTimes, err)
+	}
+}
+
+func TestGetCachedMapping_with_partial_mapping(t *testing.T) {
+	// arrange
+	backendName := "test-backend-partial"
+	client := &mappingTestClient{names: map[string]string{"1": "name-1"}, partial: true}
+	defer InvalidateNameMapping(backendName)
+
+	// action
+	got, err := GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1"})
+	if err != nil {
+		t.Errorf("TestGetCachedMapping_with_partial_mapping() error = %v", err)
+		return
+	}
+	_, err = GetCachedMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1"})
+
+	// assert
+	want := map[string]string{"1": "name-1"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TestGetCachedMapping_with_partial_mapping() want = %v, but got = %v, error = %v", want, got, err)
+	}
+	if client.queryTimes != 2 {
+		t.Errorf("TestGetCachedMapping_with_partial_mapping() want query times = 2, but got = %d",
+			client.queryTimes)
+	}
+}
+
+func TestGetFilteredMapping_without_cache(t *testing.T) {
+	// arrange
+	backendName := "test-backend-filtered"
+	client := &mappingTestClient{names: map[string]string{"1": "name-1", "2": "name-2", "3": "name-3"}}
+	defer InvalidateNameMapping(backendName)
+
+	// action
+	got, err := GetFilteredMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1", "4"})
+	if err != nil {
+		t.Errorf("TestGetFilteredMapping_without_cache() error = %v", err)
+		return
+	}
+	_, err = GetFilteredMapping(context.Background(), backendName, mappingTestStorage, mappingTestType, client,
+		[]string{"1", "4"})
+
+	// assert
+	want := map[string]string{"1": "name-1"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TestGetFilteredMapping_without_cache() want = %v, but got = %v, error = %v", want, got, err)
+	}
+	if client.queryTimes != 0 || client.lookupTimes != 1 {
+		t.Errorf("TestGetFilteredMapping_without_cache() want query times = 0 and lookup times = 1, "+
+			"but got = %d and %d", client.queryTimes, client.lookupTimes)
+	}
+}
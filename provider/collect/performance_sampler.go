@@ -0,0 +1,250 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	// sampleRetryTimes the max times of the background retries after the storage returns empty data
+	sampleRetryTimes = 5
+	// sampleRetryInterval the interval of the background retries
+	sampleRetryInterval = 5 * time.Second
+	// sampleMaxAge the max age of the sample which is returned when the storage returns empty data
+	sampleMaxAge = time.Minute
+	// sampleWaitTime the max time to wait for the background retry when there is no sample to return
+	sampleWaitTime = 2 * sampleRetryInterval
+)
+
+// performanceSampler samples the performance data of the storage earlier than 6.1.2 in background
+// Such storage may return empty data when the performance data is queried concurrently. Instead of blocking
+// the collect request by retries, the latest sample is returned with its sample time and the query is retried
+// in background, so that the sample is ready for the next collect request. If there is no sample yet,
+// e.g. the first collect request after startup, the request waits for the background retry for a while.
+// e.g.
+//
+//	|-----------------|-------------------|------------------------------|
+//	| backendName     | sample key        | sample                       |
+//	|-----------------|-------------------|------------------------------|
+//	| test-backend    | 11:[22 25]        | {data: [...], sampledAt: t1} |
+//	|-----------------|-------------------|------------------------------|
+var performanceSampler = NewPerformanceSampler(sampleRetryInterval, sampleWaitTime)
+
+// PerformanceSampler the latest performance samples per backend, object type and indicators
+type PerformanceSampler struct {
+	lock          sync.Mutex
+	retryInterval time.Duration
+	waitTime      time.Duration
+	backends      map[string]*backendSamples
+}
+
+// backendSamples the samples of one backend, the context is cancelled when the backend is released,
+// so that the background retries of the backend are stopped
+type backendSamples struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	samples map[string]*performanceSample
+}
+
+// performanceSample the latest non-empty performance data and the background retry,
+// the retryDone is closed when the retry finishes, and it is nil if no retry is running
+type performanceSample struct {
+	data      []map[string]interface{}
+	sampledAt time.Time
+	retryDone chan struct{}
+}
+
+// NewPerformanceSampler init an instance of PerformanceSampler
+func NewPerformanceSampler(retryInterval, waitTime time.Duration) *PerformanceSampler {
+	return &PerformanceSampler{retryInterval: retryInterval, waitTime: waitTime,
+		backends: map[string]*backendSamples{}}
+}
+
+// SamplePerformance query the performance data of the backend by the sampler
+func SamplePerformance(ctx context.Context, backendName string, objectType int, indicators []int,
+	query QueryFunc) ([]map[string]interface{}, time.Time, error) {
+	return performanceSampler.Sample(ctx, backendName, objectType, indicators, query)
+}
+
+// Sample query the performance data once, the result is saved as the latest sample if it is not empty.
+// If the storage returns empty data, the query is retried in background and the latest sample which is not older
+// than sampleMaxAge is returned with its sample time. The sample time is zero if the data is queried just now.
+func (s *PerformanceSampler) Sample(ctx context.Context, backendName string, objectType int, indicators []int,
+	query QueryFunc) ([]map[string]interface{}, time.Time, error) {
+	key := fmt.Sprintf("%d:%v", objectType, indicators)
+	data, err := query(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(data) != 0 {
+		s.store(backendName, key, data)
+		return data, time.Time{}, nil
+	}
+
+	retryCtx, retryDone, started := s.startRetry(backendName, key)
+	if started {
+		go s.retry(retryCtx, backendName, key, query, retryDone)
+	}
+	if data, sampledAt := s.latest(backendName, key); data != nil {
+		return data, sampledAt, nil
+	}
+
+	// there is no sample to return, e.g. the first collect after startup, so wait for the retry for a while
+	select {
+	case <-retryDone:
+	case <-ctx.Done():
+	case <-time.After(s.waitTime):
+	}
+	data, sampledAt := s.latest(backendName, key)
+	return data, sampledAt, nil
+}
+
+// InvalidatePerformanceSamples remove the performance samples of the backend and stop its background retries
+func InvalidatePerformanceSamples(backendName string) {
+	performanceSampler.invalidate(backendName)
+}
+
+// retry query the performance data in background until it is not empty or the retry times are used up
+func (s *PerformanceSampler) retry(backendCtx context.Context, backendName, key string, query QueryFunc,
+	retryDone chan struct{}) {
+	defer s.finishRetry(backendName, key, retryDone)
+
+	ctx, cancel := context.WithTimeout(backendCtx, (sampleRetryTimes+1)*s.retryInterval)
+	defer cancel()
+	for i := 0; i < sampleRetryTimes; i++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.retryInterval):
+		}
+
+		data, err := query(ctx)
+		if err != nil {
+			log.AddContext(ctx).Warningf("sample performance data of backend [%s] failed, error: %v",
+				backendName, err)
+			return
+		}
+		if len(data) != 0 {
+			s.replace(backendCtx, backendName, key, data)
+			return
+		}
+	}
+	log.AddContext(ctx).Warningf("get empty performance data of backend [%s] after %d retries",
+		backendName, sampleRetryTimes)
+}
+
+// getOrCreate get the sample, the sample is created if it does not exist, the lock must be held
+func (s *PerformanceSampler) getOrCreate(backendName, key string) (*backendSamples, *performanceSample) {
+	backend, ok := s.backends[backendName]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		backend = &backendSamples{ctx: ctx, cancel: cancel, samples: map[string]*performanceSample{}}
+		s.backends[backendName] = backend
+	}
+	sample, ok := backend.samples[key]
+	if !ok {
+		sample = &performanceSample{}
+		backend.samples[key] = sample
+	}
+	return backend, sample
+}
+
+func (s *PerformanceSampler) store(backendName, key string, data []map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, sample := s.getOrCreate(backendName, key)
+	sample.data = data
+	sample.sampledAt = time.Now()
+}
+
+// replace update the data of the sample retried in background,
+// the sample is discarded if the backend is released in the meantime
+func (s *PerformanceSampler) replace(backendCtx context.Context, backendName, key string,
+	data []map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	backend, ok := s.backends[backendName]
+	if !ok || backend.ctx != backendCtx {
+		return
+	}
+	if sample, ok := backend.samples[key]; ok {
+		sample.data = data
+		sample.sampledAt = time.Now()
+	}
+}
+
+// latest get the latest sample and its sample time, nil is returned if the sample is older than sampleMaxAge
+func (s *PerformanceSampler) latest(backendName, key string) ([]map[string]interface{}, time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	backend, ok := s.backends[backendName]
+	if !ok {
+		return nil, time.Time{}
+	}
+	sample, ok := backend.samples[key]
+	if !ok || time.Since(sample.sampledAt) > sampleMaxAge {
+		return nil, time.Time{}
+	}
+	return sample.data, sample.sampledAt
+}
+
+// startRetry mark the retry of the sample as running and return the context of the backend and
+// the channel which is closed when the retry finishes, false is returned if the retry is already running
+func (s *PerformanceSampler) startRetry(backendName, key string) (context.Context, chan struct{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	backend, sample := s.getOrCreate(backendName, key)
+	if sample.retryDone != nil {
+		return nil, sample.retryDone, false
+	}
+	sample.retryDone = make(chan struct{})
+	return backend.ctx, sample.retryDone, true
+}
+
+// finishRetry mark the retry of the sample as finished, the retryDone is closed even if the backend is released
+func (s *PerformanceSampler) finishRetry(backendName, key string, retryDone chan struct{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	close(retryDone)
+	if backend, ok := s.backends[backendName]; ok {
+		if sample, ok := backend.samples[key]; ok && sample.retryDone == retryDone {
+			sample.retryDone = nil
+		}
+	}
+}
+
+func (s *PerformanceSampler) invalidate(backendName string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if backend, ok := s.backends[backendName]; ok {
+		backend.cancel()
+		delete(s.backends, backendName)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collect is a package that provides object and performance collect
+package collect
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSamplePerformance_with_data(t *testing.T) {
+	// arrange
+	backendName := "test-backend-sample-data"
+	defer InvalidatePerformanceSamples(backendName)
+	want := []map[string]interface{}{{"object_id": "1"}}
+	query := func(ctx context.Context) ([]map[string]interface{}, error) {
+		return want, nil
+	}
+
+	// action
+	got, sampledAt, err := SamplePerformance(context.Background(), backendName, 11, []int{22}, query)
+
+	// assert
+	if err != nil || !reflect.DeepEqual(got, want) || !sampledAt.IsZero() {
+		t.Errorf("TestSamplePerformance_with_data() want = %v, but got = %v, sampled at = %v, error = %v",
+			want, got, sampledAt, err)
+	}
+}
+
+func TestSamplePerformance_with_error(t *testing.T) {
+	// arrange
+	backendName := "test-backend-sample-error"
+	defer InvalidatePerformanceSamples(backendName)
+	query := func(ctx context.Context) ([]map[string]interface{}, error) {
+		return nil, errors.New("query failed")
+	}
+
+	// action
+	_, _, err := SamplePerformance(context.Background(), backendName, 11, []int{22}, query)
+
+	// assert
+	if err == nil || err.Error() != "query failed" {
+		t.Errorf("TestSamplePerformance_with_error() want error = query failed, but got = %v", err)
+	}
+}
+
+func TestSamplePerformance_with_empty_data_return_latest_sample(t *testing.T) {
+	// arrange
+	backendName := "test-backend-sample-latest"
+	defer InvalidatePerformanceSamples(backendName)
+	want := []map[string]interface{}{{"object_id": "1"}}
+	var empty bool
+	query := func(ctx context.Context) ([]map[string]interface{}, error) {
+		if empty {
+			return nil, nil
+		}
+		return want, nil
+	}
+	if _, _, err := SamplePerformance(context.Background(), backendName, 11, []int{22}, query); err != nil {
+		t.Errorf("TestSamplePerformance_with_empty_data_return_latest_sample() error = %v", err)
+		return
+	}
+	empty = true
+
+	// action
+	got, sampledAt, err := SamplePerformance(context.Background(), backendName, 11, []int{22}, query)
+
+	// assert
+	if err != nil || !reflect.DeepEqual(got, want) || sampledAt.IsZero() {
+		t.Errorf("TestSamplePerformance_with_empty_data_return_latest_sample() want = %v with sample time, "+
+			"but got = %v, sampled at = %v, error = %v", want, got, sampledAt, err)
+	}
+}
+
+func TestSamplePerformance_with_background_retry(t *testing.T) {
+	// arrange
+	backendName := "test-backend-sample-retry"
+	sampler := NewPerformanceSampler(time.Millisecond, time.Second)
+	defer sampler.invalidate(backendName)
+
+	want := []map[string]interface{}{{"object_id": "1"}}
+	var queried int32
+	query := func(ctx context.Context) ([]map[string]interface{}, error) {
+		if atomic.AddInt32(&queried, 1) < 3 {
+			return nil, nil
+		}
+		return want, nil
+	}
+
+	// action
+	got, sampledAt, err := sampler.Sample(context.Background(), backendName, 11, []int{22}, query)
+
+	// assert
+	if err != nil || !reflect.DeepEqual(got, want) || sampledAt.IsZero() {
+		t.Errorf("TestSamplePerformance_with_background_retry() want = %v with sample time, but got = %v, "+
+			"sampled at = %v, error = %v", want, got, sampledAt, err)
+	}
+}
+
+func TestSamplePerformance_with_no_sample_wait_timeout(t *testing.T) {
+	// arrange
+	backendName := "test-backend-sample-wait"
+	sampler := NewPerformanceSampler(time.Hour, time.Millisecond)
+	defer sampler.invalidate(backendName)
+	query := func(ctx context.Context) ([]map[string]interface{}, error) {
+		return nil, nil
+	}
+
+	// action
+	got, sampledAt, err := sampler.Sample(context.Background(), backendName, 11, []int{22}, query)
+
+	// assert
+	if err != nil || got != nil || !sampledAt.IsZero() {
+		t.Errorf("TestSamplePerformance_with_no_sample_wait_timeout() want no data, but got = %v, "+
+			"sampled at = %v, error = %v", got, sampledAt, err)
+	}
+}
+
+func TestInvalidatePerformanceSamples(t *testing.T) {
+	// arrange
+	backendName := "test-backend-sample-invalidate"
+	retryCtx, _, ok := performanceSampler.startRetry(backendName, "11:[22]")
+	if !ok {
+		t.Error("TestInvalidatePerformanceSamples() want retry started, but it is already running")
+		return
+	}
+
+	// action
+	InvalidatePerformanceSamples(backendName)
+
+	// assert
+	if retryCtx.Err() == nil {
+		t.Error("TestInvalidatePerformanceSamples() want retry context cancelled, but it is not")
+	}
+	if data, _ := performanceSampler.latest(backendName, "11:[22]"); data != nil {
+		t.Error("TestInvalidatePerformanceSamples() want samples removed, but they are still cached")
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -22,10 +22,12 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/provider/backend"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -68,6 +70,32 @@ var objectHandlerCache = &HandlerMap[ObjectHandler]{}
 // The above table indicates that GetLunNameMapping is specified to obtain the name mapping of the lun volume
 var performanceHandlerCache = &HandlerMap[PerformanceHandler]{}
 
+// objectStreamHandlerCache is routing table with three-layer routing, which is used by the CollectStream
+// e.g.
+//
+//	|-------------------|-----------------|-------------------|
+//	|   StorageType     |   collectType   |  handler          |
+//	|-------------------|-----------------|-------------------|
+//	|   oceanStorage    |   lun           |  StreamLun        |
+//	|-------------------|-----------------|-------------------|
+//
+// Only the object data which is queried page by page needs a stream handler, the others will be collected by
+// the handler in objectHandlerCache and then sent in pages
+var objectStreamHandlerCache = &HandlerMap[ObjectStreamHandler]{}
+
+// nameLookupHandlerCache is routing table with three-layer routing, which is used by the name mapping cache
+// e.g.
+//
+//	|-------------------|----------------|--------------------|
+//	|   StorageType     |   collectType  |  handler           |
+//	|-------------------|----------------|--------------------|
+//	|   oceanStorage    |   lun          |  LookupLunNames    |
+//	|-------------------|----------------|--------------------|
+//
+// Only the collect types with a lookup handler refresh the ids missing from the cached name mapping one by one,
+// the others query the whole name mapping again
+var nameLookupHandlerCache = &HandlerMap[NameLookupHandler]{}
+
 // HandlerMap cache format
 type HandlerMap[T any] map[string]map[string]T
 
@@ -77,22 +105,44 @@ type ObjectHandler TObjectHandler[interface{}]
 // TObjectHandler When clients are different, we must use generics to represent different clients
 type TObjectHandler[T any] func(context.Context, T, *cmi.CollectRequest) (*cmi.CollectResponse, error)
 
+// ObjectStreamHandler object stream handler format
+type ObjectStreamHandler TObjectStreamHandler[interface{}]
+
+// TObjectStreamHandler When clients are different, we must use generics to represent different clients
+type TObjectStreamHandler[T any] func(context.Context, T, *cmi.CollectRequest, ResponseSender) error
+
 // PerformanceHandler performance handler format
 type PerformanceHandler TPerformanceHandler[interface{}]
 
 // TPerformanceHandler When clients are different, we must use generics to represent different clients
 type TPerformanceHandler[T any] func(context.Context, T) (map[string]string, error)
 
+// NameLookupHandler name lookup handler format
+type NameLookupHandler TNameLookupHandler[interface{}]
+
+// TNameLookupHandler When clients are different, we must use generics to represent different clients
+type TNameLookupHandler[T any] func(context.Context, T, []string) (map[string]string, error)
+
 // RegisterObjectHandler register a function to handle object data
 func RegisterObjectHandler[T any](storageType, collectType string, tHandler TObjectHandler[T]) {
 	registerHandler(objectHandlerCache, storageType, collectType, tHandler.ToObjectHandler())
 }
 
+// RegisterObjectStreamHandler register a function to handle object data in stream mode
+func RegisterObjectStreamHandler[T any](storageType, collectType string, tHandler TObjectStreamHandler[T]) {
+	registerHandler(objectStreamHandlerCache, storageType, collectType, tHandler.ToObjectStreamHandler())
+}
+
 // RegisterPerformanceHandler register a function to handle performance data
 func RegisterPerformanceHandler[T any](storageType, collectType string, tHandler TPerformanceHandler[T]) {
 	registerHandler(performanceHandlerCache, storageType, collectType, tHandler.ToPerformanceHandler())
 }
 
+// RegisterNameLookupHandler register a function to look up the names of the specified object ids
+func RegisterNameLookupHandler[T any](storageType, collectType string, tHandler TNameLookupHandler[T]) {
+	registerHandler(nameLookupHandlerCache, storageType, collectType, tHandler.ToNameLookupHandler())
+}
+
 // RegisterClient key is backend name, value is ClientInfo
 func RegisterClient(backendName string, info backend.ClientInfo) {
 	mutex.Lock()
@@ -101,6 +151,20 @@ func RegisterClient(backendName string, info backend.ClientInfo) {
 	clientCache[backendName] = info
 }
 
+// UpdateClientProfile update the profile of the cached client,
+// the profile is discarded if the client is removed or replaced in the meantime
+func UpdateClientProfile(backendName string, client interface{}, profile backend.StorageProfile) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	info, ok := clientCache[backendName]
+	if !ok || info.Client != client {
+		return
+	}
+	info.Profile = profile
+	clientCache[backendName] = info
+}
+
 // RemoveClient remove the client from cache
 func RemoveClient(backendName string) {
 	mutex.Lock()
@@ -114,11 +178,31 @@ func GetObjectHandler(storageType, collectType string) (ObjectHandler, error) {
 	return getHandler(objectHandlerCache, storageType, collectType)
 }
 
+// GetObjectStreamHandler get collect object data handler in stream mode
+func GetObjectStreamHandler(storageType, collectType string) (ObjectStreamHandler, error) {
+	return getHandler(objectStreamHandlerCache, storageType, collectType)
+}
+
 // GetPerformanceHandler get collect performance data handler
 func GetPerformanceHandler(storageType, collectType string) (PerformanceHandler, error) {
 	return getHandler(performanceHandlerCache, storageType, collectType)
 }
 
+// GetNameLookupHandler get the handler to look up the names of the specified object ids
+func GetNameLookupHandler(storageType, collectType string) (NameLookupHandler, error) {
+	return getHandler(nameLookupHandlerCache, storageType, collectType)
+}
+
+// GetObjectCollectTypes get the collect types which have object data handler
+func GetObjectCollectTypes(storageType string) []string {
+	return getCollectTypes(objectHandlerCache, storageType)
+}
+
+// GetPerformanceCollectTypes get the collect types which have performance data handler
+func GetPerformanceCollectTypes(storageType string) []string {
+	return getCollectTypes(performanceHandlerCache, storageType)
+}
+
 // registerHandler register a handler with the specified key to the cache
 func registerHandler[T any](cache *HandlerMap[T], storageType, collectType string, handler T) {
 	mutex.Lock()
@@ -140,8 +224,7 @@ func getHandler[T any](cache *HandlerMap[T], storageType, collectType string) (T
 	handlers, ok := (*cache)[storageType]
 	var t T
 	if !ok {
-		errMsg := fmt.Sprintf("not found handlers, storageType type is [%s] ", collectType)
-		return t, errors.New(errMsg)
+		return t, cmierror.New(cmierror.InvalidArgument, "not found handlers, storage type is [%s]", storageType)
 	}
 
 	handler, ok := handlers[collectType]
@@ -149,8 +232,20 @@ func getHandler[T any](cache *HandlerMap[T], storageType, collectType string) (T
 		return handler, nil
 	}
 
-	errMsg := fmt.Sprintf("not found handlers, collect type is [%s] ", collectType)
-	return t, errors.New(errMsg)
+	return t, cmierror.New(cmierror.InvalidArgument, "not found handlers, collect type is [%s]", collectType)
+}
+
+// getCollectTypes get all collect types registered in the specified cache, the result is sorted
+func getCollectTypes[T any](cache *HandlerMap[T], storageType string) []string {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	collectTypes := make([]string, 0, len((*cache)[storageType]))
+	for collectType := range (*cache)[storageType] {
+		collectTypes = append(collectTypes, collectType)
+	}
+	sort.Strings(collectTypes)
+	return collectTypes
 }
 
 // GetClient get or register client
@@ -187,6 +282,21 @@ func (receiver TObjectHandler[T]) ToObjectHandler() ObjectHandler {
 	}
 }
 
+// ToObjectStreamHandler convert TObjectStreamHandler to ObjectStreamHandler
+func (receiver TObjectStreamHandler[T]) ToObjectStreamHandler() ObjectStreamHandler {
+	return func(ctx context.Context, param interface{}, request *cmi.CollectRequest, send ResponseSender) error {
+		if param == nil {
+			return errors.New("ToObjectStreamHandler IllegalArgumentError, handler function argument is nil")
+		}
+		if t, ok := param.(T); ok {
+			return receiver(ctx, t, request, send)
+		}
+		errMsg := fmt.Sprintf("ToObjectStreamHandler IllegalArgumentError, current param is [%s], "+
+			"want is [%s]", reflect.TypeOf(param).Kind().String(), reflect.TypeOf((*T)(nil)).Kind().String())
+		return errors.New(errMsg)
+	}
+}
+
 // ToPerformanceHandler convert TPerformanceHandler to PerformanceHandler
 func (receiver TPerformanceHandler[T]) ToPerformanceHandler() PerformanceHandler {
 	return func(ctx context.Context, param interface{}) (map[string]string, error) {
@@ -201,3 +311,18 @@ func (receiver TPerformanceHandler[T]) ToPerformanceHandler() PerformanceHandler
 		return nil, errors.New(errMsg)
 	}
 }
+
+// ToNameLookupHandler convert TNameLookupHandler to NameLookupHandler
+func (receiver TNameLookupHandler[T]) ToNameLookupHandler() NameLookupHandler {
+	return func(ctx context.Context, param interface{}, ids []string) (map[string]string, error) {
+		if param == nil {
+			return nil, errors.New("ToNameLookupHandler IllegalArgumentError, handler function argument is nil")
+		}
+		if t, ok := param.(T); ok {
+			return receiver(ctx, t, ids)
+		}
+		errMsg := fmt.Sprintf("ToNameLookupHandler IllegalArgumentError, current param is [%s], "+
+			"want is [%s]", reflect.TypeOf(param).Kind().String(), reflect.TypeOf((*T)(nil)).Kind().String())
+		return nil, errors.New(errMsg)
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -17,17 +17,26 @@
 // Package collect is a package that provides object and performance collect
 package collect
 
-// PageResultTuple page query result
+// PageResultTuple page query result, the index is the sequence number of the page
 type PageResultTuple struct {
+	Index int
 	Error error
 	Data  []map[string]interface{}
 }
 
-// PerformanceIndicators performance information
+// PerformanceIndicators performance information,
+// the timestamp is the sample time in seconds since epoch, it is only set for the historical performance data
 type PerformanceIndicators struct {
 	Indicators      []int     `json:"indicators"`
 	IndicatorValues []float64 `json:"indicator_values"`
 	ObjectId        string    `json:"object_id"`
+	Timestamp       int64     `json:"timestamp"`
+}
+
+// PerformanceIndicatorsCapability the performance indicators available for the object type
+type PerformanceIndicatorsCapability struct {
+	ObjectType int   `json:"object_type"`
+	Indicators []int `json:"indicators"`
 }
 
 // ArrayObject array object information
@@ -79,3 +88,291 @@ type FileSystemObject struct {
 	SnapshotUsedCapacity    string `json:"SNAPSHOTUSECAPACITY" metrics:"SNAPSHOTUSECAPACITY"`
 	SnapshotReserveCapacity string `json:"SNAPSHOTRESERVECAPACITY" metrics:"SNAPSHOTRESERVECAPACITY"`
 }
+
+// FCPortObject fc port object information, the speeds are in Mbit/s
+type FCPortObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	Location      string `json:"LOCATION" metrics:"LOCATION"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	Wwn           string `json:"WWN" metrics:"WWN"`
+	RunSpeed      string `json:"RUNSPEED" metrics:"RUNSPEED"`
+	MaxSpeed      string `json:"MAXSPEED" metrics:"MAXSPEED"`
+	LogicType     string `json:"LOGICTYPE" metrics:"LOGICTYPE"`
+}
+
+// ETHPortObject ethernet port object information, the speeds are in Mbit/s
+type ETHPortObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	Location      string `json:"LOCATION" metrics:"LOCATION"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	MacAddress    string `json:"MACADDRESS" metrics:"MACADDRESS"`
+	Ipv4Address   string `json:"IPV4ADDR" metrics:"IPV4ADDR"`
+	Ipv6Address   string `json:"IPV6ADDR" metrics:"IPV6ADDR"`
+	Speed         string `json:"SPEED" metrics:"SPEED"`
+	Mtu           string `json:"MTU" metrics:"MTU"`
+	LogicType     string `json:"LOGICTYPE" metrics:"LOGICTYPE"`
+}
+
+// EnclosureObject enclosure object information, the temperature is in Celsius
+type EnclosureObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	Location      string `json:"LOCATION" metrics:"LOCATION"`
+	Model         string `json:"MODEL" metrics:"MODEL"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	Temperature   string `json:"TEMPERATURE" metrics:"TEMPERATURE"`
+}
+
+// PowerSupplyObject power supply unit object information
+type PowerSupplyObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	Location      string `json:"LOCATION" metrics:"LOCATION"`
+	Model         string `json:"MODEL" metrics:"MODEL"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+}
+
+// FanObject fan object information
+type FanObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	Location      string `json:"LOCATION" metrics:"LOCATION"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+}
+
+// BBUObject backup battery unit object information
+type BBUObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	Location      string `json:"LOCATION" metrics:"LOCATION"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+}
+
+// InterfaceModuleObject interface module object information, the temperature is in Celsius
+type InterfaceModuleObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	Location      string `json:"LOCATION" metrics:"LOCATION"`
+	Model         string `json:"MODEL" metrics:"MODEL"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	Temperature   string `json:"TEMPERATURE" metrics:"TEMPERATURE"`
+}
+
+// DiskObject disk object information, the capacity is SECTORS * SECTORSIZE in bytes,
+// and the disk domain name is collected from the disk pool which the disk belongs to
+type DiskObject struct {
+	Id             string `json:"ID" metrics:"ID"`
+	Location       string `json:"LOCATION" metrics:"LOCATION"`
+	HealthStatus   string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus  string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	DiskType       string `json:"DISKTYPE" metrics:"DISKTYPE"`
+	Sectors        string `json:"SECTORS" metrics:"SECTORS"`
+	SectorSize     string `json:"SECTORSIZE" metrics:"SECTORSIZE"`
+	Temperature    string `json:"TEMPERATURE" metrics:"TEMPERATURE"`
+	RemainLife     string `json:"REMAINLIFE" metrics:"REMAINLIFE"`
+	DiskDomainId   string `json:"POOLID" metrics:"POOLID"`
+	DiskDomainName string `json:"diskDomainName" metrics:"diskDomainName"`
+}
+
+// HostObject host object information, the initiators and mapped luns are collected from
+// the initiators and luns associated with the host, the ids and names are separated by comma
+type HostObject struct {
+	Id                 string `json:"ID" metrics:"ID"`
+	Name               string `json:"NAME" metrics:"NAME"`
+	OperationSystem    string `json:"OPERATIONSYSTEM" metrics:"OPERATIONSYSTEM"`
+	Ip                 string `json:"IP" metrics:"IP"`
+	HealthStatus       string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus      string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	HostGroupId        string `json:"PARENTID" metrics:"PARENTID"`
+	InitiatorNum       string `json:"initiatorNum" metrics:"initiatorNum"`
+	OnlineInitiatorNum string `json:"onlineInitiatorNum" metrics:"onlineInitiatorNum"`
+	MappedLunNum       string `json:"mappedLunNum" metrics:"mappedLunNum"`
+	MappedLunIds       string `json:"mappedLunIds" metrics:"mappedLunIds"`
+	MappedLunNames     string `json:"mappedLunNames" metrics:"mappedLunNames"`
+	InitiatorIds       string `json:"initiatorIds" metrics:"initiatorIds"`
+	OnlineInitiatorIds string `json:"onlineInitiatorIds" metrics:"onlineInitiatorIds"`
+}
+
+// HostGroupObject host group object information
+type HostGroupObject struct {
+	Id                string `json:"ID" metrics:"ID"`
+	Name              string `json:"NAME" metrics:"NAME"`
+	IsAdd2MappingView string `json:"ISADD2MAPPINGVIEW" metrics:"ISADD2MAPPINGVIEW"`
+	HostNum           string `json:"hostNum" metrics:"hostNum"`
+}
+
+// MappingViewObject mapping view object information, the host groups and lun groups are collected from
+// the objects associated with the mapping view, multiple groups are separated by commas
+type MappingViewObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	HostGroupId   string `json:"hostGroupId" metrics:"hostGroupId"`
+	HostGroupName string `json:"hostGroupName" metrics:"hostGroupName"`
+	LunGroupId    string `json:"lunGroupId" metrics:"lunGroupId"`
+	LunGroupName  string `json:"lunGroupName" metrics:"lunGroupName"`
+}
+
+// LunSnapshotObject lun snapshot object information, the running status shows whether the snapshot is rolling back
+type LunSnapshotObject struct {
+	Id               string `json:"ID" metrics:"ID"`
+	Name             string `json:"NAME" metrics:"NAME"`
+	ParentId         string `json:"PARENTID" metrics:"PARENTID"`
+	ParentName       string `json:"PARENTNAME" metrics:"PARENTNAME"`
+	UserCapacity     string `json:"USERCAPACITY" metrics:"USERCAPACITY"`
+	ConsumedCapacity string `json:"CONSUMEDCAPACITY" metrics:"CONSUMEDCAPACITY"`
+	Timestamp        string `json:"TIMESTAMP" metrics:"TIMESTAMP"`
+	HealthStatus     string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus    string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	RollbackRate     string `json:"ROLLBACKRATE" metrics:"ROLLBACKRATE"`
+}
+
+// FSSnapshotObject filesystem snapshot object information
+type FSSnapshotObject struct {
+	Id               string `json:"ID" metrics:"ID"`
+	Name             string `json:"NAME" metrics:"NAME"`
+	ParentId         string `json:"PARENTID" metrics:"PARENTID"`
+	ParentName       string `json:"PARENTNAME" metrics:"PARENTNAME"`
+	ConsumedCapacity string `json:"CONSUMEDCAPACITY" metrics:"CONSUMEDCAPACITY"`
+	Timestamp        string `json:"TIMESTAMP" metrics:"TIMESTAMP"`
+	HealthStatus     string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus    string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	RollbackRate     string `json:"ROLLBACKRATE" metrics:"ROLLBACKRATE"`
+}
+
+// HyperMetroDomainObject hypermetro domain object information, the domain type is san or nas
+type HyperMetroDomainObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	HealthStatus  string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	DomainType    string `json:"domainType" metrics:"domainType"`
+}
+
+// HyperMetroPairObject hypermetro pair object information, the local object is a lun or a filesystem
+type HyperMetroPairObject struct {
+	Id               string `json:"ID" metrics:"ID"`
+	HealthStatus     string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus    string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	DomainId         string `json:"DOMAINID" metrics:"DOMAINID"`
+	DomainName       string `json:"DOMAINNAME" metrics:"DOMAINNAME"`
+	LocalObjectId    string `json:"LOCALOBJID" metrics:"LOCALOBJID"`
+	LocalObjectName  string `json:"LOCALOBJNAME" metrics:"LOCALOBJNAME"`
+	RemoteObjectId   string `json:"REMOTEOBJID" metrics:"REMOTEOBJID"`
+	RemoteObjectName string `json:"REMOTEOBJNAME" metrics:"REMOTEOBJNAME"`
+	ResourceType     string `json:"HCRESOURCETYPE" metrics:"HCRESOURCETYPE"`
+	IsPrimary        string `json:"ISPRIMARY" metrics:"ISPRIMARY"`
+	SyncProgress     string `json:"SYNCPROGRESS" metrics:"SYNCPROGRESS"`
+}
+
+// ReplicationPairObject remote replication pair object information, the sync lag is the seconds since
+// the latest synchronization of asynchronous replication ended, measured by the clock of storage
+type ReplicationPairObject struct {
+	Id                  string `json:"ID" metrics:"ID"`
+	HealthStatus        string `json:"HEALTHSTATUS" metrics:"HEALTHSTATUS"`
+	RunningStatus       string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	LocalResourceId     string `json:"LOCALRESID" metrics:"LOCALRESID"`
+	LocalResourceName   string `json:"LOCALRESNAME" metrics:"LOCALRESNAME"`
+	LocalResourceType   string `json:"LOCALRESTYPE" metrics:"LOCALRESTYPE"`
+	RemoteResourceId    string `json:"REMOTERESID" metrics:"REMOTERESID"`
+	RemoteResourceName  string `json:"REMOTERESNAME" metrics:"REMOTERESNAME"`
+	RemoteDeviceName    string `json:"REMOTEDEVICENAME" metrics:"REMOTEDEVICENAME"`
+	ReplicationModel    string `json:"REPLICATIONMODEL" metrics:"REPLICATIONMODEL"`
+	ReplicationProgress string `json:"REPLICATIONPROGRESS" metrics:"REPLICATIONPROGRESS"`
+	SyncInterval        string `json:"TIMINGVAL" metrics:"TIMINGVAL"`
+	EndTime             string `json:"ENDTIME" metrics:"ENDTIME"`
+	SyncLag             string `json:"syncLag" metrics:"syncLag"`
+}
+
+// NFSShareObject nfs share object information, the client count is the number of clients allowed to access the share
+type NFSShareObject struct {
+	Id           string `json:"ID" metrics:"ID"`
+	SharePath    string `json:"SHAREPATH" metrics:"SHAREPATH"`
+	FilesystemId string `json:"FSID" metrics:"FSID"`
+	ClientCount  string `json:"clientCount" metrics:"clientCount"`
+}
+
+// CIFSShareObject cifs share object information, the acl count is the number of access control entries of the share
+type CIFSShareObject struct {
+	Id           string `json:"ID" metrics:"ID"`
+	Name         string `json:"NAME" metrics:"NAME"`
+	SharePath    string `json:"SHAREPATH" metrics:"SHAREPATH"`
+	FilesystemId string `json:"FSID" metrics:"FSID"`
+	ACLCount     string `json:"aclCount" metrics:"aclCount"`
+}
+
+// DTreeObject dtree object information, the parent is the filesystem which the dtree belongs to
+type DTreeObject struct {
+	Id          string `json:"ID" metrics:"ID"`
+	Name        string `json:"NAME" metrics:"NAME"`
+	ParentId    string `json:"PARENTID" metrics:"PARENTID"`
+	ParentName  string `json:"PARENTNAME" metrics:"PARENTNAME"`
+	QuotaSwitch string `json:"QUOTASWITCH" metrics:"QUOTASWITCH"`
+}
+
+// QuotaObject quota object information, the parent is a filesystem or a dtree, the space is in bytes
+type QuotaObject struct {
+	Id             string `json:"ID" metrics:"ID"`
+	ParentId       string `json:"PARENTID" metrics:"PARENTID"`
+	ParentType     string `json:"PARENTTYPE" metrics:"PARENTTYPE"`
+	ParentName     string `json:"parentName" metrics:"parentName"`
+	FilesystemName string `json:"filesystemName" metrics:"filesystemName"`
+	QuotaType      string `json:"QUOTATYPE" metrics:"QUOTATYPE"`
+	SpaceHardQuota string `json:"SPACEHARDQUOTA" metrics:"SPACEHARDQUOTA"`
+	SpaceSoftQuota string `json:"SPACESOFTQUOTA" metrics:"SPACESOFTQUOTA"`
+	SpaceUsed      string `json:"SPACEUSED" metrics:"SPACEUSED"`
+	FileHardQuota  string `json:"FILEHARDQUOTA" metrics:"FILEHARDQUOTA"`
+	FileSoftQuota  string `json:"FILESOFTQUOTA" metrics:"FILESOFTQUOTA"`
+	FileUsed       string `json:"FILEUSED" metrics:"FILEUSED"`
+}
+
+// QoSObject smart qos policy object information, the bandwidth is in MB/s and the latency is in us,
+// the lun ids and filesystem ids are the objects associated with the policy, separated by comma
+type QoSObject struct {
+	Id            string `json:"ID" metrics:"ID"`
+	Name          string `json:"NAME" metrics:"NAME"`
+	EnableStatus  string `json:"ENABLESTATUS" metrics:"ENABLESTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	MaxIOPS       string `json:"MAXIOPS" metrics:"MAXIOPS"`
+	MinIOPS       string `json:"MINIOPS" metrics:"MINIOPS"`
+	MaxBandwidth  string `json:"MAXBANDWIDTH" metrics:"MAXBANDWIDTH"`
+	MinBandwidth  string `json:"MINBANDWIDTH" metrics:"MINBANDWIDTH"`
+	Latency       string `json:"LATENCY" metrics:"LATENCY"`
+	LunIds        string `json:"lunIds" metrics:"lunIds"`
+	FilesystemIds string `json:"filesystemIds" metrics:"filesystemIds"`
+}
+
+// AlarmObject current alarm object information, the occurrence time is the seconds since epoch
+type AlarmObject struct {
+	Sequence       string `json:"sequence" metrics:"sequence"`
+	AlarmId        string `json:"alarmId" metrics:"alarmId"`
+	Name           string `json:"name" metrics:"name"`
+	Level          string `json:"level" metrics:"level"`
+	ObjectType     string `json:"alarmObjType" metrics:"alarmObjType"`
+	ObjectId       string `json:"alarmObjId" metrics:"alarmObjId"`
+	Location       string `json:"location" metrics:"location"`
+	Description    string `json:"description" metrics:"description"`
+	OccurrenceTime string `json:"startTime" metrics:"startTime"`
+}
+
+// VStoreObject vstore object information, the capacity quotas are in sectors,
+// the lun count and filesystem count are the number of objects belonging to the vstore
+type VStoreObject struct {
+	Id                   string `json:"ID" metrics:"ID"`
+	Name                 string `json:"NAME" metrics:"NAME"`
+	RunningStatus        string `json:"RUNNINGSTATUS" metrics:"RUNNINGSTATUS"`
+	SanCapacityQuota     string `json:"sanCapacityQuota" metrics:"sanCapacityQuota"`
+	SanFreeCapacityQuota string `json:"sanFreeCapacityQuota" metrics:"sanFreeCapacityQuota"`
+	NasCapacityQuota     string `json:"nasCapacityQuota" metrics:"nasCapacityQuota"`
+	NasFreeCapacityQuota string `json:"nasFreeCapacityQuota" metrics:"nasFreeCapacityQuota"`
+	LunCount             string `json:"lunCount" metrics:"lunCount"`
+	FilesystemCount      string `json:"filesystemCount" metrics:"filesystemCount"`
+}
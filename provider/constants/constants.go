@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -21,6 +21,9 @@ const (
 	// OceanStorage is a storage type oceanStorage.
 	OceanStorage = "oceanStorage"
 
+	// DistributedStorage is a storage type distributedStorage, e.g. OceanStor Pacific.
+	DistributedStorage = "distributedStorage"
+
 	// Object is a metrics type object.
 	Object = "object"
 
@@ -42,6 +45,78 @@ const (
 	// Filesystem is a collect type filesystem.
 	Filesystem = "filesystem"
 
+	// FCPort is a collect type fcport, the ports used by NVMe over FC are fc ports and collected by it too.
+	FCPort = "fcport"
+
+	// ETHPort is a collect type ethport.
+	// The RoCE ports used by NVMe over RoCE, which are only present on the OceanStor Dorado arrays fitted with
+	// RoCE interface modules, are out of scope: there is no collect type, performance object type or exporter
+	// collector for them, because their storage resource and performance object type are not validated yet.
+	ETHPort = "ethport"
+
+	// Enclosure is a collect type enclosure.
+	Enclosure = "enclosure"
+
+	// PowerSupply is a collect type psu.
+	PowerSupply = "psu"
+
+	// Fan is a collect type fan.
+	Fan = "fan"
+
+	// BBU is a collect type bbu.
+	BBU = "bbu"
+
+	// InterfaceModule is a collect type interfacemodule.
+	InterfaceModule = "interfacemodule"
+
+	// Disk is a collect type disk.
+	Disk = "disk"
+
+	// Host is a collect type host.
+	Host = "host"
+
+	// HostGroup is a collect type hostgroup.
+	HostGroup = "hostgroup"
+
+	// MappingView is a collect type mappingview.
+	MappingView = "mappingview"
+
+	// LunSnapshot is a collect type lunsnapshot.
+	LunSnapshot = "lunsnapshot"
+
+	// FSSnapshot is a collect type fssnapshot.
+	FSSnapshot = "fssnapshot"
+
+	// HyperMetroDomain is a collect type hypermetrodomain.
+	HyperMetroDomain = "hypermetrodomain"
+
+	// HyperMetroPair is a collect type hypermetropair.
+	HyperMetroPair = "hypermetropair"
+
+	// ReplicationPair is a collect type replicationpair.
+	ReplicationPair = "replicationpair"
+
+	// NFSShare is a collect type nfsshare.
+	NFSShare = "nfsshare"
+
+	// CIFSShare is a collect type cifsshare.
+	CIFSShare = "cifsshare"
+
+	// DTree is a collect type dtree.
+	DTree = "dtree"
+
+	// Quota is a collect type quota.
+	Quota = "quota"
+
+	// QoS is a collect type qos.
+	QoS = "qos"
+
+	// Alarm is a collect type alarm.
+	Alarm = "alarm"
+
+	// VStore is a collect type vstore.
+	VStore = "vstore"
+
 	// NasVolume is a volume type nas
 	NasVolume = "nas"
 
@@ -69,6 +144,18 @@ const (
 	// StorageSan is a storage volume type oceanstor-san
 	StorageSan = "oceanstor-san"
 
+	// StorageFusionNas is a storage volume type fusionstorage-nas
+	StorageFusionNas = "fusionstorage-nas"
+
+	// StorageFusionSan is a storage volume type fusionstorage-san
+	StorageFusionSan = "fusionstorage-san"
+
+	// Id is the field ID of storage object
+	Id = "ID"
+
+	// Name is the field NAME of storage object
+	Name = "NAME"
+
 	// ObjectId is the field objectId
 	ObjectId = "ObjectId"
 
@@ -81,3 +168,15 @@ const (
 	// StorageV6PointReleasePrefix defines the number of storage version which supported point version
 	StorageV6PointReleasePrefix = "6"
 )
+
+// PerformanceObjectTypes the object types of the storage performance data
+// Map key is the collect type.
+// Map value is the object type used by the storage performance interfaces.
+var PerformanceObjectTypes = map[string]int{
+	Filesystem:  40,
+	Lun:         11,
+	Controller:  207,
+	StoragePool: 216,
+	FCPort:      212,
+	ETHPort:     213,
+}
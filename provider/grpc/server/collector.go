@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,17 +19,21 @@ package server
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/collect"
 	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/provider/grpc/helper"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
+// maxHistoryTimeRange the max time range of the historical performance data in one request, in seconds
+const maxHistoryTimeRange = int64(24 * time.Hour / time.Second)
+
 var collectValidator = helper.NewValidator[*cmi.CollectRequest](validateBackendName, validateCollectType,
-	validateMetricsType)
+	validateMetricsType, validateTimeRange)
 
 // Collector This object implements the cmi.CollectorServer service.
 type Collector struct{}
@@ -54,10 +58,60 @@ func (c *Collector) Collect(ctx context.Context, request *cmi.CollectRequest) (*
 	return collector.Collect(ctx, request)
 }
 
+// CollectStream This method is the entry point for collecting data in stream mode.
+// The collected data will be sent in several responses, e.g. one page of luns in one response.
+func (c *Collector) CollectStream(request *cmi.CollectRequest, stream cmi.Collector_CollectStreamServer) error {
+	ctx := stream.Context()
+	log.AddContext(ctx).Infof("Start to collect stream, request: %v", request)
+	defer log.AddContext(ctx).Infof("Finish to collect stream, backend name %s", request.BackendName)
+
+	if err := collectValidator.Validate(request); err != nil {
+		return err
+	}
+
+	collector, err := collect.GetCollector(request.GetMetricsType())
+	if err != nil {
+		log.AddContext(ctx).Errorf("Get collector failed, error: %v", err)
+		return err
+	}
+	log.AddContext(ctx).Infof("Get collector success, collector: %v", collector)
+
+	return collector.CollectStream(request, stream)
+}
+
+// GetCollectCapabilities This method returns the collect types and indicators supported by the backend.
+// The capabilities of all collectors will be merged into one response.
+func (c *Collector) GetCollectCapabilities(ctx context.Context,
+	request *cmi.GetCollectCapabilitiesRequest) (*cmi.GetCollectCapabilitiesResponse, error) {
+	log.AddContext(ctx).Infof("Start to get collect capabilities, request: %v", request)
+	defer log.AddContext(ctx).Infof("Finish to get collect capabilities, backend name %s", request.BackendName)
+
+	if request.GetBackendName() == "" {
+		return nil, cmierror.New(cmierror.InvalidArgument, "backend name is blank")
+	}
+
+	response := &cmi.GetCollectCapabilitiesResponse{BackendName: request.GetBackendName()}
+	for _, metricsType := range []string{constants.Object, constants.Performance} {
+		collector, err := collect.GetCollector(metricsType)
+		if err != nil {
+			log.AddContext(ctx).Errorf("Get collector failed, error: %v", err)
+			return nil, err
+		}
+
+		capabilities, err := collector.GetCollectCapabilities(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		response.ObjectTypes = append(response.ObjectTypes, capabilities.GetObjectTypes()...)
+		response.PerformanceTypes = append(response.PerformanceTypes, capabilities.GetPerformanceTypes()...)
+	}
+	return response, nil
+}
+
 // validateBackendName validate if the backend name is blank
 func validateBackendName(request *cmi.CollectRequest) error {
 	if request.GetBackendName() == "" {
-		return errors.New("illegalArgumentError backend name is blank")
+		return cmierror.New(cmierror.InvalidArgument, "backend name is blank")
 	}
 	return nil
 }
@@ -65,7 +119,7 @@ func validateBackendName(request *cmi.CollectRequest) error {
 // validateCollectType validate if the collect type is blank
 func validateCollectType(request *cmi.CollectRequest) error {
 	if request.GetCollectType() == "" {
-		return errors.New("illegalArgumentError collect type is blank")
+		return cmierror.New(cmierror.InvalidArgument, "collect type is blank")
 	}
 	return nil
 }
@@ -73,10 +127,35 @@ func validateCollectType(request *cmi.CollectRequest) error {
 // validateMetricsType validate if the metrics type is blank
 func validateMetricsType(request *cmi.CollectRequest) error {
 	if request.GetMetricsType() == "" {
-		return errors.New("illegalArgumentError metrics type is blank")
+		return cmierror.New(cmierror.InvalidArgument, "metrics type is blank")
 	}
 	if request.GetMetricsType() != constants.Object && request.GetMetricsType() != constants.Performance {
-		return errors.New("illegalArgumentError unsupported metrics type")
+		return cmierror.New(cmierror.InvalidArgument, "unsupported metrics type")
+	}
+	return nil
+}
+
+// validateTimeRange validate the time range of historical performance data, the end time must be greater than
+// the start time and not in the future, the range must not exceed maxHistoryTimeRange, and the time range is
+// only supported by the performance metrics
+func validateTimeRange(request *cmi.CollectRequest) error {
+	if request.GetStartTime() == 0 && request.GetEndTime() == 0 && request.GetInterval() == 0 {
+		return nil
+	}
+	if request.GetMetricsType() != constants.Performance {
+		return cmierror.New(cmierror.InvalidArgument, "time range is only supported by performance metrics")
+	}
+	if request.GetStartTime() <= 0 || request.GetEndTime() <= request.GetStartTime() {
+		return cmierror.New(cmierror.InvalidArgument, "end time must be greater than the positive start time")
+	}
+	if request.GetEndTime() > time.Now().Unix() {
+		return cmierror.New(cmierror.InvalidArgument, "end time must not be in the future")
+	}
+	if request.GetEndTime()-request.GetStartTime() > maxHistoryTimeRange {
+		return cmierror.New(cmierror.InvalidArgument, "time range must not exceed 24 hours")
+	}
+	if request.GetInterval() < 0 {
+		return cmierror.New(cmierror.InvalidArgument, "interval must not be negative")
 	}
 	return nil
 }
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -22,6 +22,7 @@ import (
 
 	cmiConfig "github.com/huawei/csm/v2/config/cmi"
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/collect"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -34,6 +35,13 @@ func (i *Identity) Probe(ctx context.Context, request *cmi.ProbeRequest) (*cmi.P
 	return &cmi.ProbeResponse{}, nil
 }
 
+// ProbeBackends return the login state of each backend
+func (i *Identity) ProbeBackends(ctx context.Context,
+	request *cmi.ProbeBackendsRequest) (*cmi.ProbeBackendsResponse, error) {
+	log.AddContext(ctx).Debugln("Start probe backends")
+	return &cmi.ProbeBackendsResponse{Backends: collect.GetBackendStates()}, nil
+}
+
 // GetProvisionerInfo get provider info
 func (i *Identity) GetProvisionerInfo(ctx context.Context,
 	request *cmi.GetProviderInfoRequest) (*cmi.GetProviderInfoResponse, error) {
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,12 +19,14 @@ package server
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/provider/grpc/helper"
 	"github.com/huawei/csm/v2/provider/label"
+	"github.com/huawei/csm/v2/provider/utils"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -35,6 +37,10 @@ var createLabelValidator = helper.NewValidator[label.Validator](validateVolumeId
 // deleteLabelValidator verify the parameters when deleting labels, e.g. volumeId, labelName...
 var deleteLabelValidator = helper.NewValidator[label.Validator](validateVolumeId, validateLabelName, validateKind)
 
+// listLabelsValidator verify the parameters when listing labels, e.g. backendName, kind...
+var listLabelsValidator = helper.NewValidator[*cmi.ListLabelsRequest](validateListBackendName, validateListKind,
+	validateListVolumeId)
+
 // Label implement cmi.LabelServiceServer
 type Label struct{}
 
@@ -65,10 +71,135 @@ func (l *Label) DeleteLabel(ctx context.Context, request *cmi.DeleteLabelRequest
 	return service.DeleteLabel(ctx, request)
 }
 
+// CreateLabels create labels in storage in batch
+// The invalid labels will be failed in results, and the others will be created by label service
+func (l *Label) CreateLabels(ctx context.Context,
+	request *cmi.CreateLabelsRequest) (*cmi.CreateLabelsResponse, error) {
+	log.AddContext(ctx).Infof("Start to create labels, request: %v", request)
+
+	if err := validateBatchRequest(request.GetBackendName(), len(request.GetLabels())); err != nil {
+		return nil, err
+	}
+
+	results := make([]*cmi.LabelResult, len(request.GetLabels()))
+	validRequest := &cmi.CreateLabelsRequest{BackendName: request.GetBackendName()}
+	var validIndexes []int
+	for i, labelRequest := range request.GetLabels() {
+		validator := label.ConvertCreateRequest(labelRequest)
+		if err := validateBatchLabel(request.GetBackendName(), validator, createLabelValidator); err != nil {
+			results[i] = label.BuildLabelResult(validator, err)
+			continue
+		}
+		validIndexes = append(validIndexes, i)
+		validRequest.Labels = append(validRequest.Labels, labelRequest)
+	}
+
+	if len(validIndexes) != 0 {
+		service := label.GetLabelService()
+		response, err := service.CreateLabels(ctx, validRequest)
+		if err != nil {
+			return nil, err
+		}
+		if err = fillLabelResults(results, validIndexes, response.GetResults()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &cmi.CreateLabelsResponse{Results: results}, nil
+}
+
+// DeleteLabels delete labels in storage in batch
+// The invalid labels will be failed in results, and the others will be deleted by label service
+func (l *Label) DeleteLabels(ctx context.Context,
+	request *cmi.DeleteLabelsRequest) (*cmi.DeleteLabelsResponse, error) {
+	log.AddContext(ctx).Infof("Start to delete labels, request: %v", request)
+
+	if err := validateBatchRequest(request.GetBackendName(), len(request.GetLabels())); err != nil {
+		return nil, err
+	}
+
+	results := make([]*cmi.LabelResult, len(request.GetLabels()))
+	validRequest := &cmi.DeleteLabelsRequest{BackendName: request.GetBackendName()}
+	var validIndexes []int
+	for i, labelRequest := range request.GetLabels() {
+		validator := label.ConvertDeleteRequest(labelRequest)
+		if err := validateBatchLabel(request.GetBackendName(), validator, deleteLabelValidator); err != nil {
+			results[i] = label.BuildLabelResult(validator, err)
+			continue
+		}
+		validIndexes = append(validIndexes, i)
+		validRequest.Labels = append(validRequest.Labels, labelRequest)
+	}
+
+	if len(validIndexes) != 0 {
+		service := label.GetLabelService()
+		response, err := service.DeleteLabels(ctx, validRequest)
+		if err != nil {
+			return nil, err
+		}
+		if err = fillLabelResults(results, validIndexes, response.GetResults()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &cmi.DeleteLabelsResponse{Results: results}, nil
+}
+
+// ListLabels list labels in storage
+func (l *Label) ListLabels(ctx context.Context, request *cmi.ListLabelsRequest) (*cmi.ListLabelsResponse, error) {
+	log.AddContext(ctx).Infof("Start to list labels, request: %v", request)
+
+	if err := listLabelsValidator.Validate(request); err != nil {
+		return nil, err
+	}
+
+	service := label.GetLabelService()
+	return service.ListLabels(ctx, request)
+}
+
+// fillLabelResults fill the results of valid labels into the results of the whole batch
+func fillLabelResults(results []*cmi.LabelResult, validIndexes []int, validResults []*cmi.LabelResult) error {
+	if len(validIndexes) != len(validResults) {
+		return fmt.Errorf("the number of label results is [%d], but want [%d]", len(validResults),
+			len(validIndexes))
+	}
+
+	for i, result := range validResults {
+		results[validIndexes[i]] = result
+	}
+	return nil
+}
+
+// validateBatchRequest validate if the backend name is blank or the labels are empty
+func validateBatchRequest(backendName string, labelNumber int) error {
+	if backendName == "" {
+		return cmierror.New(cmierror.InvalidArgument, "backend name is blank")
+	}
+	if labelNumber == 0 {
+		return cmierror.New(cmierror.InvalidArgument, "labels are empty")
+	}
+	return nil
+}
+
+// validateBatchLabel validate a label in batch request, and the volume must belong to the backend of batch
+func validateBatchLabel(backendName string, request label.Validator,
+	validator *helper.Validator[label.Validator]) error {
+	if err := validator.Validate(request); err != nil {
+		return err
+	}
+
+	volumeBackendName, _ := utils.SplitVolumeId(request.VolumeId)
+	if volumeBackendName != utils.GetBackendName(backendName) {
+		return cmierror.New(cmierror.InvalidArgument, "volume [%s] does not belong to backend [%s]",
+			request.VolumeId, backendName)
+	}
+	return nil
+}
+
 // validateLabelName validate if the label name is blank
 func validateVolumeId(request label.Validator) error {
 	if request.VolumeId == "" {
-		return errors.New("illegalArgumentError volume id is blank")
+		return cmierror.New(cmierror.InvalidArgument, "volume id is blank")
 	}
 	return nil
 }
@@ -76,7 +207,7 @@ func validateVolumeId(request label.Validator) error {
 // validateLabelName validate if the label name is blank
 func validateLabelName(request label.Validator) error {
 	if request.LabelName == "" {
-		return errors.New("illegalArgumentError label name is blank")
+		return cmierror.New(cmierror.InvalidArgument, "label name is blank")
 	}
 	return nil
 }
@@ -84,11 +215,11 @@ func validateLabelName(request label.Validator) error {
 // validateLabelName validate if the label name is blank
 func validateKind(request label.Validator) error {
 	if request.Kind == "" {
-		return errors.New("illegalArgumentError kind is blank")
+		return cmierror.New(cmierror.InvalidArgument, "kind is blank")
 	}
 
 	if request.Kind != constants.PodKind && request.Kind != constants.PersistentVolumeKind {
-		return errors.New("illegalArgumentError unsupported kind")
+		return cmierror.New(cmierror.InvalidArgument, "unsupported kind")
 	}
 	return nil
 }
@@ -96,7 +227,41 @@ func validateKind(request label.Validator) error {
 // validateLabelName validate if the label name is blank
 func validateClusterName(request label.Validator) error {
 	if request.Kind == constants.PersistentVolumeKind && request.ClusterName == "" {
-		return errors.New("illegalArgumentError cluster name is blank")
+		return cmierror.New(cmierror.InvalidArgument, "cluster name is blank")
+	}
+	return nil
+}
+
+// validateListBackendName validate if the backend name is blank
+func validateListBackendName(request *cmi.ListLabelsRequest) error {
+	if request.GetBackendName() == "" {
+		return cmierror.New(cmierror.InvalidArgument, "backend name is blank")
+	}
+	return nil
+}
+
+// validateListKind validate if the kind is supported, blank kind means all kinds
+func validateListKind(request *cmi.ListLabelsRequest) error {
+	if request.GetKind() == "" {
+		return nil
+	}
+
+	if request.GetKind() != constants.PodKind && request.GetKind() != constants.PersistentVolumeKind {
+		return cmierror.New(cmierror.InvalidArgument, "unsupported kind")
+	}
+	return nil
+}
+
+// validateListVolumeId validate if the volume belongs to the backend
+func validateListVolumeId(request *cmi.ListLabelsRequest) error {
+	if request.GetVolumeId() == "" {
+		return nil
+	}
+
+	volumeBackendName, _ := utils.SplitVolumeId(request.GetVolumeId())
+	if volumeBackendName != utils.GetBackendName(request.GetBackendName()) {
+		return cmierror.New(cmierror.InvalidArgument, "volume [%s] does not belong to backend [%s]",
+			request.GetVolumeId(), request.GetBackendName())
 	}
 	return nil
 }
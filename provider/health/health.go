@@ -0,0 +1,143 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package health is a package that provides the grpc health status of cmi services
+package health
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	// IdentityService the full name of identity service
+	IdentityService = "cmi.v1.Identity"
+	// LabelService the full name of label service
+	LabelService = "cmi.v1.LabelService"
+	// CollectorService the full name of collector service
+	CollectorService = "cmi.v1.Collector"
+)
+
+// backendServices are the services which depend on the login state of backends
+var backendServices = []string{LabelService, CollectorService}
+
+var (
+	mutex        sync.Mutex
+	healthServer = newHealthServer()
+
+	// loginStates key is backend name, value is the result of the latest login
+	loginStates = map[string]LoginState{}
+)
+
+// LoginState is the result of the latest login of backend, or the latest call if the calls change the state
+type LoginState struct {
+	LoggedIn   bool
+	LastError  string
+	UpdateTime time.Time
+}
+
+func newHealthServer() *health.Server {
+	server := health.NewServer()
+	server.SetServingStatus(IdentityService, healthpb.HealthCheckResponse_SERVING)
+	for _, service := range backendServices {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	return server
+}
+
+// GetServer get the health server, which should be registered to the grpc server
+func GetServer() *health.Server {
+	return healthServer
+}
+
+// SetLoginState set the login state of backend, and update the status of the services depend on backends,
+// nil loginErr means the backend is logged in
+func SetLoginState(backendName string, loginErr error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	state := LoginState{LoggedIn: loginErr == nil, UpdateTime: time.Now()}
+	if loginErr != nil {
+		state.LastError = loginErr.Error()
+	}
+	loginStates[backendName] = state
+	updateBackendServicesStatus()
+}
+
+// SetCallState set the state of backend when the calls to storage become succeeded or failed, a call is
+// regarded as a login, so the later one of them takes precedence. The backends without login state are ignored,
+// e.g. the backend is removed while its client is still calling the storage
+func SetCallState(backendName string, available bool, lastError string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if _, ok := loginStates[backendName]; !ok {
+		return
+	}
+	state := LoginState{LoggedIn: available, UpdateTime: time.Now()}
+	if !available {
+		state.LastError = lastError
+	}
+	loginStates[backendName] = state
+	updateBackendServicesStatus()
+}
+
+// GetLoginStates get the login states of all backends, key is backend name
+func GetLoginStates() map[string]LoginState {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	states := make(map[string]LoginState, len(loginStates))
+	for name, state := range loginStates {
+		states[name] = state
+	}
+	return states
+}
+
+// RemoveBackend remove the login state of backend, e.g. when the backend is deleted
+func RemoveBackend(backendName string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if _, ok := loginStates[backendName]; !ok {
+		return
+	}
+	delete(loginStates, backendName)
+	updateBackendServicesStatus()
+}
+
+// updateBackendServicesStatus the services depend on backends are not serving
+// only when there are backends and none of them is logged in
+func updateBackendServicesStatus() {
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	if len(loginStates) != 0 {
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		for _, state := range loginStates {
+			if state.LoggedIn {
+				servingStatus = healthpb.HealthCheckResponse_SERVING
+				break
+			}
+		}
+	}
+
+	for _, service := range backendServices {
+		healthServer.SetServingStatus(service, servingStatus)
+	}
+	log.Debugf("update the status of services %v to %s", backendServices, servingStatus)
+}
@@ -0,0 +1,105 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package health is a package that provides the grpc health status of cmi services
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestSetLoginState(t *testing.T) {
+	// arrange
+	defer func() { loginStates = map[string]LoginState{} }()
+	tests := []struct {
+		name    string
+		action  func()
+		service string
+		want    healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "all backends are logged out", action: func() {
+			SetLoginState("backend-1", errors.New("login failed"))
+			SetLoginState("backend-2", errors.New("login failed"))
+		}, service: CollectorService, want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "identity does not depend on backends", action: func() {},
+			service: IdentityService, want: healthpb.HealthCheckResponse_SERVING},
+		{name: "one of the backends is logged in", action: func() { SetLoginState("backend-2", nil) },
+			service: LabelService, want: healthpb.HealthCheckResponse_SERVING},
+		{name: "logged in backend is removed", action: func() { RemoveBackend("backend-2") },
+			service: LabelService, want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "all backends are removed", action: func() { RemoveBackend("backend-1") },
+			service: CollectorService, want: healthpb.HealthCheckResponse_SERVING},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			tt.action()
+			got, err := GetServer().Check(context.Background(),
+				&healthpb.HealthCheckRequest{Service: tt.service})
+
+			// assert
+			if err != nil {
+				t.Errorf("Check() error = %v", err)
+				return
+			}
+			if got.GetStatus() != tt.want {
+				t.Errorf("Check() got = %v, want %v", got.GetStatus(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSetCallState(t *testing.T) {
+	// arrange
+	defer func() { loginStates = map[string]LoginState{} }()
+	SetLoginState("backend-1", nil)
+	tests := []struct {
+		name   string
+		action func()
+		want   healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "calls of logged in backend fail", action: func() {
+			SetCallState("backend-1", false, "call failed")
+		}, want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "calls of backend recover", action: func() { SetCallState("backend-1", true, "") },
+			want: healthpb.HealthCheckResponse_SERVING},
+		{name: "removed backend is ignored", action: func() {
+			RemoveBackend("backend-1")
+			SetCallState("backend-1", false, "call failed")
+		}, want: healthpb.HealthCheckResponse_SERVING},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			tt.action()
+			got, err := GetServer().Check(context.Background(),
+				&healthpb.HealthCheckRequest{Service: CollectorService})
+
+			// assert
+			if err != nil {
+				t.Errorf("Check() error = %v", err)
+				return
+			}
+			if got.GetStatus() != tt.want {
+				t.Errorf("Check() got = %v, want %v", got.GetStatus(), tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -21,12 +21,16 @@ import (
 	"context"
 	"errors"
 
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/provider/backend"
+	"github.com/huawei/csm/v2/provider/cmierror"
 	"github.com/huawei/csm/v2/provider/collect"
 	"github.com/huawei/csm/v2/provider/constants"
 	"github.com/huawei/csm/v2/provider/utils"
-	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	storageClient "github.com/huawei/csm/v2/storage/client"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -39,11 +43,49 @@ type Validator struct {
 	ClusterName string
 }
 
+// StorageLabelClient storage client which supports the label operations
+type StorageLabelClient interface {
+	CreatePvLabel(ctx context.Context, request storageClient.PvLabelRequest) (map[string]interface{}, error)
+	DeletePvLabel(ctx context.Context, resourceId, resourceType string) (map[string]interface{}, error)
+	CreatePodLabel(ctx context.Context, request storageClient.PodLabelRequest) (map[string]interface{}, error)
+	DeletePodLabel(ctx context.Context, request storageClient.PodLabelRequest) (map[string]interface{}, error)
+	GetPvLabels(ctx context.Context, start, end int) ([]map[string]interface{}, error)
+	GetPvLabelCount(ctx context.Context) (int, error)
+	GetPvLabelsByResource(ctx context.Context, resourceId, resourceType string) ([]map[string]interface{}, error)
+	GetPodLabels(ctx context.Context, start, end int) ([]map[string]interface{}, error)
+	GetPodLabelCount(ctx context.Context) (int, error)
+	GetPodLabelsByResource(ctx context.Context, resourceId, resourceType string) ([]map[string]interface{}, error)
+	GetFileSystemIdByName(ctx context.Context, name string) (string, error)
+	GetLunIdByName(ctx context.Context, name string) (string, error)
+}
+
 // OceanStorageLabelRequest operation ocean storage label
 type OceanStorageLabelRequest struct {
 	resourceId   string
 	resourceType string
-	client       *centralizedstorage.CentralizedClient
+	client       StorageLabelClient
+}
+
+// PvLabelObject pv label in storage
+type PvLabelObject struct {
+	ResourceId   string `json:"resourceId"`
+	ResourceType string `json:"resourceType"`
+	PvName       string `json:"pvName"`
+	ClusterName  string `json:"clusterName"`
+}
+
+// PodLabelObject pod label in storage
+type PodLabelObject struct {
+	ResourceId   string `json:"resourceId"`
+	ResourceType string `json:"resourceType"`
+	PodName      string `json:"podName"`
+	NameSpace    string `json:"nameSpace"`
+}
+
+// preparedLabelRequest cache the prepared result of a volume in batch request
+type preparedLabelRequest struct {
+	request OceanStorageLabelRequest
+	err     error
 }
 
 // ConvertCreateRequest convert CreateLabelRequest to LabelValidator
@@ -76,7 +118,7 @@ func PrepareLabelRequest(ctx context.Context, volumeId string) (OceanStorageLabe
 		return OceanStorageLabelRequest{}, err
 	}
 
-	client, ok := clientInfo.Client.(*centralizedstorage.CentralizedClient)
+	client, ok := clientInfo.Client.(StorageLabelClient)
 	if !ok {
 		return OceanStorageLabelRequest{}, errors.New("convert storage client failed")
 	}
@@ -91,8 +133,65 @@ func PrepareLabelRequest(ctx context.Context, volumeId string) (OceanStorageLabe
 	return OceanStorageLabelRequest{resourceId: resourceId, resourceType: resourceType, client: client}, nil
 }
 
+// PrepareListLabelRequest get client and resource object information for listing labels
+// If the volume id is specified, the resource will be queried by the volume name, otherwise the resource id in
+// request will be used, and an empty resource id means all labels in storage
+func PrepareListLabelRequest(ctx context.Context, request *cmi.ListLabelsRequest) (OceanStorageLabelRequest, error) {
+	if request.GetVolumeId() != "" {
+		return PrepareLabelRequest(ctx, request.GetVolumeId())
+	}
+
+	backendName := utils.GetBackendName(request.GetBackendName())
+	clientInfo, err := collect.GetClient(ctx, backendName, backend.GetClientByBackendName)
+	if err != nil {
+		log.AddContext(ctx).Errorf("list labels get client failed, error: %v", err)
+		return OceanStorageLabelRequest{}, err
+	}
+
+	client, ok := clientInfo.Client.(StorageLabelClient)
+	if !ok {
+		return OceanStorageLabelRequest{}, errors.New("convert storage client failed")
+	}
+
+	labelRequest := OceanStorageLabelRequest{client: client}
+	if request.GetResourceId() != "" {
+		labelRequest.resourceId = request.GetResourceId()
+		labelRequest.resourceType = getResourceType(clientInfo.VolumeType)
+	}
+	return labelRequest, nil
+}
+
+// prepareLabelRequestOnce prepare label request of the volume only once in batch request,
+// the prepared result will be saved in params, key is volume id
+func prepareLabelRequestOnce(ctx context.Context, volumeId string,
+	params map[string]preparedLabelRequest) (OceanStorageLabelRequest, error) {
+	if prepared, ok := params[volumeId]; ok {
+		return prepared.request, prepared.err
+	}
+
+	request, err := PrepareLabelRequest(ctx, volumeId)
+	params[volumeId] = preparedLabelRequest{request: request, err: err}
+	return request, err
+}
+
+// BuildLabelResult build the result of a label in batch request
+func BuildLabelResult(request Validator, err error) *cmi.LabelResult {
+	result := &cmi.LabelResult{
+		VolumeId:  request.VolumeId,
+		LabelName: request.LabelName,
+		Kind:      request.Kind,
+		Namespace: request.Namespace,
+		Success:   wrapperspb.Bool(err == nil),
+	}
+	if err != nil {
+		result.ErrorMessage = err.Error()
+		result.ErrorCode = int32(status.Code(cmierror.ToStatusError(err)))
+	}
+	return result
+}
+
 func getResourceId(ctx context.Context, volumeName, volumeType string,
-	client *centralizedstorage.CentralizedClient) (string, error) {
+	client StorageLabelClient) (string, error) {
 
 	if volumeType == constants.NasVolume {
 		return client.GetFileSystemIdByName(ctx, volumeName)
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,12 +19,13 @@ package label
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/provider/cmierror"
+	"github.com/huawei/csm/v2/provider/collect"
 	"github.com/huawei/csm/v2/provider/constants"
-	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
+	"github.com/huawei/csm/v2/provider/utils"
+	storageClient "github.com/huawei/csm/v2/storage/client"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -42,13 +43,25 @@ var deleteLabelFunctions = map[string]deleteLabelFunction{
 	constants.PodKind:              deletePodLabel,
 }
 
+// listLabelFunctions list label functions
+// including listing pod and pv labels
+var listLabelFunctions = map[string]listLabelFunction{
+	constants.PersistentVolumeKind: listPvLabels,
+	constants.PodKind:              listPodLabels,
+}
+
 // createLabelFunction create label function format
 type createLabelFunction func(ctx context.Context, resourceId, resourceType string,
-	client *centralizedstorage.CentralizedClient, request *cmi.CreateLabelRequest) (*cmi.CreateLabelResponse, error)
+	client StorageLabelClient, request *cmi.CreateLabelRequest) (*cmi.CreateLabelResponse, error)
 
 // deleteLabelFunction delete label function format
 type deleteLabelFunction func(ctx context.Context, resourceId, resourceType string,
-	client *centralizedstorage.CentralizedClient, request *cmi.DeleteLabelRequest) (*cmi.DeleteLabelResponse, error)
+	client StorageLabelClient, request *cmi.DeleteLabelRequest) (*cmi.DeleteLabelResponse, error)
+
+// listLabelFunction list label function format
+// if resourceId is empty, all labels of the kind in storage will be listed
+type listLabelFunction func(ctx context.Context, resourceId, resourceType string,
+	client StorageLabelClient) ([]*cmi.Label, error)
 
 // OceanStorageLabelService ocean storage label service
 type OceanStorageLabelService struct{}
@@ -63,10 +76,107 @@ func (o *OceanStorageLabelService) CreateLabel(ctx context.Context,
 		return nil, err
 	}
 
+	return createLabelWithParam(ctx, param, request)
+}
+
+// DeleteLabel delete label in ocean storage
+func (o *OceanStorageLabelService) DeleteLabel(ctx context.Context,
+	request *cmi.DeleteLabelRequest) (*cmi.DeleteLabelResponse, error) {
+
+	param, err := PrepareLabelRequest(ctx, request.GetVolumeId())
+	if err != nil {
+		log.AddContext(ctx).Errorf("delete label failed, volumeId: %s, error: %v", request.GetVolumeId(), err)
+		return nil, err
+	}
+
+	return deleteLabelWithParam(ctx, param, request)
+}
+
+// CreateLabels create labels in ocean storage in batch
+// The labels are created in the order of request, and the resource of each volume is queried only once
+func (o *OceanStorageLabelService) CreateLabels(ctx context.Context,
+	request *cmi.CreateLabelsRequest) (*cmi.CreateLabelsResponse, error) {
+	params := map[string]preparedLabelRequest{}
+	response := &cmi.CreateLabelsResponse{Results: make([]*cmi.LabelResult, 0, len(request.GetLabels()))}
+	for _, labelRequest := range request.GetLabels() {
+		param, err := prepareLabelRequestOnce(ctx, labelRequest.GetVolumeId(), params)
+		if err == nil {
+			_, err = createLabelWithParam(ctx, param, labelRequest)
+		}
+		if err != nil {
+			log.AddContext(ctx).Errorf("create label in batch failed, volumeId: %s, label: %s, error: %v",
+				labelRequest.GetVolumeId(), labelRequest.GetLabelName(), err)
+		}
+		response.Results = append(response.Results, BuildLabelResult(ConvertCreateRequest(labelRequest), err))
+	}
+	return response, nil
+}
+
+// DeleteLabels delete labels in ocean storage in batch
+// The labels are deleted in the order of request, and the resource of each volume is queried only once
+func (o *OceanStorageLabelService) DeleteLabels(ctx context.Context,
+	request *cmi.DeleteLabelsRequest) (*cmi.DeleteLabelsResponse, error) {
+	params := map[string]preparedLabelRequest{}
+	response := &cmi.DeleteLabelsResponse{Results: make([]*cmi.LabelResult, 0, len(request.GetLabels()))}
+	for _, labelRequest := range request.GetLabels() {
+		param, err := prepareLabelRequestOnce(ctx, labelRequest.GetVolumeId(), params)
+		if err == nil {
+			_, err = deleteLabelWithParam(ctx, param, labelRequest)
+		}
+		if err != nil {
+			log.AddContext(ctx).Errorf("delete label in batch failed, volumeId: %s, label: %s, error: %v",
+				labelRequest.GetVolumeId(), labelRequest.GetLabelName(), err)
+		}
+		response.Results = append(response.Results, BuildLabelResult(ConvertDeleteRequest(labelRequest), err))
+	}
+	return response, nil
+}
+
+// ListLabels list labels in ocean storage
+func (o *OceanStorageLabelService) ListLabels(ctx context.Context,
+	request *cmi.ListLabelsRequest) (*cmi.ListLabelsResponse, error) {
+
+	param, err := PrepareListLabelRequest(ctx, request)
+	if err != nil {
+		log.AddContext(ctx).Errorf("list labels failed, backend: %s, error: %v", request.GetBackendName(), err)
+		return nil, err
+	}
+
+	response := &cmi.ListLabelsResponse{Labels: []*cmi.Label{}}
+	if request.GetVolumeId() != "" && param.resourceId == "" {
+		log.AddContext(ctx).Infoln("not found resource id, perhaps the volume does not exist, " +
+			"so returning empty labels")
+		return response, nil
+	}
+
+	kinds := []string{constants.PersistentVolumeKind, constants.PodKind}
+	if request.GetKind() != "" {
+		kinds = []string{request.GetKind()}
+	}
+
+	for _, kind := range kinds {
+		fun, ok := listLabelFunctions[kind]
+		if !ok {
+			return nil, cmierror.New(cmierror.InvalidArgument, "unsupported resource kind [%s]", kind)
+		}
+
+		labels, err := fun(ctx, param.resourceId, param.resourceType, param.client)
+		if err != nil {
+			return nil, err
+		}
+		response.Labels = append(response.Labels, labels...)
+	}
+
+	return response, nil
+}
+
+// createLabelWithParam create label with the prepared client and resource information
+func createLabelWithParam(ctx context.Context, param OceanStorageLabelRequest,
+	request *cmi.CreateLabelRequest) (*cmi.CreateLabelResponse, error) {
 	if param.resourceId == "" {
 		log.AddContext(ctx).Errorln("not found resource id, perhaps the volume does not exist, " +
 			"so returning failed")
-		return nil, errors.New("not found resource id")
+		return nil, cmierror.New(cmierror.NotFound, "not found resource id of volume [%s]", request.GetVolumeId())
 	}
 
 	if request.GetNamespace() == "" {
@@ -75,22 +185,15 @@ func (o *OceanStorageLabelService) CreateLabel(ctx context.Context,
 
 	fun, ok := createLabelFunctions[request.Kind]
 	if !ok {
-		return nil, errors.New(fmt.Sprintf("illegalArgumentError unsupported resource kind [%s]", request.Kind))
+		return nil, cmierror.New(cmierror.InvalidArgument, "unsupported resource kind [%s]", request.Kind)
 	}
 
 	return fun(ctx, param.resourceId, param.resourceType, param.client, request)
 }
 
-// DeleteLabel delete label in ocean storage
-func (o *OceanStorageLabelService) DeleteLabel(ctx context.Context,
+// deleteLabelWithParam delete label with the prepared client and resource information
+func deleteLabelWithParam(ctx context.Context, param OceanStorageLabelRequest,
 	request *cmi.DeleteLabelRequest) (*cmi.DeleteLabelResponse, error) {
-
-	param, err := PrepareLabelRequest(ctx, request.GetVolumeId())
-	if err != nil {
-		log.AddContext(ctx).Errorf("delete label failed, volumeId: %s, error: %v", request.GetVolumeId(), err)
-		return nil, err
-	}
-
 	if param.resourceId == "" {
 		log.AddContext(ctx).Infoln("not found resource id, perhaps the volume does not exist, " +
 			"so returning success")
@@ -103,17 +206,17 @@ func (o *OceanStorageLabelService) DeleteLabel(ctx context.Context,
 
 	fun, ok := deleteLabelFunctions[request.Kind]
 	if !ok {
-		return nil, errors.New(fmt.Sprintf("illegalArgumentError unsupported resource kind [%s]", request.Kind))
+		return nil, cmierror.New(cmierror.InvalidArgument, "unsupported resource kind [%s]", request.Kind)
 	}
 
 	return fun(ctx, param.resourceId, param.resourceType, param.client, request)
 }
 
 // createPvLabel create pv label
-func createPvLabel(ctx context.Context, resourceId, resourceType string, client *centralizedstorage.CentralizedClient,
+func createPvLabel(ctx context.Context, resourceId, resourceType string, client StorageLabelClient,
 	request *cmi.CreateLabelRequest) (*cmi.CreateLabelResponse, error) {
 
-	var data = centralizedstorage.PvLabelRequest{
+	var data = storageClient.PvLabelRequest{
 		ResourceId:   resourceId,
 		ResourceType: resourceType,
 		PvName:       request.GetLabelName(),
@@ -128,10 +231,10 @@ func createPvLabel(ctx context.Context, resourceId, resourceType string, client
 }
 
 // createPodLabel create pod label
-func createPodLabel(ctx context.Context, resourceId, resourceType string, client *centralizedstorage.CentralizedClient,
+func createPodLabel(ctx context.Context, resourceId, resourceType string, client StorageLabelClient,
 	request *cmi.CreateLabelRequest) (*cmi.CreateLabelResponse, error) {
 
-	var data = centralizedstorage.PodLabelRequest{
+	var data = storageClient.PodLabelRequest{
 		ResourceId:   resourceId,
 		ResourceType: resourceType,
 		PodName:      request.GetLabelName(),
@@ -146,7 +249,7 @@ func createPodLabel(ctx context.Context, resourceId, resourceType string, client
 }
 
 // deletePvLabel delete pod label
-func deletePvLabel(ctx context.Context, resourceId, resourceType string, client *centralizedstorage.CentralizedClient,
+func deletePvLabel(ctx context.Context, resourceId, resourceType string, client StorageLabelClient,
 	request *cmi.DeleteLabelRequest) (*cmi.DeleteLabelResponse, error) {
 
 	_, err := client.DeletePvLabel(ctx, resourceId, resourceType)
@@ -158,10 +261,10 @@ func deletePvLabel(ctx context.Context, resourceId, resourceType string, client
 }
 
 // deletePodLabel delete pod label
-func deletePodLabel(ctx context.Context, resourceId, resourceType string, client *centralizedstorage.CentralizedClient,
+func deletePodLabel(ctx context.Context, resourceId, resourceType string, client StorageLabelClient,
 	request *cmi.DeleteLabelRequest) (*cmi.DeleteLabelResponse, error) {
 
-	var data = centralizedstorage.PodLabelRequest{
+	var data = storageClient.PodLabelRequest{
 		ResourceId:   resourceId,
 		ResourceType: resourceType,
 		PodName:      request.GetLabelName(),
@@ -174,3 +277,73 @@ func deletePodLabel(ctx context.Context, resourceId, resourceType string, client
 	}
 	return &cmi.DeleteLabelResponse{}, nil
 }
+
+// listPvLabels list pv labels
+func listPvLabels(ctx context.Context, resourceId, resourceType string,
+	client StorageLabelClient) ([]*cmi.Label, error) {
+
+	var data []map[string]interface{}
+	var err error
+	if resourceId != "" {
+		data, err = client.GetPvLabelsByResource(ctx, resourceId, resourceType)
+	} else {
+		data, err = collect.ConcurrentPaginate(collect.WithPageWorkers(ctx, client), client.GetPvLabelCount,
+			client.GetPvLabels)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("list pv labels failed, resourceId: %s, error: %v", resourceId, err)
+		return nil, err
+	}
+
+	objects, err := utils.MapToStructSlice[[]map[string]interface{}, PvLabelObject](data)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]*cmi.Label, 0, len(objects))
+	for _, object := range objects {
+		labels = append(labels, &cmi.Label{
+			Kind:         constants.PersistentVolumeKind,
+			ResourceId:   object.ResourceId,
+			ResourceType: object.ResourceType,
+			LabelName:    object.PvName,
+			ClusterName:  object.ClusterName,
+		})
+	}
+	return labels, nil
+}
+
+// listPodLabels list pod labels
+func listPodLabels(ctx context.Context, resourceId, resourceType string,
+	client StorageLabelClient) ([]*cmi.Label, error) {
+
+	var data []map[string]interface{}
+	var err error
+	if resourceId != "" {
+		data, err = client.GetPodLabelsByResource(ctx, resourceId, resourceType)
+	} else {
+		data, err = collect.ConcurrentPaginate(collect.WithPageWorkers(ctx, client), client.GetPodLabelCount,
+			client.GetPodLabels)
+	}
+	if err != nil {
+		log.AddContext(ctx).Errorf("list pod labels failed, resourceId: %s, error: %v", resourceId, err)
+		return nil, err
+	}
+
+	objects, err := utils.MapToStructSlice[[]map[string]interface{}, PodLabelObject](data)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]*cmi.Label, 0, len(objects))
+	for _, object := range objects {
+		labels = append(labels, &cmi.Label{
+			Kind:         constants.PodKind,
+			ResourceId:   object.ResourceId,
+			ResourceType: object.ResourceType,
+			LabelName:    object.PodName,
+			Namespace:    object.NameSpace,
+		})
+	}
+	return labels, nil
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,12 +19,15 @@ package label
 
 import (
 	"context"
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
 
 	"github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/provider/constants"
+	storageClient "github.com/huawei/csm/v2/storage/client"
 	"github.com/huawei/csm/v2/storage/client/centralizedstorage"
 )
 
@@ -36,7 +39,7 @@ func Test_CreatePvLabel_Success(t *testing.T) {
 
 	// mock
 	methodFunc := gomonkey.ApplyMethodFunc(client, "CreatePvLabel", func(context.Context,
-		centralizedstorage.PvLabelRequest) (map[string]interface{}, error) {
+		storageClient.PvLabelRequest) (map[string]interface{}, error) {
 		return map[string]interface{}{}, nil
 	})
 	defer methodFunc.Reset()
@@ -58,7 +61,7 @@ func Test_CreatePodLabel_Success(t *testing.T) {
 
 	// mock
 	methodFunc := gomonkey.ApplyMethodFunc(client, "CreatePodLabel", func(context.Context,
-		centralizedstorage.PodLabelRequest) (map[string]interface{}, error) {
+		storageClient.PodLabelRequest) (map[string]interface{}, error) {
 		return map[string]interface{}{}, nil
 	})
 	defer methodFunc.Reset()
@@ -102,7 +105,7 @@ func Test_DeletePodLabel_Success(t *testing.T) {
 
 	// mock
 	methodFunc := gomonkey.ApplyMethodFunc(client, "DeletePodLabel", func(context.Context,
-		centralizedstorage.PodLabelRequest) (map[string]interface{}, error) {
+		storageClient.PodLabelRequest) (map[string]interface{}, error) {
 		return map[string]interface{}{}, nil
 	})
 	defer methodFunc.Reset()
@@ -127,7 +130,7 @@ func Test_OceanStorageLabelService_CreateLabel_Success(t *testing.T) {
 			error) {
 			return OceanStorageLabelRequest{resourceId: "fakeResourceId"}, nil
 		}).
-		ApplyFunc(createPodLabel, func(context.Context, string, string, *centralizedstorage.CentralizedClient,
+		ApplyFunc(createPodLabel, func(context.Context, string, string, StorageLabelClient,
 			*cmi.CreateLabelRequest) (*cmi.CreateLabelResponse, error) {
 			return &cmi.CreateLabelResponse{}, nil
 		})
@@ -153,7 +156,7 @@ func Test_OceanStorageLabelService_DeleteLabel_Success(t *testing.T) {
 			error) {
 			return OceanStorageLabelRequest{}, nil
 		}).
-		ApplyFunc(deletePodLabel, func(context.Context, string, string, *centralizedstorage.CentralizedClient,
+		ApplyFunc(deletePodLabel, func(context.Context, string, string, StorageLabelClient,
 			*cmi.DeleteLabelRequest) (*cmi.DeleteLabelResponse, error) {
 			return &cmi.DeleteLabelResponse{}, nil
 		})
@@ -167,3 +170,95 @@ func Test_OceanStorageLabelService_DeleteLabel_Success(t *testing.T) {
 		t.Errorf("CreateLabel() error = %v", err)
 	}
 }
+
+func Test_OceanStorageLabelService_CreateLabels_PartialFailed(t *testing.T) {
+	// arrange
+	request := &cmi.CreateLabelsRequest{BackendName: "backend", Labels: []*cmi.CreateLabelRequest{
+		{VolumeId: "backend.pvc-1", LabelName: "pod-1", Kind: constants.PodKind},
+		{VolumeId: "backend.pvc-1", LabelName: "pod-2", Kind: constants.PodKind},
+		{VolumeId: "backend.pvc-2", LabelName: "pod-3", Kind: constants.PodKind},
+	}}
+	service := &OceanStorageLabelService{}
+	prepareTimes := 0
+
+	// mock
+	methodFunc := gomonkey.
+		ApplyFunc(PrepareLabelRequest, func(_ context.Context, volumeId string) (OceanStorageLabelRequest,
+			error) {
+			prepareTimes++
+			if volumeId == "backend.pvc-2" {
+				return OceanStorageLabelRequest{}, errors.New("get client failed")
+			}
+			return OceanStorageLabelRequest{resourceId: "fakeResourceId"}, nil
+		}).
+		ApplyFunc(createPodLabel, func(context.Context, string, string, StorageLabelClient,
+			*cmi.CreateLabelRequest) (*cmi.CreateLabelResponse, error) {
+			return &cmi.CreateLabelResponse{}, nil
+		})
+	defer methodFunc.Reset()
+
+	// action
+	response, err := service.CreateLabels(context.Background(), request)
+
+	// assert
+	if err != nil {
+		t.Errorf("CreateLabels() error = %v", err)
+		return
+	}
+	var got []bool
+	for _, result := range response.GetResults() {
+		got = append(got, result.GetSuccess().GetValue())
+	}
+	want := []bool{true, true, false}
+	if !reflect.DeepEqual(got, want) || prepareTimes != 2 {
+		t.Errorf("CreateLabels() failed, want = %v, got = %v, prepare times = %d", want, got, prepareTimes)
+	}
+	if response.GetResults()[2].GetErrorMessage() != "get client failed" {
+		t.Errorf("CreateLabels() failed, want error message = get client failed, got = %s",
+			response.GetResults()[2].GetErrorMessage())
+	}
+}
+
+func Test_OceanStorageLabelService_ListLabels_ByResource(t *testing.T) {
+	// arrange
+	client := &centralizedstorage.CentralizedClient{}
+	request := &cmi.ListLabelsRequest{BackendName: "backend", ResourceId: "1"}
+	want := []*cmi.Label{
+		{Kind: constants.PersistentVolumeKind, ResourceId: "1", ResourceType: "11", LabelName: "pv-1",
+			ClusterName: "cluster"},
+		{Kind: constants.PodKind, ResourceId: "1", ResourceType: "11", LabelName: "pod-1", Namespace: "default"},
+	}
+
+	// mock
+	methodFunc := gomonkey.
+		ApplyFunc(PrepareListLabelRequest, func(context.Context, *cmi.ListLabelsRequest) (OceanStorageLabelRequest,
+			error) {
+			return OceanStorageLabelRequest{resourceId: "1", resourceType: "11", client: client}, nil
+		}).
+		ApplyMethodFunc(client, "GetPvLabelsByResource", func(context.Context, string,
+			string) ([]map[string]interface{}, error) {
+			return []map[string]interface{}{
+				{"resourceId": "1", "resourceType": "11", "pvName": "pv-1", "clusterName": "cluster"},
+			}, nil
+		}).
+		ApplyMethodFunc(client, "GetPodLabelsByResource", func(context.Context, string,
+			string) ([]map[string]interface{}, error) {
+			return []map[string]interface{}{
+				{"resourceId": "1", "resourceType": "11", "podName": "pod-1", "nameSpace": "default"},
+			}, nil
+		})
+	defer methodFunc.Reset()
+
+	// action
+	service := &OceanStorageLabelService{}
+	got, err := service.ListLabels(context.Background(), request)
+
+	// assert
+	if err != nil {
+		t.Errorf("ListLabels() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got.GetLabels(), want) {
+		t.Errorf("ListLabels() got = %v, want %v", got.GetLabels(), want)
+	}
+}
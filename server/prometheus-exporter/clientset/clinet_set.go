@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -21,11 +21,13 @@ import (
 	"sync"
 
 	sbcXuanwuClient "github.com/Huawei/eSDK_K8S_Plugin/v4/pkg/client/clientset/versioned"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
 	clientConfig "github.com/huawei/csm/v2/config/client"
+	tlsConfig "github.com/huawei/csm/v2/config/tls"
 	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	"github.com/huawei/csm/v2/utils/log"
 )
@@ -38,6 +40,8 @@ type ClientsSet struct {
 	KubeClient *kubernetes.Clientset
 	// SbcClient get backend client
 	SbcClient *sbcXuanwuClient.Clientset
+	// DynamicClient get the Kubernetes resources without typed client, e.g. VolumeSnapshotContent
+	DynamicClient dynamic.Interface
 	// StorageGRPCClientSet From grpc get storage data client
 	StorageGRPCClientSet *storageGRPC.ClientSet
 	// InitError when init error this will set the reason
@@ -86,8 +90,16 @@ func initKubeClientAndSbcClient() {
 		exporterClientSet.InitError = err
 		return
 	}
+
+	dynamicClient, err := dynamic.NewForConfig(kubeConfig)
+	if err != nil {
+		log.Errorf("init dynamic client failed, err: [%v]", err)
+		exporterClientSet.InitError = err
+		return
+	}
 	exporterClientSet.KubeClient = kubeClient
 	exporterClientSet.SbcClient = sbcClient
+	exporterClientSet.DynamicClient = dynamicClient
 	return
 }
 
@@ -97,7 +109,7 @@ func InitExporterClientSet(grpcSock string) *ClientsSet {
 		log.Infoln("start to initExporterClientSet")
 		once.Do(func() {
 			exporterClientSet = &ClientsSet{}
-			grpcClientSet, err := storageGRPC.GetClientSet(grpcSock)
+			grpcClientSet, err := storageGRPC.GetClientSetWithTLS(grpcSock, tlsConfig.GetTLSOption())
 			if err != nil {
 				log.Errorln("can not get Client")
 				exporterClientSet.InitError = err
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -35,7 +35,8 @@ func TestInitExporterClientSet(t *testing.T) {
 
 	// mock
 	patches := gomonkey.
-		ApplyFunc(storageGRPC.GetClientSet, func(address string) (*ClientsSet, error) {
+		ApplyFunc(storageGRPC.GetClientSetWithTLS, func(address string,
+			tlsOption *storageGRPC.TLSOption) (*ClientsSet, error) {
 			return nil, nil
 		}).ApplyFunc(initKubeClientAndSbcClient, func() { return })
 	defer patches.Reset()
@@ -0,0 +1,100 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("alarm", NewAlarmCollector)
+}
+
+const (
+	alarmLevelKey      = "level"
+	alarmObjectTypeKey = "alarmObjType"
+)
+
+var alarmObjectMetricsLabelMap = map[string][]string{
+	"active_count": {"endpoint", "severity", "object_type", "object_id", "object"},
+}
+var alarmObjectMetricsHelpMap = map[string]string{
+	"active_count": "Number of active alarms by severity and alarm object",
+}
+
+var alarmObjectMetricsParseMap = map[string]parseRelation{
+	"active_count": {"count", parseStorageData},
+}
+var alarmObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":    {"backendName", parseStorageData},
+	"severity":    {"", parseAlarmLevel},
+	"object_type": {"", parseAlarmObjectType},
+	"object_id":   {"alarmObjId", parseStorageData},
+	"object":      {"collectorName", parseStorageData},
+}
+
+// AlarmCollector implements the prometheus.Collector interface and build storage active alarm info
+type AlarmCollector struct {
+	*BaseCollector
+}
+
+// NewAlarmCollector new an alarm collector, only object monitor type is supported
+func NewAlarmCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create alarm collector, the monitor type not in object")
+	}
+
+	return &AlarmCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("alarm").
+			SetMetricsHelpMap(alarmObjectMetricsHelpMap).
+			SetMetricsLabelMap(alarmObjectMetricsLabelMap).
+			SetLabelParseMap(alarmObjectLabelParseMap).
+			SetMetricsParseMap(alarmObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+func parseAlarmLevel(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	level, ok := StorageAlarmLevel[inData[alarmLevelKey]]
+	if !ok {
+		return inData[alarmLevelKey]
+	}
+	return level
+}
+
+func parseAlarmObjectType(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	objectType, ok := StorageObjectType[inData[alarmObjectTypeKey]]
+	if !ok {
+		return inData[alarmObjectTypeKey]
+	}
+	return objectType
+}
@@ -0,0 +1,90 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewAlarmCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &AlarmCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "alarm",
+			metricsHelpMap:   alarmObjectMetricsHelpMap,
+			metricsLabelMap:  alarmObjectMetricsLabelMap,
+			labelParseMap:    alarmObjectLabelParseMap,
+			metricsParseMap:  alarmObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewAlarmCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewAlarmCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewAlarmCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewAlarmCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewAlarmCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewAlarmCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func Test_parseAlarmLevelAndObjectType(t *testing.T) {
+	tests := []struct {
+		name           string
+		inData         map[string]string
+		wantLevel      string
+		wantObjectType string
+	}{
+		{name: "known level and object type", inData: map[string]string{"level": "4", "alarmObjType": "216"},
+			wantLevel: "critical", wantObjectType: "storagepool"},
+		{name: "unknown level and object type", inData: map[string]string{"level": "9", "alarmObjType": "999"},
+			wantLevel: "9", wantObjectType: "999"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			gotLevel := parseAlarmLevel("", "active_count", tt.inData)
+			gotObjectType := parseAlarmObjectType("", "active_count", tt.inData)
+
+			// assert
+			if gotLevel != tt.wantLevel || gotObjectType != tt.wantObjectType {
+				t.Errorf("parse alarm labels got = [%v, %v], want [%v, %v]", gotLevel, gotObjectType,
+					tt.wantLevel, tt.wantObjectType)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewBBUCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &BBUCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "bbu",
+			metricsHelpMap:   bbuObjectMetricsHelpMap,
+			metricsLabelMap:  bbuObjectMetricsLabelMap,
+			labelParseMap:    bbuObjectLabelParseMap,
+			metricsParseMap:  bbuObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewBBUCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewBBUCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewBBUCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewBBUCollector_GetPerformanceCollector(t *testing.T) {
+	// arrange
+	var mockMetricsIndicators []string
+
+	// action
+	_, err := NewBBUCollector("fake_backend", "performance", mockMetricsIndicators, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewBBUCollector() error = %v, wantErr %v", err, true)
+	}
+}
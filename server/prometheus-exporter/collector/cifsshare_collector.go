@@ -0,0 +1,74 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("cifsshare", NewCIFSShareCollector)
+}
+
+var cifsShareObjectMetricsLabelMap = map[string][]string{
+	"acl_count": {"endpoint", "id", "name", "share_path", "filesystem_id", "object"},
+}
+var cifsShareObjectMetricsHelpMap = map[string]string{
+	"acl_count": "Number of access control entries of the CIFS share",
+}
+
+var cifsShareObjectMetricsParseMap = map[string]parseRelation{
+	"acl_count": {"aclCount", parseStorageData},
+}
+var cifsShareObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":      {"backendName", parseStorageData},
+	"id":            {"ID", parseStorageData},
+	"name":          {"NAME", parseStorageData},
+	"share_path":    {"SHAREPATH", parseStorageData},
+	"filesystem_id": {"FSID", parseStorageData},
+	"object":        {"collectorName", parseStorageData},
+}
+
+// CIFSShareCollector implements the prometheus.Collector interface and build storage CIFSShare info
+type CIFSShareCollector struct {
+	*BaseCollector
+}
+
+// NewCIFSShareCollector new a cifs share collector, only object monitor type is supported
+func NewCIFSShareCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create cifs share collector, the monitor type not in object")
+	}
+
+	return &CIFSShareCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("cifsshare").
+			SetMetricsHelpMap(cifsShareObjectMetricsHelpMap).
+			SetMetricsLabelMap(cifsShareObjectMetricsLabelMap).
+			SetLabelParseMap(cifsShareObjectLabelParseMap).
+			SetMetricsParseMap(cifsShareObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
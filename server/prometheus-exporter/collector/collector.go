@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -93,8 +94,9 @@ func NewPerformanceBaseCollector(backendName, monitorType, collectorName string,
 		SetMetrics(make(map[string]*prometheus.Desc)), nil
 }
 
+// setPrometheusMetric send the metric parsed from detail data, the metric has the timestamp of the detail if set
 func (baseCollector *BaseCollector) setPrometheusMetric(ctx context.Context, ch chan<- prometheus.Metric,
-	metricsName string, detailData map[string]string) {
+	metricsName string, detailData map[string]string, timestamp int64) {
 	metricsParseRelation, ok := baseCollector.metricsParseMap[metricsName]
 	if !ok {
 		log.AddContext(ctx).Warningln("can not get the metricsParseRelation")
@@ -126,12 +128,16 @@ func (baseCollector *BaseCollector) setPrometheusMetric(ctx context.Context, ch
 		log.AddContext(ctx).Warningln("can not get the labelValueSlice")
 		return
 	}
-	ch <- prometheus.MustNewConstMetric(
+	metric := prometheus.MustNewConstMetric(
 		baseCollector.metrics[metricsName],
 		prometheus.GaugeValue,
 		metricsValueFloat,
 		labelValueSlice...,
 	)
+	if timestamp != 0 {
+		metric = prometheus.NewMetricWithTimestamp(time.Unix(timestamp, 0), metric)
+	}
+	ch <- metric
 }
 
 // Collect implements the prometheus.Collector interface.
@@ -157,7 +163,7 @@ func (baseCollector *BaseCollector) Collect(ch chan<- prometheus.Metric) {
 		detailData["backendName"] = collectorCacheData.BackendName
 		detailData["collectorName"] = collectorCacheData.CollectType
 		for metricsName := range baseCollector.metrics {
-			baseCollector.setPrometheusMetric(ctx, ch, metricsName, detailData)
+			baseCollector.setPrometheusMetric(ctx, ch, metricsName, detailData, storageCollectDetail.GetTimestamp())
 		}
 	}
 }
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -33,11 +33,21 @@ const (
 	precisionOfTwo            = 2
 	precisionOfSmallest       = -1
 	skipReportValue           = "skipReportValue"
-	storageTypeSan            = "oceanstor-san"
-	storageTypeNas            = "oceanstor-nas"
 	storageTypeKey            = "sbcStorageType"
 )
 
+// sanStorageTypes and nasStorageTypes the sbc storage types of pv backed by lun and filesystem
+var (
+	sanStorageTypes = map[string]struct{}{
+		"oceanstor-san":     {},
+		"fusionstorage-san": {},
+	}
+	nasStorageTypes = map[string]struct{}{
+		"oceanstor-nas":     {},
+		"fusionstorage-nas": {},
+	}
+)
+
 type metricsParseFunc func(inDataKey, metricsName string, inData map[string]string) string
 
 type parseRelation struct {
@@ -52,6 +62,16 @@ func parseStorageData(inDataKey, metricsName string, inData map[string]string) s
 	return inData[inDataKey]
 }
 
+// parseStorageDataOrSkip the metric will not be reported if the value is not a number,
+// e.g. the remaining life of HDD is not reported by storage
+func parseStorageDataOrSkip(inDataKey, metricsName string, inData map[string]string) string {
+	value := parseStorageData(inDataKey, metricsName, inData)
+	if _, err := strconv.ParseFloat(value, bitSize); err != nil {
+		return skipReportValue
+	}
+	return value
+}
+
 func parseStorageReturnZero(inDataKey, metricsName string, inData map[string]string) string {
 	return "0.0"
 }
@@ -80,6 +100,16 @@ func parseStorageSectorsToGB(inDataKey, metricsName string, inData map[string]st
 	return strconv.FormatFloat(sectorsData/sectorsTOGb, 'f', precisionOfSmallest, bitSize)
 }
 
+func isSanStorageType(storageType string) bool {
+	_, ok := sanStorageTypes[storageType]
+	return ok
+}
+
+func isNasStorageType(storageType string) bool {
+	_, ok := nasStorageTypes[storageType]
+	return ok
+}
+
 func parseLabelListToLabelValueSlice(labelKeys []string,
 	labelParseRelation map[string]parseRelation, metricsName string, inData map[string]string) []string {
 	var labelValueSlice []string
@@ -95,14 +125,3 @@ func parseLabelListToLabelValueSlice(labelKeys []string,
 	}
 	return labelValueSlice
 }
-
-func parseVstoreCapacityToGB(inDataKey, metricsName string, inData map[string]string) string {
-	if len(inData) == 0 {
-		return ""
-	}
-	sectorsData, err := strconv.ParseFloat(inData[inDataKey], bitSize)
-	if err != nil {
-		return ""
-	}
-	return strconv.FormatFloat(sectorsData/byteToGb, 'f', precisionOfSmallest, bitSize)
-}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -134,26 +134,3 @@ func Test_parseStorageSectorsToGB(t *testing.T) {
 		t.Errorf("parseStorageStatus() got2 = %v, want %v", got2, "100.00000047683716")
 	}
 }
-
-func Test_parseVstoreCapacityToGB(t *testing.T) {
-	// arrange
-	mockInDataKey := "fake_key"
-	mockInData := map[string]string{
-		"fake_key": "107374182400",
-	}
-	mockInData2 := map[string]string{
-		"fake_key": "107374182401",
-	}
-
-	// action
-	got := parseVstoreCapacityToGB(mockInDataKey, "", mockInData)
-	got2 := parseVstoreCapacityToGB(mockInDataKey, "", mockInData2)
-
-	// assert
-	if !reflect.DeepEqual(got, "100") {
-		t.Errorf("parseVstoreCapacityToGB() got = %v, want %v", got, "100")
-	}
-	if !reflect.DeepEqual(got2, "100.00000000093132") {
-		t.Errorf("parseVstoreCapacityToGB() got2 = %v, want %v", got2, "100.00000000093132")
-	}
-}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -227,6 +228,27 @@ func TestBaseCollector_Collect_ParseSuccess(t *testing.T) {
 	}
 }
 
+func TestBaseCollector_Collect_WithTimestamp(t *testing.T) {
+	// arrange
+	mockCollector := buildMockBaseCollector()
+	collectorCacheData := mockCollector.metricsDataCache.GetMetricsData("fake_collector_name")
+	collectorCacheData.Details[0].Timestamp = 1700000060
+	mockMetricChan := make(chan prometheus.Metric, 2)
+	wantPrometheusMetric := prometheus.NewMetricWithTimestamp(time.Unix(1700000060, 0),
+		prometheus.MustNewConstMetric(mockCollector.metrics["fake_key1"], prometheus.GaugeValue, 0.0,
+			"fake_backend_name", "fake_collector_name", "test_data"))
+
+	// action
+	mockCollector.Collect(mockMetricChan)
+	defer close(mockMetricChan)
+
+	// assert
+	got := <-mockMetricChan
+	if !reflect.DeepEqual(got, wantPrometheusMetric) {
+		t.Errorf("Collect() got = %v, want %v", got, wantPrometheusMetric)
+	}
+}
+
 func TestBaseCollector_SetBackendName(t *testing.T) {
 	// arrange
 	mockBackendName := "fake_backend_name"
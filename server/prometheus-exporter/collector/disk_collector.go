@@ -0,0 +1,120 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("disk", NewDiskCollector)
+}
+
+const (
+	diskSectorsKey    = "SECTORS"
+	diskSectorSizeKey = "SECTORSIZE"
+	diskTypeKey       = "DISKTYPE"
+)
+
+var diskObjectMetricsLabelMap = map[string][]string{
+	"capacity":       {"endpoint", "id", "location", "type", "disk_domain", "object"},
+	"temperature":    {"endpoint", "id", "location", "type", "disk_domain", "object"},
+	"remaining_life": {"endpoint", "id", "location", "type", "disk_domain", "object"},
+	"health_status":  {"endpoint", "id", "status", "location", "type", "disk_domain", "object"},
+	"running_status": {"endpoint", "id", "status", "location", "type", "disk_domain", "object"},
+}
+var diskObjectMetricsHelpMap = map[string]string{
+	"capacity":       "Disk capacity(GB)",
+	"temperature":    "Disk temperature(℃)",
+	"remaining_life": "Remaining life(%) of SSD",
+	"health_status":  "Health Status",
+	"running_status": "Running Status",
+}
+
+var diskObjectMetricsParseMap = map[string]parseRelation{
+	"capacity":       {"", parseDiskCapacity},
+	"temperature":    {"TEMPERATURE", parseStorageData},
+	"remaining_life": {"REMAINLIFE", parseStorageDataOrSkip},
+	"health_status":  {"HEALTHSTATUS", parseStorageData},
+	"running_status": {"RUNNINGSTATUS", parseStorageData},
+}
+var diskObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":    {"backendName", parseStorageData},
+	"id":          {"ID", parseStorageData},
+	"location":    {"LOCATION", parseStorageData},
+	"type":        {"", parseDiskType},
+	"disk_domain": {"diskDomainName", parseStorageData},
+	"status":      {"", parseStorageStatus},
+	"object":      {"collectorName", parseStorageData},
+}
+
+// DiskCollector implements the prometheus.Collector interface and build storage Disk info
+type DiskCollector struct {
+	*BaseCollector
+}
+
+// NewDiskCollector new a disk collector, only object monitor type is supported
+func NewDiskCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create disk collector, the monitor type not in object")
+	}
+
+	return &DiskCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("disk").
+			SetMetricsHelpMap(diskObjectMetricsHelpMap).
+			SetMetricsLabelMap(diskObjectMetricsLabelMap).
+			SetLabelParseMap(diskObjectLabelParseMap).
+			SetMetricsParseMap(diskObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+func parseDiskCapacity(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	sectors, err := strconv.ParseFloat(inData[diskSectorsKey], bitSize)
+	if err != nil {
+		return ""
+	}
+	sectorSize, err := strconv.ParseFloat(inData[diskSectorSizeKey], bitSize)
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatFloat(sectors*sectorSize/byteToGb, 'f', precisionOfTwo, bitSize)
+}
+
+func parseDiskType(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	diskType, ok := StorageDiskType[inData[diskTypeKey]]
+	if !ok {
+		return inData[diskTypeKey]
+	}
+	return diskType
+}
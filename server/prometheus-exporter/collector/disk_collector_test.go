@@ -0,0 +1,121 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewDiskCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &DiskCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "disk",
+			metricsHelpMap:   diskObjectMetricsHelpMap,
+			metricsLabelMap:  diskObjectMetricsLabelMap,
+			labelParseMap:    diskObjectLabelParseMap,
+			metricsParseMap:  diskObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewDiskCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewDiskCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewDiskCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewDiskCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewDiskCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewDiskCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func Test_parseDiskCapacity(t *testing.T) {
+	// arrange
+	mockInData := map[string]string{"SECTORS": "3750748848", "SECTORSIZE": "512"}
+
+	// action
+	got := parseDiskCapacity("", "capacity", mockInData)
+
+	// assert
+	if got != "1788.50" {
+		t.Errorf("parseDiskCapacity() got = %v, want %v", got, "1788.50")
+	}
+}
+
+func Test_parseDiskType(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "known disk type", inData: map[string]string{"DISKTYPE": "3"}, want: "SSD"},
+		{name: "unknown disk type", inData: map[string]string{"DISKTYPE": "99"}, want: "99"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseDiskType("", "capacity", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseDiskType() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseStorageDataOrSkip(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "number value", inData: map[string]string{"REMAINLIFE": "98"}, want: "98"},
+		{name: "empty value", inData: map[string]string{"REMAINLIFE": ""}, want: skipReportValue},
+		{name: "invalid value", inData: map[string]string{"REMAINLIFE": "--"}, want: skipReportValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseStorageDataOrSkip("REMAINLIFE", "remaining_life", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseStorageDataOrSkip() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
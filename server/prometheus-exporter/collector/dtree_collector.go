@@ -0,0 +1,88 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("dtree", NewDTreeCollector)
+}
+
+const (
+	quotaSwitchOn  = "1"
+	quotaSwitchOff = "0"
+)
+
+var dtreeObjectMetricsLabelMap = map[string][]string{
+	"quota_switch": {"endpoint", "id", "name", "filesystem_name", "object"},
+}
+var dtreeObjectMetricsHelpMap = map[string]string{
+	"quota_switch": "Whether the quota of dtree is enabled(1: enabled, 0: disabled)",
+}
+
+var dtreeObjectMetricsParseMap = map[string]parseRelation{
+	"quota_switch": {"QUOTASWITCH", parseDTreeQuotaSwitch},
+}
+var dtreeObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":        {"backendName", parseStorageData},
+	"id":              {"ID", parseStorageData},
+	"name":            {"NAME", parseStorageData},
+	"filesystem_name": {"PARENTNAME", parseStorageData},
+	"object":          {"collectorName", parseStorageData},
+}
+
+// DTreeCollector implements the prometheus.Collector interface and build storage DTree info
+type DTreeCollector struct {
+	*BaseCollector
+}
+
+// NewDTreeCollector new a dtree collector, only object monitor type is supported
+func NewDTreeCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create dtree collector, the monitor type not in object")
+	}
+
+	return &DTreeCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("dtree").
+			SetMetricsHelpMap(dtreeObjectMetricsHelpMap).
+			SetMetricsLabelMap(dtreeObjectMetricsLabelMap).
+			SetLabelParseMap(dtreeObjectLabelParseMap).
+			SetMetricsParseMap(dtreeObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+func parseDTreeQuotaSwitch(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	if inData[inDataKey] == "true" {
+		return quotaSwitchOn
+	}
+	return quotaSwitchOff
+}
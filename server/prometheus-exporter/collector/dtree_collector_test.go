@@ -0,0 +1,85 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewDTreeCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &DTreeCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "dtree",
+			metricsHelpMap:   dtreeObjectMetricsHelpMap,
+			metricsLabelMap:  dtreeObjectMetricsLabelMap,
+			labelParseMap:    dtreeObjectLabelParseMap,
+			metricsParseMap:  dtreeObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewDTreeCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewDTreeCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewDTreeCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewDTreeCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewDTreeCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewDTreeCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func Test_parseDTreeQuotaSwitch(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "quota enabled", inData: map[string]string{"QUOTASWITCH": "true"}, want: "1"},
+		{name: "quota disabled", inData: map[string]string{"QUOTASWITCH": "false"}, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseDTreeQuotaSwitch("QUOTASWITCH", "quota_switch", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseDTreeQuotaSwitch() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("enclosure", NewEnclosureCollector)
+}
+
+var enclosureObjectMetricsLabelMap = map[string][]string{
+	"temperature":    {"endpoint", "id", "name", "location", "object"},
+	"health_status":  {"endpoint", "id", "status", "name", "location", "object"},
+	"running_status": {"endpoint", "id", "status", "name", "location", "object"},
+}
+var enclosureObjectMetricsHelpMap = map[string]string{
+	"temperature":    "Enclosure temperature(℃)",
+	"health_status":  "Health Status",
+	"running_status": "Running Status",
+}
+
+var enclosureObjectMetricsParseMap = map[string]parseRelation{
+	"temperature":    {"TEMPERATURE", parseStorageDataOrSkip},
+	"health_status":  {"HEALTHSTATUS", parseStorageData},
+	"running_status": {"RUNNINGSTATUS", parseStorageData},
+}
+var enclosureObjectLabelParseMap = map[string]parseRelation{
+	"endpoint": {"backendName", parseStorageData},
+	"id":       {"ID", parseStorageData},
+	"name":     {"NAME", parseStorageData},
+	"location": {"LOCATION", parseStorageData},
+	"status":   {"", parseStorageStatus},
+	"object":   {"collectorName", parseStorageData},
+}
+
+// EnclosureCollector implements the prometheus.Collector interface and build storage Enclosure info
+type EnclosureCollector struct {
+	*BaseCollector
+}
+
+// NewEnclosureCollector new a enclosure collector, only object monitor type is supported
+func NewEnclosureCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create enclosure collector, the monitor type not in object")
+	}
+
+	return &EnclosureCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("enclosure").
+			SetMetricsHelpMap(enclosureObjectMetricsHelpMap).
+			SetMetricsLabelMap(enclosureObjectMetricsLabelMap).
+			SetLabelParseMap(enclosureObjectLabelParseMap).
+			SetMetricsParseMap(enclosureObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
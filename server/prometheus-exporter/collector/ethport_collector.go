@@ -0,0 +1,112 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("ethport", NewETHPortCollector)
+}
+
+const (
+	ethPortIPv4Key = "IPV4ADDR"
+	ethPortIPv6Key = "IPV6ADDR"
+)
+
+var ethPortObjectMetricsLabelMap = map[string][]string{
+	"speed":          {"endpoint", "id", "name", "location", "mac", "ip", "object"},
+	"mtu":            {"endpoint", "id", "name", "location", "mac", "ip", "object"},
+	"health_status":  {"endpoint", "id", "status", "name", "location", "mac", "ip", "object"},
+	"running_status": {"endpoint", "id", "status", "name", "location", "mac", "ip", "object"},
+}
+var ethPortObjectMetricsHelpMap = map[string]string{
+	"speed":          "Working rate(Mbit/s)",
+	"mtu":            "MTU(Byte)",
+	"health_status":  "Health Status",
+	"running_status": "Running Status, Link up or Link down",
+}
+
+var ethPortObjectMetricsParseMap = map[string]parseRelation{
+	"speed":          {"SPEED", parseStorageDataOrSkip},
+	"mtu":            {"MTU", parseStorageDataOrSkip},
+	"health_status":  {"HEALTHSTATUS", parseStorageData},
+	"running_status": {"RUNNINGSTATUS", parseStorageData},
+}
+var ethPortObjectLabelParseMap = map[string]parseRelation{
+	"endpoint": {"backendName", parseStorageData},
+	"id":       {"ID", parseStorageData},
+	"name":     {"NAME", parseStorageData},
+	"location": {"LOCATION", parseStorageData},
+	"mac":      {"MACADDRESS", parseStorageData},
+	"ip":       {"", parseETHPortIP},
+	"status":   {"", parseStorageStatus},
+	"object":   {"collectorName", parseStorageData},
+}
+
+// ETHPortCollector implements the prometheus.Collector interface and build storage ethernet port info,
+// the RoCE ports used by NVMe over RoCE are not collected, see constants.ETHPort
+type ETHPortCollector struct {
+	*BaseCollector
+}
+
+// NewETHPortCollector new an ethernet port collector, both object and performance monitor type are supported
+func NewETHPortCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType == "object" {
+		return &ETHPortCollector{
+			BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+				SetMonitorType(monitorType).
+				SetCollectorName("ethport").
+				SetMetricsHelpMap(ethPortObjectMetricsHelpMap).
+				SetMetricsLabelMap(ethPortObjectMetricsLabelMap).
+				SetLabelParseMap(ethPortObjectLabelParseMap).
+				SetMetricsParseMap(ethPortObjectMetricsParseMap).
+				SetMetricsDataCache(metricsDataCache).
+				SetMetrics(make(map[string]*prometheus.Desc)),
+		}, nil
+	} else if monitorType == "performance" {
+		performanceBaseCollector, err := NewPerformanceBaseCollector(
+			backendName, monitorType, "ethport", metricsIndicators, metricsDataCache)
+		if err != nil {
+			return nil, err
+		}
+		return &ETHPortCollector{
+			BaseCollector: performanceBaseCollector,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("can not create ethport collector, " +
+		"the monitor type not in object or performance")
+}
+
+// parseETHPortIP the IPv4 address is preferred, the IPv6 address is used if the port has no IPv4 address
+func parseETHPortIP(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	if inData[ethPortIPv4Key] != "" {
+		return inData[ethPortIPv4Key]
+	}
+	return inData[ethPortIPv6Key]
+}
@@ -0,0 +1,79 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewETHPortCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &ETHPortCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "ethport",
+			metricsHelpMap:   ethPortObjectMetricsHelpMap,
+			metricsLabelMap:  ethPortObjectMetricsLabelMap,
+			labelParseMap:    ethPortObjectLabelParseMap,
+			metricsParseMap:  ethPortObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewETHPortCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewETHPortCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewETHPortCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestParseETHPortIP(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "ipv4 preferred", inData: map[string]string{"IPV4ADDR": "192.168.1.1", "IPV6ADDR": "fe80::1"},
+			want: "192.168.1.1"},
+		{name: "fallback to ipv6", inData: map[string]string{"IPV4ADDR": "", "IPV6ADDR": "fe80::1"},
+			want: "fe80::1"},
+		{name: "empty data", inData: map[string]string{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseETHPortIP("", "", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseETHPortIP() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
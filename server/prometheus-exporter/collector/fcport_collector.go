@@ -0,0 +1,94 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("fcport", NewFCPortCollector)
+}
+
+var fcPortObjectMetricsLabelMap = map[string][]string{
+	"speed":          {"endpoint", "id", "name", "location", "wwn", "object"},
+	"max_speed":      {"endpoint", "id", "name", "location", "wwn", "object"},
+	"health_status":  {"endpoint", "id", "status", "name", "location", "wwn", "object"},
+	"running_status": {"endpoint", "id", "status", "name", "location", "wwn", "object"},
+}
+var fcPortObjectMetricsHelpMap = map[string]string{
+	"speed":          "Working rate(Mbit/s)",
+	"max_speed":      "Max rate(Mbit/s)",
+	"health_status":  "Health Status",
+	"running_status": "Running Status, Link up or Link down",
+}
+
+var fcPortObjectMetricsParseMap = map[string]parseRelation{
+	"speed":          {"RUNSPEED", parseStorageDataOrSkip},
+	"max_speed":      {"MAXSPEED", parseStorageDataOrSkip},
+	"health_status":  {"HEALTHSTATUS", parseStorageData},
+	"running_status": {"RUNNINGSTATUS", parseStorageData},
+}
+var fcPortObjectLabelParseMap = map[string]parseRelation{
+	"endpoint": {"backendName", parseStorageData},
+	"id":       {"ID", parseStorageData},
+	"name":     {"NAME", parseStorageData},
+	"location": {"LOCATION", parseStorageData},
+	"wwn":      {"WWN", parseStorageData},
+	"status":   {"", parseStorageStatus},
+	"object":   {"collectorName", parseStorageData},
+}
+
+// FCPortCollector implements the prometheus.Collector interface and build storage FC port info
+type FCPortCollector struct {
+	*BaseCollector
+}
+
+// NewFCPortCollector new a fc port collector, both object and performance monitor type are supported
+func NewFCPortCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType == "object" {
+		return &FCPortCollector{
+			BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+				SetMonitorType(monitorType).
+				SetCollectorName("fcport").
+				SetMetricsHelpMap(fcPortObjectMetricsHelpMap).
+				SetMetricsLabelMap(fcPortObjectMetricsLabelMap).
+				SetLabelParseMap(fcPortObjectLabelParseMap).
+				SetMetricsParseMap(fcPortObjectMetricsParseMap).
+				SetMetricsDataCache(metricsDataCache).
+				SetMetrics(make(map[string]*prometheus.Desc)),
+		}, nil
+	} else if monitorType == "performance" {
+		performanceBaseCollector, err := NewPerformanceBaseCollector(
+			backendName, monitorType, "fcport", metricsIndicators, metricsDataCache)
+		if err != nil {
+			return nil, err
+		}
+		return &FCPortCollector{
+			BaseCollector: performanceBaseCollector,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("can not create fcport collector, " +
+		"the monitor type not in object or performance")
+}
@@ -0,0 +1,93 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("host", NewHostCollector)
+}
+
+var hostObjectMetricsLabelMap = map[string][]string{
+	"mapped_lun_num":       {"endpoint", "id", "name", "object"},
+	"initiator_num":        {"endpoint", "id", "name", "object"},
+	"online_initiator_num": {"endpoint", "id", "name", "object"},
+	"health_status":        {"endpoint", "id", "status", "name", "object"},
+	"running_status":       {"endpoint", "id", "status", "name", "object"},
+	"basic_info": {"endpoint", "id", "name", "initiators", "online_initiators", "mapped_lun_ids",
+		"mapped_lun_names", "object"},
+}
+var hostObjectMetricsHelpMap = map[string]string{
+	"mapped_lun_num":       "Number of LUNs mapped to the host",
+	"initiator_num":        "Number of FC and iSCSI initiators of the host",
+	"online_initiator_num": "Number of online FC and iSCSI initiators of the host",
+	"health_status":        "Health Status",
+	"running_status":       "Running Status",
+	"basic_info":           "Initiators and mapped LUNs of the host",
+}
+
+var hostObjectMetricsParseMap = map[string]parseRelation{
+	"mapped_lun_num":       {"mappedLunNum", parseStorageData},
+	"initiator_num":        {"initiatorNum", parseStorageData},
+	"online_initiator_num": {"onlineInitiatorNum", parseStorageData},
+	"health_status":        {"HEALTHSTATUS", parseStorageData},
+	"running_status":       {"RUNNINGSTATUS", parseStorageData},
+	"basic_info":           {"", parseStorageReturnZero},
+}
+var hostObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":          {"backendName", parseStorageData},
+	"id":                {"ID", parseStorageData},
+	"name":              {"NAME", parseStorageData},
+	"status":            {"", parseStorageStatus},
+	"object":            {"collectorName", parseStorageData},
+	"initiators":        {"initiatorIds", parseStorageData},
+	"online_initiators": {"onlineInitiatorIds", parseStorageData},
+	"mapped_lun_ids":    {"mappedLunIds", parseStorageData},
+	"mapped_lun_names":  {"mappedLunNames", parseStorageData},
+}
+
+// HostCollector implements the prometheus.Collector interface and build storage Host info
+type HostCollector struct {
+	*BaseCollector
+}
+
+// NewHostCollector new a host collector, only object monitor type is supported
+func NewHostCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create host collector, the monitor type not in object")
+	}
+
+	return &HostCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("host").
+			SetMetricsHelpMap(hostObjectMetricsHelpMap).
+			SetMetricsLabelMap(hostObjectMetricsLabelMap).
+			SetLabelParseMap(hostObjectLabelParseMap).
+			SetMetricsParseMap(hostObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
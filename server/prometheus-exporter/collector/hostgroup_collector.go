@@ -0,0 +1,72 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("hostgroup", NewHostGroupCollector)
+}
+
+var hostGroupObjectMetricsLabelMap = map[string][]string{
+	"host_num": {"endpoint", "id", "name", "object"},
+}
+var hostGroupObjectMetricsHelpMap = map[string]string{
+	"host_num": "Number of hosts in the host group",
+}
+
+var hostGroupObjectMetricsParseMap = map[string]parseRelation{
+	"host_num": {"hostNum", parseStorageData},
+}
+var hostGroupObjectLabelParseMap = map[string]parseRelation{
+	"endpoint": {"backendName", parseStorageData},
+	"id":       {"ID", parseStorageData},
+	"name":     {"NAME", parseStorageData},
+	"object":   {"collectorName", parseStorageData},
+}
+
+// HostGroupCollector implements the prometheus.Collector interface and build storage HostGroup info
+type HostGroupCollector struct {
+	*BaseCollector
+}
+
+// NewHostGroupCollector new a host group collector, only object monitor type is supported
+func NewHostGroupCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create host group collector, the monitor type not in object")
+	}
+
+	return &HostGroupCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("hostgroup").
+			SetMetricsHelpMap(hostGroupObjectMetricsHelpMap).
+			SetMetricsLabelMap(hostGroupObjectMetricsLabelMap).
+			SetLabelParseMap(hostGroupObjectLabelParseMap).
+			SetMetricsParseMap(hostGroupObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
@@ -0,0 +1,77 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("hypermetrodomain", NewHyperMetroDomainCollector)
+}
+
+var hyperMetroDomainObjectMetricsLabelMap = map[string][]string{
+	"health_status":  {"endpoint", "id", "name", "domain_type", "status", "object"},
+	"running_status": {"endpoint", "id", "name", "domain_type", "status", "object"},
+}
+var hyperMetroDomainObjectMetricsHelpMap = map[string]string{
+	"health_status":  "Health Status",
+	"running_status": "Running Status",
+}
+
+var hyperMetroDomainObjectMetricsParseMap = map[string]parseRelation{
+	"health_status":  {"HEALTHSTATUS", parseStorageData},
+	"running_status": {"RUNNINGSTATUS", parseStorageData},
+}
+var hyperMetroDomainObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":    {"backendName", parseStorageData},
+	"id":          {"ID", parseStorageData},
+	"name":        {"NAME", parseStorageData},
+	"domain_type": {"domainType", parseStorageData},
+	"status":      {"", parseStorageStatus},
+	"object":      {"collectorName", parseStorageData},
+}
+
+// HyperMetroDomainCollector implements the prometheus.Collector interface and build storage HyperMetroDomain info
+type HyperMetroDomainCollector struct {
+	*BaseCollector
+}
+
+// NewHyperMetroDomainCollector new a hypermetro domain collector, only object monitor type is supported
+func NewHyperMetroDomainCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create hypermetro domain collector, the monitor type not in object")
+	}
+
+	return &HyperMetroDomainCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("hypermetrodomain").
+			SetMetricsHelpMap(hyperMetroDomainObjectMetricsHelpMap).
+			SetMetricsLabelMap(hyperMetroDomainObjectMetricsLabelMap).
+			SetLabelParseMap(hyperMetroDomainObjectLabelParseMap).
+			SetMetricsParseMap(hyperMetroDomainObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
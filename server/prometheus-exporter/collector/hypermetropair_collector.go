@@ -0,0 +1,102 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("hypermetropair", NewHyperMetroPairCollector)
+}
+
+const hyperMetroResourceTypeKey = "HCRESOURCETYPE"
+
+// the storage_volume_name label is the name of local lun or filesystem, which can be joined to the pv metrics
+var hyperMetroPairLabelSlice = []string{"endpoint", "id", "domain_name", "local_object_type", "storage_volume_id",
+	"storage_volume_name", "remote_object_name", "object"}
+var hyperMetroPairStatusLabelSlice = []string{"endpoint", "id", "status", "domain_name", "local_object_type",
+	"storage_volume_id", "storage_volume_name", "remote_object_name", "object"}
+
+var hyperMetroPairObjectMetricsLabelMap = map[string][]string{
+	"health_status":  hyperMetroPairStatusLabelSlice,
+	"running_status": hyperMetroPairStatusLabelSlice,
+	"sync_progress":  hyperMetroPairLabelSlice,
+}
+var hyperMetroPairObjectMetricsHelpMap = map[string]string{
+	"health_status":  "Health Status",
+	"running_status": "Running Status",
+	"sync_progress":  "Synchronization Progress(%)",
+}
+
+var hyperMetroPairObjectMetricsParseMap = map[string]parseRelation{
+	"health_status":  {"HEALTHSTATUS", parseStorageData},
+	"running_status": {"RUNNINGSTATUS", parseStorageData},
+	"sync_progress":  {"SYNCPROGRESS", parseStorageDataOrSkip},
+}
+var hyperMetroPairObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":            {"backendName", parseStorageData},
+	"id":                  {"ID", parseStorageData},
+	"status":              {"", parseStorageStatus},
+	"domain_name":         {"DOMAINNAME", parseStorageData},
+	"local_object_type":   {"", parseHyperMetroResourceType},
+	"storage_volume_id":   {"LOCALOBJID", parseStorageData},
+	"storage_volume_name": {"LOCALOBJNAME", parseStorageData},
+	"remote_object_name":  {"REMOTEOBJNAME", parseStorageData},
+	"object":              {"collectorName", parseStorageData},
+}
+
+// HyperMetroPairCollector implements the prometheus.Collector interface and build storage HyperMetroPair info
+type HyperMetroPairCollector struct {
+	*BaseCollector
+}
+
+// NewHyperMetroPairCollector new a hypermetro pair collector, only object monitor type is supported
+func NewHyperMetroPairCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create hypermetro pair collector, the monitor type not in object")
+	}
+
+	return &HyperMetroPairCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("hypermetropair").
+			SetMetricsHelpMap(hyperMetroPairObjectMetricsHelpMap).
+			SetMetricsLabelMap(hyperMetroPairObjectMetricsLabelMap).
+			SetLabelParseMap(hyperMetroPairObjectLabelParseMap).
+			SetMetricsParseMap(hyperMetroPairObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+func parseHyperMetroResourceType(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	resourceType, ok := StorageHyperMetroResourceType[inData[hyperMetroResourceTypeKey]]
+	if !ok {
+		return inData[hyperMetroResourceTypeKey]
+	}
+	return resourceType
+}
@@ -0,0 +1,86 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewHyperMetroPairCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &HyperMetroPairCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "hypermetropair",
+			metricsHelpMap:   hyperMetroPairObjectMetricsHelpMap,
+			metricsLabelMap:  hyperMetroPairObjectMetricsLabelMap,
+			labelParseMap:    hyperMetroPairObjectLabelParseMap,
+			metricsParseMap:  hyperMetroPairObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewHyperMetroPairCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewHyperMetroPairCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewHyperMetroPairCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewHyperMetroPairCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewHyperMetroPairCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewHyperMetroPairCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func Test_parseHyperMetroResourceType(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "lun pair", inData: map[string]string{"HCRESOURCETYPE": "1"}, want: "lun"},
+		{name: "filesystem pair", inData: map[string]string{"HCRESOURCETYPE": "2"}, want: "filesystem"},
+		{name: "unknown type", inData: map[string]string{"HCRESOURCETYPE": "9"}, want: "9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseHyperMetroResourceType("", "health_status", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseHyperMetroResourceType() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("mappingview", NewMappingViewCollector)
+}
+
+var mappingViewObjectMetricsLabelMap = map[string][]string{
+	"basic_info": {"endpoint", "id", "name", "host_group", "lun_group", "object"},
+}
+var mappingViewObjectMetricsHelpMap = map[string]string{
+	"basic_info": "Host groups and LUN groups of the mapping view",
+}
+
+var mappingViewObjectMetricsParseMap = map[string]parseRelation{
+	"basic_info": {"", parseStorageReturnZero},
+}
+var mappingViewObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":   {"backendName", parseStorageData},
+	"id":         {"ID", parseStorageData},
+	"name":       {"NAME", parseStorageData},
+	"host_group": {"hostGroupName", parseStorageData},
+	"lun_group":  {"lunGroupName", parseStorageData},
+	"object":     {"collectorName", parseStorageData},
+}
+
+// MappingViewCollector implements the prometheus.Collector interface and build storage MappingView info
+type MappingViewCollector struct {
+	*BaseCollector
+}
+
+// NewMappingViewCollector new a mapping view collector, only object monitor type is supported
+func NewMappingViewCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create mapping view collector, the monitor type not in object")
+	}
+
+	return &MappingViewCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("mappingview").
+			SetMetricsHelpMap(mappingViewObjectMetricsHelpMap).
+			SetMetricsLabelMap(mappingViewObjectMetricsLabelMap).
+			SetLabelParseMap(mappingViewObjectLabelParseMap).
+			SetMetricsParseMap(mappingViewObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
@@ -0,0 +1,63 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewMappingViewCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &MappingViewCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "mappingview",
+			metricsHelpMap:   mappingViewObjectMetricsHelpMap,
+			metricsLabelMap:  mappingViewObjectMetricsLabelMap,
+			labelParseMap:    mappingViewObjectLabelParseMap,
+			metricsParseMap:  mappingViewObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewMappingViewCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewMappingViewCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewMappingViewCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewMappingViewCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewMappingViewCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewMappingViewCollector() error = %v, wantErr %v", err, true)
+	}
+}
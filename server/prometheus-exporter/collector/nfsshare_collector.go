@@ -0,0 +1,73 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("nfsshare", NewNFSShareCollector)
+}
+
+var nfsShareObjectMetricsLabelMap = map[string][]string{
+	"client_count": {"endpoint", "id", "share_path", "filesystem_id", "object"},
+}
+var nfsShareObjectMetricsHelpMap = map[string]string{
+	"client_count": "Number of clients allowed to access the NFS share",
+}
+
+var nfsShareObjectMetricsParseMap = map[string]parseRelation{
+	"client_count": {"clientCount", parseStorageData},
+}
+var nfsShareObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":      {"backendName", parseStorageData},
+	"id":            {"ID", parseStorageData},
+	"share_path":    {"SHAREPATH", parseStorageData},
+	"filesystem_id": {"FSID", parseStorageData},
+	"object":        {"collectorName", parseStorageData},
+}
+
+// NFSShareCollector implements the prometheus.Collector interface and build storage NFSShare info
+type NFSShareCollector struct {
+	*BaseCollector
+}
+
+// NewNFSShareCollector new a nfs share collector, only object monitor type is supported
+func NewNFSShareCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create nfs share collector, the monitor type not in object")
+	}
+
+	return &NFSShareCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("nfsshare").
+			SetMetricsHelpMap(nfsShareObjectMetricsHelpMap).
+			SetMetricsLabelMap(nfsShareObjectMetricsLabelMap).
+			SetLabelParseMap(nfsShareObjectLabelParseMap).
+			SetMetricsParseMap(nfsShareObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
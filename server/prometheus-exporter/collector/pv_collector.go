@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -34,19 +34,38 @@ var pvBuildMap = map[string]collectorInitFunc{
 var pvLabelSlice = []string{"backend", "pv_name", "pvc_name", "object",
 	"storage_volume_type", "storage_volume_id", "storage_volume_name"}
 
+// pvQoSLabelSlice the labels of the SmartQoS limits applied to the pv
+var pvQoSLabelSlice = []string{"backend", "pv_name", "pvc_name", "object",
+	"storage_volume_type", "storage_volume_id", "storage_volume_name", "qos_policy"}
+
 var pvObjectMetricsLabelMap = map[string][]string{
-	"capacity":       pvLabelSlice,
-	"capacity_usage": pvLabelSlice,
+	"capacity":          pvLabelSlice,
+	"capacity_usage":    pvLabelSlice,
+	"qos_max_iops":      pvQoSLabelSlice,
+	"qos_min_iops":      pvQoSLabelSlice,
+	"qos_max_bandwidth": pvQoSLabelSlice,
+	"qos_min_bandwidth": pvQoSLabelSlice,
+	"qos_latency":       pvQoSLabelSlice,
 }
 
 var pvObjectMetricsHelpMap = map[string]string{
-	"capacity":       "Huawei Storage k8s PV Capacity(GB)",
-	"capacity_usage": "Huawei Storage k8s PV Capacity Usage(%)",
+	"capacity":          "Huawei Storage k8s PV Capacity(GB)",
+	"capacity_usage":    "Huawei Storage k8s PV Capacity Usage(%)",
+	"qos_max_iops":      "Max IOPS(IO/s) limit of the SmartQoS policy applied to the PV",
+	"qos_min_iops":      "Min IOPS(IO/s) guarantee of the SmartQoS policy applied to the PV",
+	"qos_max_bandwidth": "Max Bandwidth(MB/s) limit of the SmartQoS policy applied to the PV",
+	"qos_min_bandwidth": "Min Bandwidth(MB/s) guarantee of the SmartQoS policy applied to the PV",
+	"qos_latency":       "Latency(us) target of the SmartQoS policy applied to the PV",
 }
 
 var pvObjectMetricsParseMap = map[string]parseRelation{
-	"capacity":       {"CAPACITY", parseStorageSectorsToGB},
-	"capacity_usage": {"", parsePVCapacityUsage},
+	"capacity":          {"CAPACITY", parseStorageSectorsToGB},
+	"capacity_usage":    {"", parsePVCapacityUsage},
+	"qos_max_iops":      {"qosMaxIOPS", parseQoSLimit},
+	"qos_min_iops":      {"qosMinIOPS", parseQoSLimit},
+	"qos_max_bandwidth": {"qosMaxBandwidth", parseQoSLimit},
+	"qos_min_bandwidth": {"qosMinBandwidth", parseQoSLimit},
+	"qos_latency":       {"qosLatency", parseQoSLimit},
 }
 
 var pvTypePrometheusMetrics = map[string][]string{
@@ -88,6 +107,7 @@ var pvLabelParseMap = map[string]parseRelation{
 	"storage_volume_type": {"sbcStorageType", parseStorageData},
 	"storage_volume_id":   {"ID", parsePVStorageID},
 	"storage_volume_name": {"sameName", parseStorageData},
+	"qos_policy":          {"qosName", parseStorageData},
 	"object":              {"collectorName", parseStorageData},
 }
 
@@ -109,7 +129,7 @@ func parsePVFSData(inDataKey, metricsName string, inData map[string]string) stri
 		return ""
 	}
 
-	if pvType != storageTypeNas {
+	if !isNasStorageType(pvType) {
 		return skipReportValue
 	}
 
@@ -126,7 +146,7 @@ func parsePVLunData(inDataKey, metricsName string, inData map[string]string) str
 		return ""
 	}
 
-	if pvType != storageTypeSan {
+	if !isSanStorageType(pvType) {
 		return skipReportValue
 	}
 
@@ -157,10 +177,10 @@ func parsePVCapacityUsage(inDataKey, metricsName string, inData map[string]strin
 		return ""
 	}
 	var pvCapacityUsage string
-	if pvType == storageTypeSan {
+	if isSanStorageType(pvType) {
 		pvCapacityUsage = parseLunCapacityUsage(inDataKey, metricsName, inData)
 	}
-	if pvType == storageTypeNas {
+	if isNasStorageType(pvType) {
 		pvCapacityUsage = parseFilesystemCapacityUsage(inDataKey, metricsName, inData)
 	}
 	return pvCapacityUsage
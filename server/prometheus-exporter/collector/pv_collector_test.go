@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -76,3 +76,34 @@ func Test_parsePVCapacityUsageSan(t *testing.T) {
 		t.Errorf("parseStorageData() got = %v, want %v", got, want)
 	}
 }
+
+func Test_parsePVCapacityUsagePacificNas(t *testing.T) {
+	// arrange
+	mockInData := map[string]string{
+		"sbcStorageType":          "fusionstorage-nas",
+		"CAPACITY":                "100",
+		"allocatedPoolQuota":      "20",
+		"SNAPSHOTRESERVECAPACITY": "0",
+	}
+
+	// action
+	got := parsePVCapacityUsage("", "", mockInData)
+
+	// assert
+	if want := "20"; got != want {
+		t.Errorf("parsePVCapacityUsage() got = %v, want %v", got, want)
+	}
+}
+
+func Test_parsePVLunDataPacificSan(t *testing.T) {
+	// arrange
+	mockInData := map[string]string{"sbcStorageType": "fusionstorage-san", "22": "1000"}
+
+	// action
+	got := parsePVLunData("22", "lun_pv_lun_total_iops", mockInData)
+
+	// assert
+	if want := "1000"; got != want {
+		t.Errorf("parsePVLunData() got = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("qos", NewQoSCollector)
+}
+
+const (
+	qosEnabled        = "1"
+	qosDisabled       = "0"
+	qosIdsSeparator   = ","
+	qosEnableValue    = "true"
+	qosLunIdsKey      = "lunIds"
+	qosFileSystemsKey = "filesystemIds"
+)
+
+var qosLabelSlice = []string{"endpoint", "id", "name", "object"}
+
+var qosObjectMetricsLabelMap = map[string][]string{
+	"max_iops":         qosLabelSlice,
+	"min_iops":         qosLabelSlice,
+	"max_bandwidth":    qosLabelSlice,
+	"min_bandwidth":    qosLabelSlice,
+	"latency":          qosLabelSlice,
+	"enable_status":    qosLabelSlice,
+	"lun_count":        qosLabelSlice,
+	"filesystem_count": qosLabelSlice,
+}
+var qosObjectMetricsHelpMap = map[string]string{
+	"max_iops":         "Max IOPS(IO/s) limit of SmartQoS policy",
+	"min_iops":         "Min IOPS(IO/s) guarantee of SmartQoS policy",
+	"max_bandwidth":    "Max Bandwidth(MB/s) limit of SmartQoS policy",
+	"min_bandwidth":    "Min Bandwidth(MB/s) guarantee of SmartQoS policy",
+	"latency":          "Latency(us) target of SmartQoS policy",
+	"enable_status":    "Whether the SmartQoS policy is enabled(1: enabled, 0: disabled)",
+	"lun_count":        "Number of luns associated with the SmartQoS policy",
+	"filesystem_count": "Number of filesystems associated with the SmartQoS policy",
+}
+
+var qosObjectMetricsParseMap = map[string]parseRelation{
+	"max_iops":         {"MAXIOPS", parseQoSLimit},
+	"min_iops":         {"MINIOPS", parseQoSLimit},
+	"max_bandwidth":    {"MAXBANDWIDTH", parseQoSLimit},
+	"min_bandwidth":    {"MINBANDWIDTH", parseQoSLimit},
+	"latency":          {"LATENCY", parseQoSLimit},
+	"enable_status":    {"ENABLESTATUS", parseQoSEnableStatus},
+	"lun_count":        {qosLunIdsKey, parseQoSAssociatedCount},
+	"filesystem_count": {qosFileSystemsKey, parseQoSAssociatedCount},
+}
+var qosObjectLabelParseMap = map[string]parseRelation{
+	"endpoint": {"backendName", parseStorageData},
+	"id":       {"ID", parseStorageData},
+	"name":     {"NAME", parseStorageData},
+	"object":   {"collectorName", parseStorageData},
+}
+
+// QoSCollector implements the prometheus.Collector interface and build storage SmartQoS policy info
+type QoSCollector struct {
+	*BaseCollector
+}
+
+// NewQoSCollector new a qos collector, only object monitor type is supported
+func NewQoSCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create qos collector, the monitor type not in object")
+	}
+
+	return &QoSCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("qos").
+			SetMetricsHelpMap(qosObjectMetricsHelpMap).
+			SetMetricsLabelMap(qosObjectMetricsLabelMap).
+			SetLabelParseMap(qosObjectLabelParseMap).
+			SetMetricsParseMap(qosObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+// parseQoSLimit the limit will not be reported if it is not set in the policy
+func parseQoSLimit(inDataKey, metricsName string, inData map[string]string) string {
+	value := parseStorageDataOrSkip(inDataKey, metricsName, inData)
+	if value == "" || value == skipReportValue {
+		return value
+	}
+	limit, err := strconv.ParseFloat(value, bitSize)
+	if err != nil || limit <= 0 {
+		return skipReportValue
+	}
+	return value
+}
+
+func parseQoSEnableStatus(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	if inData[inDataKey] == qosEnableValue {
+		return qosEnabled
+	}
+	return qosDisabled
+}
+
+func parseQoSAssociatedCount(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	if inData[inDataKey] == "" {
+		return "0"
+	}
+	return strconv.Itoa(len(strings.Split(inData[inDataKey], qosIdsSeparator)))
+}
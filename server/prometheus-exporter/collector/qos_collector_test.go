@@ -0,0 +1,108 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewQoSCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &QoSCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "qos",
+			metricsHelpMap:   qosObjectMetricsHelpMap,
+			metricsLabelMap:  qosObjectMetricsLabelMap,
+			labelParseMap:    qosObjectLabelParseMap,
+			metricsParseMap:  qosObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewQoSCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewQoSCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewQoSCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewQoSCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewQoSCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewQoSCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func Test_parseQoSLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "limit is set", inData: map[string]string{"MAXIOPS": "1000"}, want: "1000"},
+		{name: "limit is zero", inData: map[string]string{"MAXIOPS": "0"}, want: skipReportValue},
+		{name: "limit is not set", inData: map[string]string{"NAME": "qos-1"}, want: skipReportValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseQoSLimit("MAXIOPS", "max_iops", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseQoSLimit() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseQoSAssociatedCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "associated with luns", inData: map[string]string{"lunIds": "1,2,3"}, want: "3"},
+		{name: "no associated lun", inData: map[string]string{"lunIds": ""}, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseQoSAssociatedCount("lunIds", "lun_count", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseQoSAssociatedCount() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("quota", NewQuotaCollector)
+}
+
+const (
+	// quotaUnlimited is the value of quota limit when the limit is not set
+	quotaUnlimited = "18446744073709551615"
+
+	quotaTypeKey       = "QUOTATYPE"
+	quotaParentTypeKey = "PARENTTYPE"
+	spaceHardQuotaKey  = "SPACEHARDQUOTA"
+	spaceUsedKey       = "SPACEUSED"
+	fileHardQuotaKey   = "FILEHARDQUOTA"
+	fileUsedKey        = "FILEUSED"
+)
+
+// the storage_volume_name label is the name of the dtree or filesystem which the quota belongs to,
+// which can be joined to the pv metrics
+var quotaLabelSlice = []string{"endpoint", "id", "quota_type", "parent_type", "storage_volume_name",
+	"filesystem_name", "object"}
+
+var quotaObjectMetricsLabelMap = map[string][]string{
+	"space_hard_quota": quotaLabelSlice,
+	"space_soft_quota": quotaLabelSlice,
+	"space_used":       quotaLabelSlice,
+	"space_usage":      quotaLabelSlice,
+	"file_hard_quota":  quotaLabelSlice,
+	"file_soft_quota":  quotaLabelSlice,
+	"file_used":        quotaLabelSlice,
+	"file_usage":       quotaLabelSlice,
+}
+var quotaObjectMetricsHelpMap = map[string]string{
+	"space_hard_quota": "Space Hard Quota(GB)",
+	"space_soft_quota": "Space Soft Quota(GB)",
+	"space_used":       "Used Space(GB)",
+	"space_usage":      "Space Usage of Hard Quota(%)",
+	"file_hard_quota":  "File Hard Quota",
+	"file_soft_quota":  "File Soft Quota",
+	"file_used":        "Used File Count",
+	"file_usage":       "File Usage of Hard Quota(%)",
+}
+
+var quotaObjectMetricsParseMap = map[string]parseRelation{
+	"space_hard_quota": {"SPACEHARDQUOTA", parseQuotaBytesToGB},
+	"space_soft_quota": {"SPACESOFTQUOTA", parseQuotaBytesToGB},
+	"space_used":       {"SPACEUSED", parseQuotaBytesToGB},
+	"space_usage":      {"", parseQuotaSpaceUsage},
+	"file_hard_quota":  {"FILEHARDQUOTA", parseQuotaLimit},
+	"file_soft_quota":  {"FILESOFTQUOTA", parseQuotaLimit},
+	"file_used":        {"FILEUSED", parseStorageDataOrSkip},
+	"file_usage":       {"", parseQuotaFileUsage},
+}
+var quotaObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":            {"backendName", parseStorageData},
+	"id":                  {"ID", parseStorageData},
+	"quota_type":          {"", parseQuotaType},
+	"parent_type":         {"", parseQuotaParentType},
+	"storage_volume_name": {"parentName", parseStorageData},
+	"filesystem_name":     {"filesystemName", parseStorageData},
+	"object":              {"collectorName", parseStorageData},
+}
+
+// QuotaCollector implements the prometheus.Collector interface and build storage Quota info
+type QuotaCollector struct {
+	*BaseCollector
+}
+
+// NewQuotaCollector new a quota collector, only object monitor type is supported
+func NewQuotaCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create quota collector, the monitor type not in object")
+	}
+
+	return &QuotaCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("quota").
+			SetMetricsHelpMap(quotaObjectMetricsHelpMap).
+			SetMetricsLabelMap(quotaObjectMetricsLabelMap).
+			SetLabelParseMap(quotaObjectLabelParseMap).
+			SetMetricsParseMap(quotaObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+// parseQuotaLimit the metric will not be reported if the limit is not set
+func parseQuotaLimit(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	if inData[inDataKey] == quotaUnlimited {
+		return skipReportValue
+	}
+	return parseStorageDataOrSkip(inDataKey, metricsName, inData)
+}
+
+func parseQuotaBytesToGB(inDataKey, metricsName string, inData map[string]string) string {
+	limit := parseQuotaLimit(inDataKey, metricsName, inData)
+	if limit == "" || limit == skipReportValue {
+		return limit
+	}
+	bytes, err := strconv.ParseFloat(limit, bitSize)
+	if err != nil {
+		return skipReportValue
+	}
+	return strconv.FormatFloat(bytes/byteToGb, 'f', precisionOfTwo, bitSize)
+}
+
+func parseQuotaSpaceUsage(inDataKey, metricsName string, inData map[string]string) string {
+	return parseQuotaUsage(spaceUsedKey, spaceHardQuotaKey, inData)
+}
+
+func parseQuotaFileUsage(inDataKey, metricsName string, inData map[string]string) string {
+	return parseQuotaUsage(fileUsedKey, fileHardQuotaKey, inData)
+}
+
+// parseQuotaUsage the usage is not reported if the hard quota is not set
+func parseQuotaUsage(usedKey, hardQuotaKey string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	if inData[hardQuotaKey] == quotaUnlimited {
+		return skipReportValue
+	}
+	hardQuota, err := strconv.ParseFloat(inData[hardQuotaKey], bitSize)
+	if err != nil || hardQuota == 0 {
+		return skipReportValue
+	}
+	used, err := strconv.ParseFloat(inData[usedKey], bitSize)
+	if err != nil {
+		return skipReportValue
+	}
+	return strconv.FormatFloat(used/hardQuota*calculatePercentage, 'f', precisionOfTwo, bitSize)
+}
+
+func parseQuotaType(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	quotaType, ok := StorageQuotaType[inData[quotaTypeKey]]
+	if !ok {
+		return inData[quotaTypeKey]
+	}
+	return quotaType
+}
+
+func parseQuotaParentType(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	parentType, ok := StorageQuotaParentType[inData[quotaParentTypeKey]]
+	if !ok {
+		return inData[quotaParentTypeKey]
+	}
+	return parentType
+}
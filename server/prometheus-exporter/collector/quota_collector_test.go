@@ -0,0 +1,133 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewQuotaCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &QuotaCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "quota",
+			metricsHelpMap:   quotaObjectMetricsHelpMap,
+			metricsLabelMap:  quotaObjectMetricsLabelMap,
+			labelParseMap:    quotaObjectLabelParseMap,
+			metricsParseMap:  quotaObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewQuotaCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewQuotaCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewQuotaCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewQuotaCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewQuotaCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewQuotaCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func Test_parseQuotaBytesToGB(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "limited quota", inData: map[string]string{"SPACEHARDQUOTA": "2147483648"}, want: "2.00"},
+		{name: "unlimited quota", inData: map[string]string{"SPACEHARDQUOTA": "18446744073709551615"}, want: skipReportValue},
+		{name: "invalid quota", inData: map[string]string{"SPACEHARDQUOTA": ""}, want: skipReportValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseQuotaBytesToGB("SPACEHARDQUOTA", "space_hard_quota", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseQuotaBytesToGB() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseQuotaSpaceUsage(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "limited quota", inData: map[string]string{"SPACEHARDQUOTA": "2147483648",
+			"SPACEUSED": "536870912"}, want: "25.00"},
+		{name: "unlimited quota", inData: map[string]string{"SPACEHARDQUOTA": "18446744073709551615",
+			"SPACEUSED": "536870912"}, want: skipReportValue},
+		{name: "zero quota", inData: map[string]string{"SPACEHARDQUOTA": "0", "SPACEUSED": "0"}, want: skipReportValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseQuotaSpaceUsage("", "space_usage", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseQuotaSpaceUsage() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseQuotaParentType(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "dtree quota", inData: map[string]string{"PARENTTYPE": "16445"}, want: "dtree"},
+		{name: "filesystem quota", inData: map[string]string{"PARENTTYPE": "40"}, want: "filesystem"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseQuotaParentType("", "space_used", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseQuotaParentType() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("replicationpair", NewReplicationPairCollector)
+}
+
+const (
+	replicationResourceTypeKey = "LOCALRESTYPE"
+	replicationModelKey        = "REPLICATIONMODEL"
+)
+
+// the storage_volume_name label is the name of local lun or filesystem, which can be joined to the pv metrics
+var replicationPairLabelSlice = []string{"endpoint", "id", "replication_mode", "local_object_type",
+	"storage_volume_id", "storage_volume_name", "remote_object_name", "remote_device_name", "object"}
+var replicationPairStatusLabelSlice = []string{"endpoint", "id", "status", "replication_mode", "local_object_type",
+	"storage_volume_id", "storage_volume_name", "remote_object_name", "remote_device_name", "object"}
+
+var replicationPairObjectMetricsLabelMap = map[string][]string{
+	"health_status":  replicationPairStatusLabelSlice,
+	"running_status": replicationPairStatusLabelSlice,
+	"sync_progress":  replicationPairLabelSlice,
+	"sync_lag":       replicationPairLabelSlice,
+	"sync_interval":  replicationPairLabelSlice,
+}
+var replicationPairObjectMetricsHelpMap = map[string]string{
+	"health_status":  "Health Status",
+	"running_status": "Running Status",
+	"sync_progress":  "Synchronization Progress(%)",
+	"sync_lag":       "Seconds since the latest synchronization of asynchronous replication ended(s)",
+	"sync_interval":  "Synchronization Interval of asynchronous replication(s)",
+}
+
+var replicationPairObjectMetricsParseMap = map[string]parseRelation{
+	"health_status":  {"HEALTHSTATUS", parseStorageData},
+	"running_status": {"RUNNINGSTATUS", parseStorageData},
+	"sync_progress":  {"REPLICATIONPROGRESS", parseStorageDataOrSkip},
+	"sync_lag":       {"syncLag", parseStorageDataOrSkip},
+	"sync_interval":  {"TIMINGVAL", parseStorageDataOrSkip},
+}
+var replicationPairObjectLabelParseMap = map[string]parseRelation{
+	"endpoint":            {"backendName", parseStorageData},
+	"id":                  {"ID", parseStorageData},
+	"status":              {"", parseStorageStatus},
+	"replication_mode":    {"", parseReplicationMode},
+	"local_object_type":   {"", parseReplicationResourceType},
+	"storage_volume_id":   {"LOCALRESID", parseStorageData},
+	"storage_volume_name": {"LOCALRESNAME", parseStorageData},
+	"remote_object_name":  {"REMOTERESNAME", parseStorageData},
+	"remote_device_name":  {"REMOTEDEVICENAME", parseStorageData},
+	"object":              {"collectorName", parseStorageData},
+}
+
+// ReplicationPairCollector implements the prometheus.Collector interface and build storage ReplicationPair info
+type ReplicationPairCollector struct {
+	*BaseCollector
+}
+
+// NewReplicationPairCollector new a remote replication pair collector, only object monitor type is supported
+func NewReplicationPairCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create replication pair collector, the monitor type not in object")
+	}
+
+	return &ReplicationPairCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("replicationpair").
+			SetMetricsHelpMap(replicationPairObjectMetricsHelpMap).
+			SetMetricsLabelMap(replicationPairObjectMetricsLabelMap).
+			SetLabelParseMap(replicationPairObjectLabelParseMap).
+			SetMetricsParseMap(replicationPairObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+func parseReplicationResourceType(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	resourceType, ok := StorageReplicationResourceType[inData[replicationResourceTypeKey]]
+	if !ok {
+		return inData[replicationResourceTypeKey]
+	}
+	return resourceType
+}
+
+func parseReplicationMode(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	mode, ok := StorageReplicationMode[inData[replicationModelKey]]
+	if !ok {
+		return inData[replicationModelKey]
+	}
+	return mode
+}
@@ -0,0 +1,109 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewReplicationPairCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &ReplicationPairCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "replicationpair",
+			metricsHelpMap:   replicationPairObjectMetricsHelpMap,
+			metricsLabelMap:  replicationPairObjectMetricsLabelMap,
+			labelParseMap:    replicationPairObjectLabelParseMap,
+			metricsParseMap:  replicationPairObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewReplicationPairCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewReplicationPairCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewReplicationPairCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewReplicationPairCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewReplicationPairCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewReplicationPairCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func Test_parseReplicationResourceType(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "lun pair", inData: map[string]string{"LOCALRESTYPE": "11"}, want: "lun"},
+		{name: "filesystem pair", inData: map[string]string{"LOCALRESTYPE": "40"}, want: "filesystem"},
+		{name: "unknown type", inData: map[string]string{"LOCALRESTYPE": "9"}, want: "9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseReplicationResourceType("", "health_status", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseReplicationResourceType() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseReplicationMode(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "synchronous replication", inData: map[string]string{"REPLICATIONMODEL": "1"}, want: "synchronous"},
+		{name: "asynchronous replication", inData: map[string]string{"REPLICATIONMODEL": "2"}, want: "asynchronous"},
+		{name: "unknown mode", inData: map[string]string{"REPLICATIONMODEL": "9"}, want: "9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseReplicationMode("", "health_status", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseReplicationMode() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
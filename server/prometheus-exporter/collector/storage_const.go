@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -110,6 +110,83 @@ var StorageProductMode = map[string]string{
 	"832": "OceanStor Dorado 18800K V6",
 }
 
+// StorageHyperMetroResourceType The local object type name map for hypermetro pair
+var StorageHyperMetroResourceType = map[string]string{
+	"1": "lun",
+	"2": "filesystem",
+}
+
+// StorageReplicationResourceType The local object type name map for remote replication pair
+var StorageReplicationResourceType = map[string]string{
+	"11": "lun",
+	"40": "filesystem",
+}
+
+// StorageReplicationMode The replication mode name map for remote replication pair
+var StorageReplicationMode = map[string]string{
+	"1": "synchronous",
+	"2": "asynchronous",
+}
+
+// StorageQuotaType The quota type name map for storage
+var StorageQuotaType = map[string]string{
+	"1": "directory",
+	"2": "user",
+	"3": "user group",
+}
+
+// StorageQuotaParentType The quota parent type name map for storage
+var StorageQuotaParentType = map[string]string{
+	"40":    "filesystem",
+	"16445": "dtree",
+}
+
+// StorageAlarmLevel The alarm severity name map for storage
+var StorageAlarmLevel = map[string]string{
+	"1": "info",
+	"2": "warning",
+	"3": "major",
+	"4": "critical",
+}
+
+// StorageObjectType The object type name map for storage, e.g. the object type of alarm
+var StorageObjectType = map[string]string{
+	"10":  "disk",
+	"11":  "lun",
+	"14":  "hostgroup",
+	"21":  "host",
+	"40":  "filesystem",
+	"201": "system",
+	"206": "enclosure",
+	"207": "controller",
+	"212": "fcport",
+	"213": "ethport",
+	"216": "storagepool",
+	"245": "mappingview",
+}
+
+// StorageDiskType The Disk Type name map for storage
+var StorageDiskType = map[string]string{
+	"0":  "FC",
+	"1":  "SAS",
+	"2":  "SATA",
+	"3":  "SSD",
+	"4":  "NL-SAS",
+	"5":  "SLC SSD",
+	"6":  "MLC SSD",
+	"7":  "FC SED",
+	"8":  "SAS SED",
+	"9":  "SATA SED",
+	"10": "SSD SED",
+	"11": "NL-SAS SED",
+	"12": "SLC SSD SED",
+	"13": "MLC SSD SED",
+	"14": "NVMe SSD",
+	"16": "NVMe SSD SED",
+	"17": "SCM",
+	"18": "SCM SED",
+}
+
 // StorageHealthStatus The Health Status name map for storage
 var StorageHealthStatus = map[string]string{
 	"0":  "--",
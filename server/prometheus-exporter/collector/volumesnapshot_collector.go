@@ -0,0 +1,111 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package collector includes all huawei storage collectors to gather and export huawei storage metrics.
+package collector
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metricsCache "github.com/huawei/csm/v2/server/prometheus-exporter/metricscache"
+)
+
+func init() {
+	RegisterCollector("volumesnapshot", NewVolumeSnapshotCollector)
+}
+
+var volumeSnapshotLabelSlice = []string{"backend", "namespace", "volumesnapshot_name", "volumesnapshotcontent_name",
+	"object", "storage_snapshot_type", "storage_snapshot_id", "storage_snapshot_name", "storage_parent_name"}
+
+var volumeSnapshotStatusLabelSlice = append([]string{"status"}, volumeSnapshotLabelSlice...)
+
+var volumeSnapshotObjectMetricsLabelMap = map[string][]string{
+	"consumed_capacity": volumeSnapshotLabelSlice,
+	"restore_size":      volumeSnapshotLabelSlice,
+	"creation_time":     volumeSnapshotLabelSlice,
+	"rollback_rate":     volumeSnapshotLabelSlice,
+	"running_status":    volumeSnapshotStatusLabelSlice,
+}
+
+var volumeSnapshotObjectMetricsHelpMap = map[string]string{
+	"consumed_capacity": "Huawei Storage k8s VolumeSnapshot Consumed Capacity(GB)",
+	"restore_size":      "Huawei Storage k8s VolumeSnapshot Restore Size(GB)",
+	"creation_time":     "Huawei Storage k8s VolumeSnapshot Creation Time(unix seconds)",
+	"rollback_rate":     "Huawei Storage k8s VolumeSnapshot Rollback Progress(%)",
+	"running_status":    "Huawei Storage k8s VolumeSnapshot Running Status, Restore means rolling back",
+}
+
+var volumeSnapshotObjectMetricsParseMap = map[string]parseRelation{
+	"consumed_capacity": {"CONSUMEDCAPACITY", parseStorageSectorsToGB},
+	"restore_size":      {"restoreSize", parseVolumeSnapshotBytesToGB},
+	"creation_time":     {"TIMESTAMP", parseStorageDataOrSkip},
+	"rollback_rate":     {"ROLLBACKRATE", parseStorageDataOrSkip},
+	"running_status":    {"RUNNINGSTATUS", parseStorageData},
+}
+
+var volumeSnapshotLabelParseMap = map[string]parseRelation{
+	"backend":                    {"sbcName", parseStorageData},
+	"namespace":                  {"volumeSnapshotNamespace", parseStorageData},
+	"volumesnapshot_name":        {"volumeSnapshotName", parseStorageData},
+	"volumesnapshotcontent_name": {"volumeSnapshotContentName", parseStorageData},
+	"storage_snapshot_type":      {"sbcStorageType", parseStorageData},
+	"storage_snapshot_id":        {"ID", parseStorageData},
+	"storage_snapshot_name":      {"NAME", parseStorageData},
+	"storage_parent_name":        {"PARENTNAME", parseStorageData},
+	"status":                     {"", parseStorageStatus},
+	"object":                     {"collectorName", parseStorageData},
+}
+
+// VolumeSnapshotCollector implements the prometheus.Collector interface and build k8s VolumeSnapshot info,
+// which is merged by VolumeSnapshotContent and the lun or filesystem snapshot in storage
+type VolumeSnapshotCollector struct {
+	*BaseCollector
+}
+
+// NewVolumeSnapshotCollector new a volume snapshot collector, only object monitor type is supported
+func NewVolumeSnapshotCollector(backendName, monitorType string, metricsIndicators []string,
+	metricsDataCache *metricsCache.MetricsDataCache) (prometheus.Collector, error) {
+	if monitorType != "object" {
+		return nil, fmt.Errorf("can not create volumesnapshot collector, the monitor type not in object")
+	}
+
+	return &VolumeSnapshotCollector{
+		BaseCollector: (&BaseCollector{}).SetBackendName(backendName).
+			SetMonitorType(monitorType).
+			SetCollectorName("volumesnapshot").
+			SetMetricsHelpMap(volumeSnapshotObjectMetricsHelpMap).
+			SetMetricsLabelMap(volumeSnapshotObjectMetricsLabelMap).
+			SetLabelParseMap(volumeSnapshotLabelParseMap).
+			SetMetricsParseMap(volumeSnapshotObjectMetricsParseMap).
+			SetMetricsDataCache(metricsDataCache).
+			SetMetrics(make(map[string]*prometheus.Desc)),
+	}, nil
+}
+
+// parseVolumeSnapshotBytesToGB the restore size of VolumeSnapshotContent is in bytes
+func parseVolumeSnapshotBytesToGB(inDataKey, metricsName string, inData map[string]string) string {
+	if len(inData) == 0 {
+		return ""
+	}
+	bytes, err := strconv.ParseFloat(inData[inDataKey], bitSize)
+	if err != nil {
+		return skipReportValue
+	}
+	return strconv.FormatFloat(bytes/byteToGb, 'f', precisionOfTwo, bitSize)
+}
@@ -0,0 +1,87 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewVolumeSnapshotCollector_GetObjectCollector(t *testing.T) {
+	// arrange
+	var wantCollector = &VolumeSnapshotCollector{
+		BaseCollector: &BaseCollector{
+			backendName:      "fake_backend",
+			monitorType:      "object",
+			collectorName:    "volumesnapshot",
+			metricsHelpMap:   volumeSnapshotObjectMetricsHelpMap,
+			metricsLabelMap:  volumeSnapshotObjectMetricsLabelMap,
+			labelParseMap:    volumeSnapshotLabelParseMap,
+			metricsParseMap:  volumeSnapshotObjectMetricsParseMap,
+			metricsDataCache: nil,
+			metrics:          make(map[string]*prometheus.Desc),
+		},
+	}
+
+	// action
+	got, err := NewVolumeSnapshotCollector("fake_backend", "object", []string{""}, nil)
+
+	// assert
+	if err != nil {
+		t.Errorf("NewVolumeSnapshotCollector() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, wantCollector) {
+		t.Errorf("NewVolumeSnapshotCollector() got = %v, want %v", got, wantCollector)
+	}
+}
+
+func TestNewVolumeSnapshotCollector_GetPerformanceCollector(t *testing.T) {
+	// action
+	_, err := NewVolumeSnapshotCollector("fake_backend", "performance", []string{"22"}, nil)
+
+	// assert
+	if err == nil {
+		t.Errorf("NewVolumeSnapshotCollector() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestParseVolumeSnapshotBytesToGB(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "restore size is reported", inData: map[string]string{"restoreSize": "1610612736"}, want: "1.50"},
+		{name: "restore size is not reported", inData: map[string]string{"ID": "1"}, want: skipReportValue},
+		{name: "empty data", inData: map[string]string{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			got := parseVolumeSnapshotBytesToGB("restoreSize", "restore_size", tt.inData)
+
+			// assert
+			if got != tt.want {
+				t.Errorf("parseVolumeSnapshotBytesToGB() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -31,40 +31,84 @@ func init() {
 }
 
 const (
+	vstoreSanCapacityType = "san"
+	vstoreNasCapacityType = "nas"
+
+	vstorePoolNameKey      = "PoolName"
 	vstoreTotalCapacityKey = "TotalCapacity"
 	vstoreUsedCapacityKey  = "UsedCapacity"
 )
 
-var vstoreLabelSlice = []string{"name", "endpoint", "id", "object", "pool"}
+// vstoreQuotaKeys the keys of capacity quota and free capacity quota of each capacity type
+var vstoreQuotaKeys = map[string][2]string{
+	vstoreSanCapacityType: {"sanCapacityQuota", "sanFreeCapacityQuota"},
+	vstoreNasCapacityType: {"nasCapacityQuota", "nasFreeCapacityQuota"},
+}
+
+// the backend label is the names of backends using the vstore, which are joined by comma
+var vstoreLabelSlice = []string{"endpoint", "id", "name", "backend", "object"}
+
+// Deprecated: the pool level metrics are got from the StorageBackendContent and will be removed in later version,
+// use the san and nas capacity metrics instead
+var vstorePoolLabelSlice = []string{"name", "endpoint", "id", "object", "pool"}
 
 var vstorePrometheusDescName = map[string]string{
 	"object": "vstore",
 }
 
 var vstoreObjectMetricsLabelMap = map[string][]string{
-	"total_capacity": vstoreLabelSlice,
-	"free_capacity":  vstoreLabelSlice,
-	"used_capacity":  vstoreLabelSlice,
-	"capacity_usage": vstoreLabelSlice,
+	"san_capacity_quota": vstoreLabelSlice,
+	"san_used_capacity":  vstoreLabelSlice,
+	"san_capacity_usage": vstoreLabelSlice,
+	"nas_capacity_quota": vstoreLabelSlice,
+	"nas_used_capacity":  vstoreLabelSlice,
+	"nas_capacity_usage": vstoreLabelSlice,
+	"lun_count":          vstoreLabelSlice,
+	"filesystem_count":   vstoreLabelSlice,
+	"running_status":     {"endpoint", "id", "name", "backend", "status", "object"},
+	"total_capacity":     vstorePoolLabelSlice,
+	"free_capacity":      vstorePoolLabelSlice,
+	"used_capacity":      vstorePoolLabelSlice,
+	"capacity_usage":     vstorePoolLabelSlice,
 }
 var vstoreObjectMetricsHelpMap = map[string]string{
-	"total_capacity": "vstore capacity(GB)",
-	"free_capacity":  "vstore free capacity(GB)",
-	"used_capacity":  "vstore used capacity(GB)",
-	"capacity_usage": "vstore capacity usage(%)",
+	"san_capacity_quota": "vstore san capacity quota(GB)",
+	"san_used_capacity":  "vstore san used capacity(GB)",
+	"san_capacity_usage": "vstore san capacity usage(%)",
+	"nas_capacity_quota": "vstore nas capacity quota(GB)",
+	"nas_used_capacity":  "vstore nas used capacity(GB)",
+	"nas_capacity_usage": "vstore nas capacity usage(%)",
+	"lun_count":          "vstore lun count",
+	"filesystem_count":   "vstore filesystem count",
+	"running_status":     "Running Status",
+	"total_capacity":     "vstore capacity(GB), deprecated",
+	"free_capacity":      "vstore free capacity(GB), deprecated",
+	"used_capacity":      "vstore used capacity(GB), deprecated",
+	"capacity_usage":     "vstore capacity usage(%), deprecated",
 }
 var vstoreObjectMetricsParseMap = map[string]parseRelation{
-	"total_capacity": {"TotalCapacity", parseVstoreCapacityToGB},
-	"free_capacity":  {"FreeCapacity", parseVstoreCapacityToGB},
-	"used_capacity":  {"UsedCapacity", parseVstoreCapacityToGB},
-	"capacity_usage": {"", parseVstoreCapacityUsage},
+	"san_capacity_quota": {"sanCapacityQuota", parseVstoreQuotaToGB},
+	"san_used_capacity":  {vstoreSanCapacityType, parseVstoreUsedCapacity},
+	"san_capacity_usage": {vstoreSanCapacityType, parseVstoreCapacityUsage},
+	"nas_capacity_quota": {"nasCapacityQuota", parseVstoreQuotaToGB},
+	"nas_used_capacity":  {vstoreNasCapacityType, parseVstoreUsedCapacity},
+	"nas_capacity_usage": {vstoreNasCapacityType, parseVstoreCapacityUsage},
+	"lun_count":          {"lunCount", parseStorageDataOrSkip},
+	"filesystem_count":   {"filesystemCount", parseStorageDataOrSkip},
+	"running_status":     {"RUNNINGSTATUS", parseStorageDataOrSkip},
+	"total_capacity":     {vstoreTotalCapacityKey, parseVstorePoolCapacityToGB},
+	"free_capacity":      {"FreeCapacity", parseVstorePoolCapacityToGB},
+	"used_capacity":      {vstoreUsedCapacityKey, parseVstorePoolCapacityToGB},
+	"capacity_usage":     {"", parseVstorePoolCapacityUsage},
 }
 var vstoreObjectLabelParseMap = map[string]parseRelation{
-	"endpoint": {"BackendName", parseStorageData},
-	"id":       {"VStoreID", parseStorageData},
-	"name":     {"VStoreName", parseStorageData},
+	"endpoint": {"BackendName", parseVstoreEndpoint},
+	"id":       {"ID", parseStorageData},
+	"name":     {"NAME", parseStorageData},
+	"backend":  {"sbcName", parseStorageData},
+	"status":   {"", parseStorageStatus},
 	"object":   {"collectorName", parseStorageData},
-	"pool":     {"PoolName", parseStorageData},
+	"pool":     {vstorePoolNameKey, parseStorageData},
 }
 
 // VstoreCollector implements the prometheus.Collector interface and build storage Vstore info
@@ -72,21 +116,105 @@ type VstoreCollector struct {
 	*BaseCollector
 }
 
-func parseVstoreCapacityUsage(inDataKey, metricsName string, inData map[string]string) string {
+// parseVstoreQuota parse the capacity quota in sectors, the quota is invalid if it is not set
+func parseVstoreQuota(quota string) (float64, bool) {
+	if quota == quotaUnlimited {
+		return 0, false
+	}
+	sectors, err := strconv.ParseFloat(quota, bitSize)
+	if err != nil || sectors <= 0 {
+		return 0, false
+	}
+	return sectors, true
+}
+
+// getVstoreUsedQuota get the used capacity and capacity quota in sectors of the capacity type
+func getVstoreUsedQuota(capacityType string, inData map[string]string) (float64, float64, bool) {
+	keys, ok := vstoreQuotaKeys[capacityType]
+	if !ok || len(inData) == 0 {
+		return 0, 0, false
+	}
+
+	quota, ok := parseVstoreQuota(inData[keys[0]])
+	if !ok {
+		return 0, 0, false
+	}
+	free, err := strconv.ParseFloat(inData[keys[1]], bitSize)
+	if err != nil || free > quota {
+		return 0, 0, false
+	}
+	return quota - free, quota, true
+}
+
+// parseVstoreQuotaToGB the metric will not be reported if the quota is not set
+func parseVstoreQuotaToGB(inDataKey, metricsName string, inData map[string]string) string {
 	if len(inData) == 0 {
 		return ""
 	}
+	quota, ok := parseVstoreQuota(inData[inDataKey])
+	if !ok {
+		return skipReportValue
+	}
+	return strconv.FormatFloat(quota/sectorsTOGb, 'f', precisionOfSmallest, bitSize)
+}
 
+// parseVstoreUsedCapacity the inDataKey is the capacity type, used capacity is quota minus free quota
+func parseVstoreUsedCapacity(inDataKey, metricsName string, inData map[string]string) string {
+	used, _, ok := getVstoreUsedQuota(inDataKey, inData)
+	if !ok {
+		return skipReportValue
+	}
+	return strconv.FormatFloat(used/sectorsTOGb, 'f', precisionOfSmallest, bitSize)
+}
+
+// parseVstoreCapacityUsage the inDataKey is the capacity type, the usage is used capacity of quota
+func parseVstoreCapacityUsage(inDataKey, metricsName string, inData map[string]string) string {
+	used, quota, ok := getVstoreUsedQuota(inDataKey, inData)
+	if !ok {
+		return skipReportValue
+	}
+	return strconv.FormatFloat(used/quota*calculatePercentage, 'f', unlimitedPrecision, bitSize)
+}
+
+// parseVstoreEndpoint the pool details use the backend of the StorageBackendContent as endpoint
+func parseVstoreEndpoint(inDataKey, metricsName string, inData map[string]string) string {
+	if endpoint := parseStorageData(inDataKey, metricsName, inData); endpoint != "" {
+		return endpoint
+	}
+	return parseStorageData("backendName", metricsName, inData)
+}
+
+// isVstorePoolDetail the pool details are only used by the deprecated pool level metrics
+func isVstorePoolDetail(inData map[string]string) bool {
+	_, ok := inData[vstorePoolNameKey]
+	return ok
+}
+
+// parseVstorePoolCapacityToGB the pool capacities in StorageBackendContent are in bytes
+func parseVstorePoolCapacityToGB(inDataKey, metricsName string, inData map[string]string) string {
+	if !isVstorePoolDetail(inData) {
+		return skipReportValue
+	}
+	bytes, err := strconv.ParseFloat(inData[inDataKey], bitSize)
+	if err != nil {
+		return skipReportValue
+	}
+	return strconv.FormatFloat(bytes/byteToGb, 'f', precisionOfSmallest, bitSize)
+}
+
+// parseVstorePoolCapacityUsage the usage is used capacity of the total capacity of pool
+func parseVstorePoolCapacityUsage(inDataKey, metricsName string, inData map[string]string) string {
+	if !isVstorePoolDetail(inData) {
+		return skipReportValue
+	}
 	capacity, err := strconv.ParseFloat(inData[vstoreTotalCapacityKey], bitSize)
 	if err != nil || capacity == 0 {
-		return ""
+		return skipReportValue
 	}
-
 	usedCapacity, err := strconv.ParseFloat(inData[vstoreUsedCapacityKey], bitSize)
 	if err != nil {
-		return ""
+		return skipReportValue
 	}
-
 	return strconv.FormatFloat(usedCapacity/capacity*calculatePercentage, 'f', unlimitedPrecision, bitSize)
 }
 
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -17,6 +17,7 @@ package collector
 
 import (
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -110,17 +111,116 @@ func TestNewVstoreCollector_GetPerformanceCollector(t *testing.T) {
 	}
 }
 
+func Test_parseVstoreQuotaToGB(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   string
+	}{
+		{name: "quota set", inData: map[string]string{"sanCapacityQuota": "2097152"}, want: "1"},
+		{name: "quota not set", inData: map[string]string{"sanCapacityQuota": quotaUnlimited}, want: skipReportValue},
+		{name: "quota is zero", inData: map[string]string{"sanCapacityQuota": "0"}, want: skipReportValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseVstoreQuotaToGB("sanCapacityQuota", "", tt.inData); got != tt.want {
+				t.Errorf("parseVstoreQuotaToGB() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseVstoreUsedCapacity(t *testing.T) {
+	// arrange
+	inData := map[string]string{"nasCapacityQuota": "4194304", "nasFreeCapacityQuota": "2097152"}
+
+	// action
+	got := parseVstoreUsedCapacity(vstoreNasCapacityType, "", inData)
+	gotSan := parseVstoreUsedCapacity(vstoreSanCapacityType, "", inData)
+
+	// assert
+	if got != "1" {
+		t.Errorf("parseVstoreUsedCapacity() got = %v, want %v", got, "1")
+	}
+	if gotSan != skipReportValue {
+		t.Errorf("parseVstoreUsedCapacity() gotSan = %v, want %v", gotSan, skipReportValue)
+	}
+}
+
 func Test_parseVstoreCapacityUsage(t *testing.T) {
 	// mock input data
 	mockInData := make(map[string]string)
-	mockInData[vstoreTotalCapacityKey] = "100"
-	mockInData[vstoreUsedCapacityKey] = "80"
+	mockInData["sanCapacityQuota"] = "100"
+	mockInData["sanFreeCapacityQuota"] = "20"
 
 	// action
-	got := parseVstoreCapacityUsage("", "", mockInData)
+	got := parseVstoreCapacityUsage(vstoreSanCapacityType, "", mockInData)
 
 	// assert
 	if !reflect.DeepEqual(got, "80") {
 		t.Errorf("parseVstoreCapacityUsage() got = %v, want %v", got, "80")
 	}
 }
+
+func Test_vstoreObjectMetricsParseMap_ReportedMetrics(t *testing.T) {
+	tests := []struct {
+		name   string
+		inData map[string]string
+		want   []string
+	}{
+		{
+			name: "vstore with san quota",
+			inData: map[string]string{"ID": "1", "NAME": "vstore-1", "RUNNINGSTATUS": "27",
+				"sanCapacityQuota": "4194304", "sanFreeCapacityQuota": "2097152",
+				"nasCapacityQuota": quotaUnlimited, "lunCount": "2", "filesystemCount": "0"},
+			want: []string{"filesystem_count", "lun_count", "running_status",
+				"san_capacity_quota", "san_capacity_usage", "san_used_capacity"},
+		},
+		{
+			name: "vstore without quota",
+			inData: map[string]string{"ID": "0", "NAME": "System_vStore", "RUNNINGSTATUS": "27",
+				"sanCapacityQuota": quotaUnlimited, "nasCapacityQuota": quotaUnlimited,
+				"lunCount": "3", "filesystemCount": "1"},
+			want: []string{"filesystem_count", "lun_count", "running_status"},
+		},
+		{
+			name: "pool of backend",
+			inData: map[string]string{"BackendName": "backend-1", "ID": "0", "NAME": "System_vStore",
+				"PoolName": "pool-1", "TotalCapacity": "2147483648", "FreeCapacity": "1073741824",
+				"UsedCapacity": "1073741824"},
+			want: []string{"capacity_usage", "free_capacity", "total_capacity", "used_capacity"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			var got []string
+			for metricsName, relation := range vstoreObjectMetricsParseMap {
+				if relation.parseFunc(relation.parseKey, metricsName, tt.inData) != skipReportValue {
+					got = append(got, metricsName)
+				}
+			}
+			sort.Strings(got)
+
+			// assert
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("vstoreObjectMetricsParseMap reported metrics got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseVstoreEndpoint(t *testing.T) {
+	// arrange
+	vstoreData := map[string]string{"backendName": "backend-1"}
+	poolData := map[string]string{"backendName": "backend-1", "BackendName": "backend-2"}
+
+	// action
+	gotVstore := parseVstoreEndpoint("BackendName", "", vstoreData)
+	gotPool := parseVstoreEndpoint("BackendName", "", poolData)
+
+	// assert
+	if gotVstore != "backend-1" || gotPool != "backend-2" {
+		t.Errorf("parseVstoreEndpoint() got = %v and %v, want backend-1 and backend-2", gotVstore, gotPool)
+	}
+}
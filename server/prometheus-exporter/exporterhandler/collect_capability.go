@@ -0,0 +1,160 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package exporterhandler provide all handler use by prometheus exporter
+package exporterhandler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	clientSet "github.com/huawei/csm/v2/server/prometheus-exporter/clientset"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// capabilitiesExpiration the collect capabilities of backend will be queried again after expiration
+const capabilitiesExpiration = 10 * time.Minute
+
+var (
+	// the collectors which are not collected from the storage directly, e.g. pv is merged by lun and filesystem
+	mergedCollectors = map[string]struct{}{
+		"pv":             {},
+		"volumesnapshot": {},
+	}
+
+	capabilitiesMutex sync.Mutex
+	capabilitiesCache = map[string]*collectCapabilities{}
+)
+
+// collectCapabilities the collect capabilities of one backend
+// key of performanceTypes is collect type, value is the set of available indicators
+type collectCapabilities struct {
+	objectTypes      map[string]struct{}
+	performanceTypes map[string]map[string]struct{}
+	expireTime       time.Time
+}
+
+// checkCollectCapabilities check whether the collectors and indicators are supported by the backend
+// If the capabilities can not be got, e.g. the cmi does not support, the check will be skipped.
+func checkCollectCapabilities(ctx context.Context, backendName, monitorType string,
+	params map[string][]string) error {
+	capabilities, err := getCollectCapabilities(ctx, backendName)
+	if err != nil {
+		log.AddContext(ctx).Warningf("get collect capabilities of backend [%s] failed, skip to check, "+
+			"err is [%v]", backendName, err)
+		return nil
+	}
+
+	for collectorName, metricsIndicators := range params {
+		if _, ok := mergedCollectors[collectorName]; ok {
+			continue
+		}
+
+		if monitorType == "object" {
+			if _, ok := capabilities.objectTypes[collectorName]; !ok {
+				return fmt.Errorf("the collectorName [%s] is unsupported by backend [%s]", collectorName, backendName)
+			}
+			continue
+		}
+
+		indicators, ok := capabilities.performanceTypes[collectorName]
+		if !ok {
+			return fmt.Errorf("the collectorName [%s] is unsupported by backend [%s]", collectorName, backendName)
+		}
+		// empty indicators means the storage does not report the available indicators
+		if len(indicators) == 0 || len(metricsIndicators) == 0 {
+			continue
+		}
+		for _, indicator := range strings.Split(metricsIndicators[0], ",") {
+			if _, ok := indicators[indicator]; !ok {
+				return fmt.Errorf("the indicator [%s] of [%s] is unsupported by backend [%s]",
+					indicator, collectorName, backendName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getCollectCapabilities get collect capabilities from cache, if not exist or expired, query it from cmi
+// The cache is only locked when it is read or written, so a slow backend does not block the others.
+func getCollectCapabilities(ctx context.Context, backendName string) (*collectCapabilities, error) {
+	capabilities, ok := loadCollectCapabilities(backendName)
+	if ok && time.Now().Before(capabilities.expireTime) {
+		return capabilities, nil
+	}
+
+	capabilities, err := queryCollectCapabilities(ctx, backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	storeCollectCapabilities(backendName, capabilities)
+	return capabilities, nil
+}
+
+func loadCollectCapabilities(backendName string) (*collectCapabilities, bool) {
+	capabilitiesMutex.Lock()
+	defer capabilitiesMutex.Unlock()
+	capabilities, ok := capabilitiesCache[backendName]
+	return capabilities, ok
+}
+
+func storeCollectCapabilities(backendName string, capabilities *collectCapabilities) {
+	capabilitiesMutex.Lock()
+	defer capabilitiesMutex.Unlock()
+	capabilitiesCache[backendName] = capabilities
+}
+
+// queryCollectCapabilities query the collect capabilities of backend from cmi
+func queryCollectCapabilities(ctx context.Context, backendName string) (*collectCapabilities, error) {
+	exporterClientSet := clientSet.GetExporterClientSet()
+	if exporterClientSet == nil || exporterClientSet.StorageGRPCClientSet == nil {
+		return nil, fmt.Errorf("the grpc client set is not initialized")
+	}
+
+	response, err := exporterClientSet.StorageGRPCClientSet.CollectorClient.GetCollectCapabilities(ctx,
+		&storageGRPC.GetCollectCapabilitiesRequest{BackendName: backendName})
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectCapabilities(response), nil
+}
+
+func convertCollectCapabilities(response *storageGRPC.GetCollectCapabilitiesResponse) *collectCapabilities {
+	capabilities := &collectCapabilities{
+		objectTypes:      map[string]struct{}{},
+		performanceTypes: map[string]map[string]struct{}{},
+		expireTime:       time.Now().Add(capabilitiesExpiration),
+	}
+
+	for _, objectType := range response.GetObjectTypes() {
+		capabilities.objectTypes[objectType] = struct{}{}
+	}
+
+	for _, performanceType := range response.GetPerformanceTypes() {
+		indicators := map[string]struct{}{}
+		for _, indicator := range performanceType.GetIndicators() {
+			indicators[indicator] = struct{}{}
+		}
+		capabilities.performanceTypes[performanceType.GetCollectType()] = indicators
+	}
+	return capabilities
+}
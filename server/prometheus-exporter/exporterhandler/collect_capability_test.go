@@ -0,0 +1,94 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package exporterhandler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+)
+
+func Test_checkCollectCapabilities(t *testing.T) {
+	// arrange
+	ctx := context.TODO()
+	capabilitiesCache["backend_name"] = convertCollectCapabilities(&storageGRPC.GetCollectCapabilitiesResponse{
+		ObjectTypes: []string{"array", "lun"},
+		PerformanceTypes: []*storageGRPC.PerformanceCapability{
+			{CollectType: "lun", Indicators: []string{"21", "22"}},
+			{CollectType: "controller"},
+		},
+	})
+	defer delete(capabilitiesCache, "backend_name")
+	tests := []struct {
+		name        string
+		monitorType string
+		params      map[string][]string
+		wantErr     bool
+	}{
+		{"supported object", "object", map[string][]string{"array": {""}, "pv": {""}}, false},
+		{"unsupported object", "object", map[string][]string{"controller": {""}}, true},
+		{"supported indicators", "performance", map[string][]string{"lun": {"21,22"}}, false},
+		{"unsupported indicator", "performance", map[string][]string{"lun": {"21,370"}}, true},
+		{"unreported indicators", "performance", map[string][]string{"controller": {"22"}}, false},
+		{"unsupported performance", "performance", map[string][]string{"filesystem": {"182"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			err := checkCollectCapabilities(ctx, "backend_name", tt.monitorType, tt.params)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkCollectCapabilities() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_getCollectCapabilities_QueryWithoutLock(t *testing.T) {
+	// arrange
+	ctx := context.TODO()
+	lockedWhenQuery := false
+	p := gomonkey.ApplyFunc(queryCollectCapabilities,
+		func(ctx context.Context, backendName string) (*collectCapabilities, error) {
+			if capabilitiesMutex.TryLock() {
+				capabilitiesMutex.Unlock()
+			} else {
+				lockedWhenQuery = true
+			}
+			return convertCollectCapabilities(&storageGRPC.GetCollectCapabilitiesResponse{
+				ObjectTypes: []string{"lun"}}), nil
+		})
+	defer p.Reset()
+	defer delete(capabilitiesCache, "backend_name")
+
+	// action
+	got, err := getCollectCapabilities(ctx, "backend_name")
+
+	// assert
+	if err != nil || lockedWhenQuery {
+		t.Errorf("getCollectCapabilities() error = %v, locked when query = %v", err, lockedWhenQuery)
+		return
+	}
+	if cached, ok := capabilitiesCache["backend_name"]; !ok || cached != got {
+		t.Errorf("getCollectCapabilities() want the capabilities cached, but got %v", cached)
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -44,13 +44,34 @@ var (
 	}
 	// Supported monitoring types
 	metricsObjectLegal = map[string]struct{}{
-		"array":       {},
-		"controller":  {},
-		"storagepool": {},
-		"lun":         {},
-		"filesystem":  {},
-		"pv":          {},
-		"vstore":      {},
+		"array":            {},
+		"controller":       {},
+		"storagepool":      {},
+		"lun":              {},
+		"filesystem":       {},
+		"pv":               {},
+		"vstore":           {},
+		"disk":             {},
+		"host":             {},
+		"hostgroup":        {},
+		"mappingview":      {},
+		"fcport":           {},
+		"ethport":          {},
+		"enclosure":        {},
+		"psu":              {},
+		"fan":              {},
+		"bbu":              {},
+		"interfacemodule":  {},
+		"volumesnapshot":   {},
+		"hypermetrodomain": {},
+		"hypermetropair":   {},
+		"replicationpair":  {},
+		"nfsshare":         {},
+		"cifsshare":        {},
+		"dtree":            {},
+		"quota":            {},
+		"qos":              {},
+		"alarm":            {},
 	}
 )
 
@@ -92,6 +113,12 @@ func parseRequestPath(ctx context.Context, w http.ResponseWriter, r *http.Reques
 		http.Error(w, "MetricsObjectType is invalid.", http.StatusBadRequest)
 		return "", "", fmt.Errorf("check metrics object failed, err is [%w]", checkError)
 	}
+
+	checkError = checkCollectCapabilities(ctx, monitorBackendName, monitorType, params)
+	if checkError != nil {
+		http.Error(w, checkError.Error(), http.StatusBadRequest)
+		return "", "", fmt.Errorf("check collect capabilities failed, err is [%w]", checkError)
+	}
 	return monitorBackendName, monitorType, nil
 }
 
@@ -0,0 +1,99 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package metricscache use to save query the data of the storage metrics once
+package metricscache
+
+import (
+	"context"
+	"strconv"
+
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// alarmCountKeys the alarms with the same values of these keys are counted together,
+// so the active alarm counts are reported by severity and alarm object
+var alarmCountKeys = []string{"level", "alarmObjType", "alarmObjId"}
+
+// MetricsAlarmData save the active alarm counts of storage, from prometheus request
+type MetricsAlarmData struct {
+	*StorageMetricsData
+}
+
+func init() {
+	RegisterMetricsData("alarm", NewMetricsAlarmData)
+}
+
+// NewMetricsAlarmData new a MetricsAlarmData
+func NewMetricsAlarmData(backendName, metricsType string) (MetricsData, error) {
+	return &MetricsAlarmData{StorageMetricsData: &StorageMetricsData{BaseMetricsData: &BaseMetricsData{
+		BackendName: backendName, MetricsType: metricsType}}}, nil
+}
+
+// SetMetricsData get the current alarms from storage and count them by severity and alarm object
+func (metricsData *MetricsAlarmData) SetMetricsData(ctx context.Context,
+	collectorName, monitorType string, metricsIndicators []string) error {
+	err := metricsData.StorageMetricsData.SetMetricsData(ctx, collectorName, monitorType, metricsIndicators)
+	if err != nil {
+		return err
+	}
+
+	metricsData.MetricsDataResponse = countAlarms(ctx, metricsData.MetricsDataResponse)
+	return nil
+}
+
+// countAlarms build the details of active alarm counts, each detail contains the alarmCountKeys and the count
+func countAlarms(ctx context.Context, alarmResponse *storageGRPC.CollectResponse) *storageGRPC.CollectResponse {
+	if alarmResponse == nil {
+		return nil
+	}
+
+	var countDetails []*storageGRPC.CollectDetail
+	var detailIndex = make(map[string]int)
+	var counts []int
+	for _, alarm := range alarmResponse.GetDetails() {
+		countKey := ""
+		for _, key := range alarmCountKeys {
+			countKey += alarm.GetData()[key] + "/"
+		}
+
+		index, exist := detailIndex[countKey]
+		if !exist {
+			index = len(countDetails)
+			detailIndex[countKey] = index
+			countData := make(map[string]string, len(alarmCountKeys)+1)
+			for _, key := range alarmCountKeys {
+				countData[key] = alarm.GetData()[key]
+			}
+			countDetails = append(countDetails, &storageGRPC.CollectDetail{Data: countData})
+			counts = append(counts, 0)
+		}
+		counts[index]++
+	}
+
+	for index, detail := range countDetails {
+		detail.Data["count"] = strconv.Itoa(counts[index])
+	}
+	log.AddContext(ctx).Infof("count %d active alarms into %d groups", len(alarmResponse.GetDetails()),
+		len(countDetails))
+	return &storageGRPC.CollectResponse{
+		BackendName: alarmResponse.GetBackendName(),
+		CollectType: alarmResponse.GetCollectType(),
+		MetricsType: alarmResponse.GetMetricsType(),
+		Details:     countDetails,
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package metricscache
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+)
+
+func Test_countAlarms(t *testing.T) {
+	// arrange
+	alarmResponse := &storageGRPC.CollectResponse{BackendName: "fake_backend", CollectType: "alarm",
+		Details: []*storageGRPC.CollectDetail{
+			{Data: map[string]string{"sequence": "1", "level": "4", "alarmObjType": "216", "alarmObjId": "0"}},
+			{Data: map[string]string{"sequence": "2", "level": "4", "alarmObjType": "216", "alarmObjId": "0"}},
+			{Data: map[string]string{"sequence": "3", "level": "3", "alarmObjType": "10", "alarmObjId": "CTE0.1"}},
+		}}
+	want := []map[string]string{
+		{"level": "4", "alarmObjType": "216", "alarmObjId": "0", "count": "2"},
+		{"level": "3", "alarmObjType": "10", "alarmObjId": "CTE0.1", "count": "1"},
+	}
+
+	// action
+	got := countAlarms(context.Background(), alarmResponse)
+
+	// assert
+	if got.GetBackendName() != "fake_backend" || got.GetCollectType() != "alarm" {
+		t.Errorf("countAlarms() got response = %v, want backend and collect type kept", got)
+	}
+	var gotData []map[string]string
+	for _, detail := range got.GetDetails() {
+		gotData = append(gotData, detail.GetData())
+	}
+	if !reflect.DeepEqual(gotData, want) {
+		t.Errorf("countAlarms() got = %v, want %v", gotData, want)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package metricscache use to save query the data of the storage metrics once
+package metricscache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	exporterConfig "github.com/huawei/csm/v2/config/exporter"
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	clientSet "github.com/huawei/csm/v2/server/prometheus-exporter/clientset"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// the page size of listing VolumeSnapshotContent, which is same as listing pv
+const getVolumeSnapshotContentLimit = getPVLimit
+
+// snapshotHandle str is sbcName.snapshotName or sbcName.parentId.snapshotName,
+// so when use strings.Split, the length is at least 2.
+const snapshotHandleMinLen = 2
+
+// volumeSnapshotContentGVR is the resource of VolumeSnapshotContent, which is queried by dynamic client
+// so that the exporter does not depend on the snapshot client
+var volumeSnapshotContentGVR = schema.GroupVersionResource{
+	Group:    "snapshot.storage.k8s.io",
+	Version:  "v1",
+	Resource: "volumesnapshotcontents",
+}
+
+func getVolumeSnapshotContentsFromApi(ctx context.Context) []unstructured.Unstructured {
+	usedClientSet := clientSet.GetExporterClientSet()
+	var allContentItems []unstructured.Unstructured
+	var continueKey string
+	if usedClientSet.DynamicClient == nil {
+		return allContentItems
+	}
+
+	for {
+		contentList, err := usedClientSet.DynamicClient.Resource(volumeSnapshotContentGVR).List(
+			ctx, metaV1.ListOptions{Limit: getVolumeSnapshotContentLimit, Continue: continueKey})
+		if err != nil {
+			log.AddContext(ctx).Errorf("can not get volume snapshot content list, err is [%v]", err)
+			break
+		}
+		allContentItems = append(allContentItems, contentList.Items...)
+		continueKey = contentList.GetContinue()
+		if continueKey == "" {
+			break
+		}
+	}
+	return allContentItems
+}
+
+// parseVolumeSnapshotContent parse the VolumeSnapshotContent created by huawei csi,
+// the VolumeSnapshotContent which is not ready or not bound to a VolumeSnapshot will be skipped
+func parseVolumeSnapshotContent(content unstructured.Unstructured) (map[string]string, error) {
+	object := content.UnstructuredContent()
+	driver, _, err := unstructured.NestedString(object, "spec", "driver")
+	if err != nil || driver != exporterConfig.GetCSIDriverName() {
+		return nil, errors.New("unsupported driver")
+	}
+
+	snapshotHandle, found, err := unstructured.NestedString(object, "status", "snapshotHandle")
+	if !found || err != nil {
+		return nil, errors.New("can not get snapshotHandle")
+	}
+	handleSplit := strings.Split(snapshotHandle, ".")
+	if len(handleSplit) < snapshotHandleMinLen {
+		return nil, errors.New("can not parse snapshotHandle, split error")
+	}
+
+	snapshotName, found, err := unstructured.NestedString(object, "spec", "volumeSnapshotRef", "name")
+	if !found || err != nil {
+		return nil, errors.New("can not get VolumeSnapshot name")
+	}
+	snapshotNamespace, _, err := unstructured.NestedString(object, "spec", "volumeSnapshotRef", "namespace")
+	if err != nil {
+		return nil, errors.New("can not get VolumeSnapshot namespace")
+	}
+
+	data := map[string]string{
+		"driverName":                driver,
+		"sbcName":                   handleSplit[0],
+		"storageName":               handleSplit[len(handleSplit)-1],
+		"volumeSnapshotName":        snapshotName,
+		"volumeSnapshotNamespace":   snapshotNamespace,
+		"volumeSnapshotContentName": content.GetName(),
+	}
+	restoreSize, found, err := unstructured.NestedInt64(object, "status", "restoreSize")
+	if found && err == nil {
+		data["restoreSize"] = strconv.FormatInt(restoreSize, 10)
+	}
+	return data, nil
+}
+
+func buildOutVolumeSnapshotData(ctx context.Context, backendName, collectType string,
+	allSBCInfo map[string]map[string]string,
+	allContents []unstructured.Unstructured) *storageGRPC.CollectResponse {
+	outSnapshotData := &storageGRPC.CollectResponse{
+		BackendName: backendName,
+		CollectType: collectType,
+		Details:     []*storageGRPC.CollectDetail{}}
+	for _, content := range allContents {
+		data, err := parseVolumeSnapshotContent(content)
+		if err != nil {
+			log.AddContext(ctx).Debugf("parse volume snapshot content [%s] data failed, err is [%v]",
+				content.GetName(), err)
+			continue
+		}
+
+		sbcInfo, ok := allSBCInfo[data["sbcName"]]
+		if !ok {
+			continue
+		}
+		data["sbcStorageType"], ok = sbcInfo["sbcStorageType"]
+		if !ok {
+			continue
+		}
+		outSnapshotData.Details = append(outSnapshotData.Details, &storageGRPC.CollectDetail{Data: data})
+	}
+	return outSnapshotData
+}
+
+// GetAndParseVolumeSnapshotInfo gets and parses VolumeSnapshotContent info with special collect type
+func GetAndParseVolumeSnapshotInfo(ctx context.Context, backendName,
+	collectType string) (*storageGRPC.CollectResponse, error) {
+	allContents := getVolumeSnapshotContentsFromApi(ctx)
+	if len(allContents) == 0 {
+		return nil, errors.New("can not get volume snapshot content data, volume snapshot content is empty")
+	}
+
+	allSBCInfo := getAllBackendFromApi(ctx)
+	if len(allSBCInfo) == 0 {
+		return nil, errors.New("can not get sbc data, sbc is empty")
+	}
+
+	return buildOutVolumeSnapshotData(ctx, backendName, collectType, allSBCInfo, allContents), nil
+}
@@ -0,0 +1,91 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package metricscache
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	exporterConfig "github.com/huawei/csm/v2/config/exporter"
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+)
+
+func mockVolumeSnapshotContent(name, driver, snapshotHandle string) unstructured.Unstructured {
+	content := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"driver": driver,
+			"volumeSnapshotRef": map[string]interface{}{
+				"name":      "snap-" + name,
+				"namespace": "default",
+			},
+		},
+		"status": map[string]interface{}{
+			"snapshotHandle": snapshotHandle,
+			"restoreSize":    int64(1073741824),
+		},
+	}}
+	content.SetName(name)
+	return content
+}
+
+func Test_buildOutVolumeSnapshotData(t *testing.T) {
+	// arrange
+	ctx := context.TODO()
+	allSBCInfo := map[string]map[string]string{
+		"backend-san": {"namespace": "huawei-csi", "sbcStorageType": "oceanstor-san"},
+		"backend-nas": {"namespace": "huawei-csi", "sbcStorageType": "oceanstor-nas"},
+	}
+	allContents := []unstructured.Unstructured{
+		mockVolumeSnapshotContent("content-1", "csi.huawei.com", "backend-san.snapshot-1"),
+		mockVolumeSnapshotContent("content-2", "csi.huawei.com", "backend-nas.10.snapshot-2"),
+		mockVolumeSnapshotContent("content-3", "other.csi.io", "backend-san.snapshot-3"),
+		mockVolumeSnapshotContent("content-4", "csi.huawei.com", "unknown.snapshot-4"),
+		mockVolumeSnapshotContent("content-5", "csi.huawei.com", "invalid"),
+	}
+	want := &storageGRPC.CollectResponse{
+		BackendName: "fake_backend",
+		CollectType: "volumesnapshot",
+		Details: []*storageGRPC.CollectDetail{
+			{Data: map[string]string{"driverName": "csi.huawei.com", "sbcName": "backend-san",
+				"storageName": "snapshot-1", "volumeSnapshotName": "snap-content-1",
+				"volumeSnapshotNamespace": "default", "volumeSnapshotContentName": "content-1",
+				"restoreSize": "1073741824", "sbcStorageType": "oceanstor-san"}},
+			{Data: map[string]string{"driverName": "csi.huawei.com", "sbcName": "backend-nas",
+				"storageName": "snapshot-2", "volumeSnapshotName": "snap-content-2",
+				"volumeSnapshotNamespace": "default", "volumeSnapshotContentName": "content-2",
+				"restoreSize": "1073741824", "sbcStorageType": "oceanstor-nas"}},
+		},
+	}
+
+	// mock
+	mock := gomonkey.ApplyFunc(exporterConfig.GetCSIDriverName, func() string {
+		return "csi.huawei.com"
+	})
+	defer mock.Reset()
+
+	// action
+	got := buildOutVolumeSnapshotData(ctx, "fake_backend", "volumesnapshot", allSBCInfo, allContents)
+
+	// assert
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("buildOutVolumeSnapshotData() got = %v, want %v", got, want)
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -27,6 +27,27 @@ import (
 	"github.com/huawei/csm/v2/utils/log"
 )
 
+const (
+	qosEnableValue = "true"
+	qosNameKey     = "qosName"
+	qosIdSeparator = ","
+)
+
+// qosLimitKeys the limit keys of qos policy and the keys of them in the merged pv data
+var qosLimitKeys = map[string]string{
+	"MAXIOPS":      "qosMaxIOPS",
+	"MINIOPS":      "qosMinIOPS",
+	"MAXBANDWIDTH": "qosMaxBandwidth",
+	"MINBANDWIDTH": "qosMinBandwidth",
+	"LATENCY":      "qosLatency",
+}
+
+// qosAssociatedKeys the keys of the associated object ids in qos policy with the volume type
+var qosAssociatedKeys = map[string]string{
+	"lun":        "lunIds",
+	"filesystem": "filesystemIds",
+}
+
 // MergePVMetricsData implement MergeMetricsData interface
 type MergePVMetricsData struct {
 	*BaseMergeMetricsData
@@ -142,6 +163,7 @@ func (mergePVMetricsData *MergePVMetricsData) MergeData(ctx context.Context,
 
 	pvTempData := pvMetricsDataResponse.Details
 	pvMetricsDataResponse.Details = nil
+	qosPolicies := getAppliedQoSPolicies(metricsDataCache)
 	for _, volumeType := range metricsIndicatorsList {
 		mergeMapData, err := mergePVMetricsData.mergeKubePVAndStorageInfo(
 			ctx, storageNameKey, "storageName", volumeType, pvTempData, metricsDataCache)
@@ -155,6 +177,7 @@ func (mergePVMetricsData *MergePVMetricsData) MergeData(ctx context.Context,
 			if !ok {
 				continue
 			}
+			mergeQoSPolicy(volumeType, value, qosPolicies)
 			pvMetricsDataResponse.Details = append(pvMetricsDataResponse.Details,
 				&storageGRPC.CollectDetail{Data: value})
 		}
@@ -162,3 +185,42 @@ func (mergePVMetricsData *MergePVMetricsData) MergeData(ctx context.Context,
 	log.AddContext(ctx).Infoln("merge pv and storage data success")
 	return nil
 }
+
+// getAppliedQoSPolicies get the enabled qos policies from the cache,
+// the key of result is the volume type and id of the associated lun or filesystem, e.g. lun:1
+func getAppliedQoSPolicies(metricsDataCache *MetricsDataCache) map[string]map[string]string {
+	qosCacheData := metricsDataCache.GetMetricsData("qos")
+	if qosCacheData == nil {
+		return nil
+	}
+
+	var policies = make(map[string]map[string]string)
+	for _, detail := range qosCacheData.GetDetails() {
+		policy := detail.GetData()
+		if policy["ENABLESTATUS"] != qosEnableValue {
+			continue
+		}
+		for volumeType, idsKey := range qosAssociatedKeys {
+			if policy[idsKey] == "" {
+				continue
+			}
+			for _, id := range strings.Split(policy[idsKey], qosIdSeparator) {
+				policies[volumeType+":"+id] = policy
+			}
+		}
+	}
+	return policies
+}
+
+// mergeQoSPolicy merge the limits of the qos policy applied to the pv storage into the pv data
+func mergeQoSPolicy(volumeType string, pvData map[string]string, qosPolicies map[string]map[string]string) {
+	policy, ok := qosPolicies[volumeType+":"+pvData["ID"]]
+	if !ok {
+		return
+	}
+
+	pvData[qosNameKey] = policy["NAME"]
+	for limitKey, pvKey := range qosLimitKeys {
+		pvData[pvKey] = policy[limitKey]
+	}
+}
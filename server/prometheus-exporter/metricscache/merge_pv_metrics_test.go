@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -458,3 +458,77 @@ func TestMergePVMetricsData_MergeData_MergeFailed(t *testing.T) {
 		p.Reset()
 	})
 }
+
+func Test_mergeQoSPolicy(t *testing.T) {
+	// arrange
+	qosData := &StorageMetricsData{BaseMetricsData: &BaseMetricsData{
+		MetricsDataResponse: &storageGRPC.CollectResponse{Details: []*storageGRPC.CollectDetail{
+			{Data: map[string]string{"NAME": "qos-1", "ENABLESTATUS": "true", "MAXIOPS": "1000",
+				"lunIds": "1,2", "filesystemIds": ""}},
+			{Data: map[string]string{"NAME": "qos-2", "ENABLESTATUS": "false", "MAXIOPS": "500",
+				"lunIds": "3", "filesystemIds": "1"}},
+		}}}}
+	metricsDataCache := &MetricsDataCache{CacheDataMap: map[string]MetricsData{"qos": qosData}}
+	tests := []struct {
+		name       string
+		volumeType string
+		pvData     map[string]string
+		wantName   string
+		wantIOPS   string
+	}{
+		{name: "lun with enabled policy", volumeType: "lun", pvData: map[string]string{"ID": "2"},
+			wantName: "qos-1", wantIOPS: "1000"},
+		{name: "lun with disabled policy", volumeType: "lun", pvData: map[string]string{"ID": "3"}},
+		{name: "filesystem with same id", volumeType: "filesystem", pvData: map[string]string{"ID": "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// action
+			mergeQoSPolicy(tt.volumeType, tt.pvData, getAppliedQoSPolicies(metricsDataCache))
+
+			// assert
+			if tt.pvData["qosName"] != tt.wantName || tt.pvData["qosMaxIOPS"] != tt.wantIOPS {
+				t.Errorf("mergeQoSPolicy() got pv data = %v, want qos name [%s] and max iops [%s]",
+					tt.pvData, tt.wantName, tt.wantIOPS)
+			}
+		})
+	}
+}
+
+func TestMergePVMetricsData_MergeData_PacificBackend(t *testing.T) {
+	// arrange
+	ctx := context.TODO()
+	mergePVMetricsData := &MergePVMetricsData{BaseMergeMetricsData: &BaseMergeMetricsData{
+		backendName: "pacific", monitorType: "object", metricsType: "pv"}}
+	pvData := &BaseMetricsData{MetricsDataResponse: &storageGRPC.CollectResponse{
+		Details: []*storageGRPC.CollectDetail{
+			{Data: map[string]string{"pvName": "pv-1", "storageName": "pvc-1",
+				"sbcStorageType": "fusionstorage-san"}},
+			{Data: map[string]string{"pvName": "pv-2", "storageName": "pvc-2",
+				"sbcStorageType": "fusionstorage-nas"}},
+		}}}
+	metricsDataCache := &MetricsDataCache{BackendName: "pacific", CacheDataMap: map[string]MetricsData{
+		"pv": pvData,
+		"lun": &BaseMetricsData{MetricsDataResponse: &storageGRPC.CollectResponse{
+			Details: []*storageGRPC.CollectDetail{{Data: map[string]string{"ID": "1", "NAME": "pvc-1"}}}}},
+		"filesystem": &BaseMetricsData{MetricsDataResponse: &storageGRPC.CollectResponse{
+			Details: []*storageGRPC.CollectDetail{{Data: map[string]string{"ID": "2", "NAME": "pvc-2"}}}}},
+	}}
+
+	// action
+	err := mergePVMetricsData.MergeData(ctx, metricsDataCache)
+
+	// assert
+	if err != nil {
+		t.Errorf("MergeData() error = %v", err)
+		return
+	}
+	got := map[string]string{}
+	for _, detail := range pvData.GetMetricsDataResponse().GetDetails() {
+		got[detail.GetData()["pvName"]] = detail.GetData()["ID"]
+	}
+	if want := map[string]string{"pv-1": "1", "pv-2": "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeData() got pv storage ids = %v, want %v", got, want)
+	}
+}
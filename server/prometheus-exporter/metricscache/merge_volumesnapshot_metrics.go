@@ -0,0 +1,111 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package metricscache use to save query the data of the storage metrics once
+package metricscache
+
+import (
+	"context"
+	"errors"
+
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// MergeVolumeSnapshotMetricsData implement MergeMetricsData interface,
+// merge the VolumeSnapshotContent data and the storage snapshot data by the snapshot name in storage
+type MergeVolumeSnapshotMetricsData struct {
+	*BaseMergeMetricsData
+}
+
+func init() {
+	RegisterMergeMetricsData("volumesnapshot", NewMergeVolumeSnapshotMetricsData)
+}
+
+// NewMergeVolumeSnapshotMetricsData new a MergeVolumeSnapshotMetricsData
+func NewMergeVolumeSnapshotMetricsData(backendName, monitorType, metricsType string,
+	metricsIndicators []string) (MergeMetricsData, error) {
+	return &MergeVolumeSnapshotMetricsData{BaseMergeMetricsData: &BaseMergeMetricsData{
+		backendName: backendName, monitorType: monitorType, metricsType: metricsType,
+		mergeIndicators: metricsIndicators}}, nil
+}
+
+func mergeVolumeSnapshotAndStorageInfo(snapshotType string, snapshotCacheData []*storageGRPC.CollectDetail,
+	metricsDataCache *MetricsDataCache) ([]*storageGRPC.CollectDetail, error) {
+	storageCacheData := metricsDataCache.GetMetricsData(snapshotType)
+	if storageCacheData == nil || len(storageCacheData.Details) == 0 {
+		return nil, errors.New("can not get the storage snapshot data when merge")
+	}
+
+	var snapshotCacheDataMap = make(map[string]map[string]string, len(snapshotCacheData))
+	for _, snapshotData := range snapshotCacheData {
+		if snapshotData.Data["storageName"] == "" {
+			continue
+		}
+		if snapshotTypeMap[snapshotData.Data["sbcStorageType"]] != snapshotType {
+			continue
+		}
+		snapshotCacheDataMap[snapshotData.Data["storageName"]] = snapshotData.Data
+	}
+
+	var resultMerge []*storageGRPC.CollectDetail
+	for _, mergeData := range storageCacheData.Details {
+		sameData, exist := snapshotCacheDataMap[mergeData.GetData()["NAME"]]
+		if !exist {
+			continue
+		}
+
+		var data = make(map[string]string, len(mergeData.Data)+len(sameData))
+		for key, value := range mergeData.Data {
+			data[key] = value
+		}
+		for key, value := range sameData {
+			data[key] = value
+		}
+		resultMerge = append(resultMerge, &storageGRPC.CollectDetail{Data: data})
+	}
+	return resultMerge, nil
+}
+
+// MergeData merge VolumeSnapshotContent data and storage snapshot data,
+// only the VolumeSnapshotContents which have snapshot in storage are kept
+func (mergeSnapshotMetricsData *MergeVolumeSnapshotMetricsData) MergeData(ctx context.Context,
+	metricsDataCache *MetricsDataCache) error {
+	log.AddContext(ctx).Infoln("start to merge volume snapshot and storage data")
+	snapshotCacheData, ok := metricsDataCache.CacheDataMap["volumesnapshot"]
+	if !ok {
+		return errors.New("can not get volume snapshot cache data when MergeVolumeSnapshotAndStorageData")
+	}
+
+	snapshotMetricsDataResponse := snapshotCacheData.GetMetricsDataResponse()
+	if snapshotMetricsDataResponse == nil || len(snapshotMetricsDataResponse.Details) == 0 {
+		return errors.New("can not get MetricsDataResponse.Details when MergeVolumeSnapshotAndStorageData")
+	}
+
+	snapshotTempData := snapshotMetricsDataResponse.Details
+	snapshotMetricsDataResponse.Details = nil
+	for _, snapshotType := range snapshotTypes {
+		mergeData, err := mergeVolumeSnapshotAndStorageInfo(snapshotType, snapshotTempData, metricsDataCache)
+		if err != nil {
+			log.AddContext(ctx).Errorf("merge volume snapshot metricsData of [%s] failed, "+
+				"err is [%v], try to merge next data", snapshotType, err)
+			continue
+		}
+		snapshotMetricsDataResponse.Details = append(snapshotMetricsDataResponse.Details, mergeData...)
+	}
+	log.AddContext(ctx).Infoln("merge volume snapshot and storage data success")
+	return nil
+}
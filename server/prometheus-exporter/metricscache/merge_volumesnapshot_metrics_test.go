@@ -0,0 +1,112 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package metricscache
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
+)
+
+func newMockBaseMetricsData(collectType string, details ...map[string]string) *BaseMetricsData {
+	response := &storageGRPC.CollectResponse{CollectType: collectType}
+	for _, detail := range details {
+		response.Details = append(response.Details, &storageGRPC.CollectDetail{Data: detail})
+	}
+	return &BaseMetricsData{MetricsDataResponse: response}
+}
+
+func TestMergeVolumeSnapshotMetricsData_MergeData(t *testing.T) {
+	// arrange
+	ctx := context.TODO()
+	metricsDataCache := &MetricsDataCache{CacheDataMap: map[string]MetricsData{
+		"volumesnapshot": &MetricsVolumeSnapshotData{BaseMetricsData: newMockBaseMetricsData("volumesnapshot",
+			map[string]string{"storageName": "snapshot-1", "sbcStorageType": "oceanstor-san",
+				"volumeSnapshotName": "snap-1"},
+			map[string]string{"storageName": "snapshot-2", "sbcStorageType": "oceanstor-nas",
+				"volumeSnapshotName": "snap-2"},
+			map[string]string{"storageName": "snapshot-3", "sbcStorageType": "oceanstor-san",
+				"volumeSnapshotName": "snap-3"})},
+		"lunsnapshot": &StorageMetricsData{BaseMetricsData: newMockBaseMetricsData("lunsnapshot",
+			map[string]string{"ID": "1", "NAME": "snapshot-1", "CONSUMEDCAPACITY": "2048"},
+			map[string]string{"ID": "2", "NAME": "snapshot-2", "CONSUMEDCAPACITY": "4096"})},
+		"fssnapshot": &StorageMetricsData{BaseMetricsData: newMockBaseMetricsData("fssnapshot",
+			map[string]string{"ID": "10@1", "NAME": "snapshot-2", "CONSUMEDCAPACITY": "8192"})},
+	}}
+	mergeData, err := NewMergeVolumeSnapshotMetricsData("fake_backend", "object", "volumesnapshot", nil)
+	if err != nil {
+		t.Fatalf("NewMergeVolumeSnapshotMetricsData() error = %v", err)
+	}
+	want := map[string]map[string]string{
+		"snap-1": {"ID": "1", "NAME": "snapshot-1", "CONSUMEDCAPACITY": "2048", "storageName": "snapshot-1",
+			"sbcStorageType": "oceanstor-san", "volumeSnapshotName": "snap-1"},
+		"snap-2": {"ID": "10@1", "NAME": "snapshot-2", "CONSUMEDCAPACITY": "8192", "storageName": "snapshot-2",
+			"sbcStorageType": "oceanstor-nas", "volumeSnapshotName": "snap-2"},
+	}
+
+	// action
+	err = mergeData.MergeData(ctx, metricsDataCache)
+
+	// assert
+	if err != nil {
+		t.Errorf("MergeData() error = %v", err)
+		return
+	}
+	got := make(map[string]map[string]string)
+	for _, detail := range metricsDataCache.GetMetricsData("volumesnapshot").GetDetails() {
+		got[detail.GetData()["volumeSnapshotName"]] = detail.GetData()
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeData() got = %v, want %v", got, want)
+	}
+}
+
+func TestMergeVolumeSnapshotMetricsData_MergeData_NoDuplicate(t *testing.T) {
+	// arrange
+	ctx := context.TODO()
+	metricsDataCache := &MetricsDataCache{CacheDataMap: map[string]MetricsData{
+		"volumesnapshot": &MetricsVolumeSnapshotData{BaseMetricsData: newMockBaseMetricsData("volumesnapshot",
+			map[string]string{"storageName": "snapshot-1", "sbcStorageType": "oceanstor-san",
+				"volumeSnapshotName": "snap-1"},
+			map[string]string{"storageName": "snapshot-2", "sbcStorageType": "fusionstorage-nas",
+				"volumeSnapshotName": "snap-2"})},
+		"lunsnapshot": &StorageMetricsData{BaseMetricsData: newMockBaseMetricsData("lunsnapshot",
+			map[string]string{"ID": "1", "NAME": "snapshot-1"})},
+		"fssnapshot": &StorageMetricsData{BaseMetricsData: newMockBaseMetricsData("fssnapshot",
+			map[string]string{"ID": "10@1", "NAME": "snapshot-2"})},
+	}}
+	mergeData, err := NewMergeVolumeSnapshotMetricsData("fake_backend", "object", "volumesnapshot", nil)
+	if err != nil {
+		t.Fatalf("NewMergeVolumeSnapshotMetricsData() error = %v", err)
+	}
+	wantCount := 2
+
+	// action
+	err = mergeData.MergeData(ctx, metricsDataCache)
+
+	// assert
+	if err != nil {
+		t.Errorf("MergeData() error = %v", err)
+		return
+	}
+	got := metricsDataCache.GetMetricsData("volumesnapshot").GetDetails()
+	if len(got) != wantCount {
+		t.Errorf("MergeData() got %d details, want %d, details = %v", len(got), wantCount, got)
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -26,10 +26,33 @@ import (
 	"github.com/huawei/csm/v2/utils/log"
 )
 
+// storageTypeMap the storage object type of the pv with the sbc storage type,
+// the volumes and namespaces of fusionstorage are collected as lun and filesystem too
 var storageTypeMap = map[string]string{
-	"oceanstor-san": "lun",
-	"oceanstor-nas": "filesystem",
+	"oceanstor-san":     "lun",
+	"oceanstor-nas":     "filesystem",
+	"fusionstorage-san": "lun",
+	"fusionstorage-nas": "filesystem",
 }
+
+// snapshotTypeMap the storage snapshot type of the VolumeSnapshotContent with the sbc storage type
+var snapshotTypeMap = map[string]string{
+	"oceanstor-san":     "lunsnapshot",
+	"oceanstor-nas":     "fssnapshot",
+	"fusionstorage-san": "lunsnapshot",
+	"fusionstorage-nas": "fssnapshot",
+}
+
+// snapshotTypes the distinct storage snapshot types in snapshotTypeMap, several sbc storage types share one
+// snapshot type, so the snapshot types are ranged by this slice to query and merge each of them only once
+var snapshotTypes = []string{"lunsnapshot", "fssnapshot"}
+
+// pvUnfilteredCollectors the collectors required by pv whose objects are not named after the pv storage,
+// e.g. the qos policies, so they can not be filtered by the storage names of pv
+var pvUnfilteredCollectors = map[string]struct{}{
+	"qos": {},
+}
+
 var pvPerformanceMap = map[string][]string{
 	"lun":        {"21,22,370"},
 	"filesystem": {"182,524,525"},
@@ -56,6 +79,10 @@ func (metricsDataCache *MetricsDataCache) SetBatchDataFromSource(ctx context.Con
 	monitorType string, params map[string][]string) {
 	log.AddContext(ctx).Infoln("start to fill batch data from source")
 
+	// the pv and volume snapshot data are set first,
+	// so that only the storage objects backing pv and volume snapshot will be collected
+	metricsDataCache.setPVObjectNames(ctx, monitorType, params)
+	metricsDataCache.setVolumeSnapshotObjectNames(ctx, monitorType, params)
 	for collectorName, metricsIndicators := range params {
 		metricsData, ok := metricsDataCache.CacheDataMap[collectorName]
 		if !ok {
@@ -80,6 +107,62 @@ func (metricsDataCache *MetricsDataCache) SetBatchDataFromSource(ctx context.Con
 	log.AddContext(ctx).Infoln("fill batch data success")
 }
 
+func (metricsDataCache *MetricsDataCache) setPVObjectNames(ctx context.Context,
+	monitorType string, params map[string][]string) {
+	pvMetricsData, ok := metricsDataCache.CacheDataMap["pv"]
+	if !ok {
+		return
+	}
+
+	err := pvMetricsData.SetMetricsData(ctx, "pv", monitorType, params["pv"])
+	if err != nil {
+		log.AddContext(ctx).Errorf("set metrics data for pv failed, err is [%v]", err)
+		return
+	}
+
+	metricsDataCache.setObjectNames(pvMetricsData, storageTypeMap)
+}
+
+func (metricsDataCache *MetricsDataCache) setVolumeSnapshotObjectNames(ctx context.Context,
+	monitorType string, params map[string][]string) {
+	snapshotMetricsData, ok := metricsDataCache.CacheDataMap["volumesnapshot"]
+	if !ok {
+		return
+	}
+
+	err := snapshotMetricsData.SetMetricsData(ctx, "volumesnapshot", monitorType, params["volumesnapshot"])
+	if err != nil {
+		log.AddContext(ctx).Errorf("set metrics data for volumesnapshot failed, err is [%v]", err)
+		return
+	}
+
+	metricsDataCache.setObjectNames(snapshotMetricsData, snapshotTypeMap)
+}
+
+// setObjectNames set the storage names in the kubernetes data to the storage metrics data of the storage types,
+// storageTypes key is the sbc storage type, value is the collector name of the storage objects
+func (metricsDataCache *MetricsDataCache) setObjectNames(kubeMetricsData MetricsData,
+	storageTypes map[string]string) {
+	var storageNames []string
+	var nameSet = make(map[string]struct{})
+	for _, detail := range kubeMetricsData.GetMetricsDataResponse().GetDetails() {
+		storageName := detail.GetData()["storageName"]
+		if _, exist := nameSet[storageName]; storageName == "" || exist {
+			continue
+		}
+		nameSet[storageName] = struct{}{}
+		storageNames = append(storageNames, storageName)
+	}
+
+	for _, collectorName := range storageTypes {
+		storageMetricsData, ok := metricsDataCache.CacheDataMap[collectorName].(*StorageMetricsData)
+		if !ok {
+			continue
+		}
+		storageMetricsData.SetObjectNames(storageNames)
+	}
+}
+
 // MergeBatchData Merge batch data of MergeMetrics is not empty.
 // use the MergeData interface to get need Merge Metrics like pv Metrics
 func (metricsDataCache *MetricsDataCache) MergeBatchData(ctx context.Context) {
@@ -126,6 +209,7 @@ func (metricsDataCache *MetricsDataCache) buildPVBatchParams(ctx context.Context
 	} else {
 		batchParams["lun"] = []string{""}
 		batchParams["filesystem"] = []string{""}
+		batchParams["qos"] = []string{""}
 	}
 	return nil
 }
@@ -164,6 +248,39 @@ func (metricsDataCache *MetricsDataCache) buildPVClass(ctx context.Context,
 	return
 }
 
+// buildVolumeSnapshotClass the volume snapshot only supports object monitor type,
+// the lun and filesystem snapshots are collected and merged with the VolumeSnapshotContents
+func (metricsDataCache *MetricsDataCache) buildVolumeSnapshotClass(ctx context.Context,
+	monitorType string, params, batchParams map[string][]string) {
+	metricsIndicators, ok := params["volumesnapshot"]
+	if !ok {
+		return
+	}
+	log.AddContext(ctx).Infoln("start to build volume snapshot class")
+	if monitorType != "object" {
+		log.AddContext(ctx).Errorf("volume snapshot is unsupported with monitor type [%s]", monitorType)
+		return
+	}
+
+	for _, snapshotType := range snapshotTypes {
+		batchParams[snapshotType] = []string{""}
+	}
+
+	mergeFunc, exist := mergeMetricsFactories["volumesnapshot"]
+	if !exist {
+		log.AddContext(ctx).Errorf("can not get volume snapshot merge func")
+		return
+	}
+
+	mergeDataType, err := mergeFunc(metricsDataCache.BackendName, monitorType, "volumesnapshot", metricsIndicators)
+	if err != nil {
+		log.AddContext(ctx).Errorf("can not get volume snapshot mergeDataType, err is [%v]", err)
+		return
+	}
+	metricsDataCache.MergeMetrics["volumesnapshot"] = mergeDataType
+	log.AddContext(ctx).Infof("build volume snapshot class success with batch params: %v", batchParams)
+}
+
 func (metricsDataCache *MetricsDataCache) buildStorageClass(ctx context.Context,
 	monitorType string, params, batchParams map[string][]string) {
 	log.AddContext(ctx).Infoln("start to build storage class")
@@ -190,6 +307,11 @@ func (metricsDataCache *MetricsDataCache) buildStorageClass(ctx context.Context,
 				collectorName, monitorType, err)
 			continue
 		}
+		_, required := params[collectorName]
+		_, unfiltered := pvUnfilteredCollectors[collectorName]
+		if !required && !unfiltered {
+			setFilterByPV(metricsData)
+		}
 		metricsDataCache.CacheDataMap[collectorName] = metricsData
 	}
 	log.AddContext(ctx).Infof("build storage class success with batch params: %v", batchParams)
@@ -201,6 +323,7 @@ func (metricsDataCache *MetricsDataCache) BuildBatchDataClass(ctx context.Contex
 	batchParams := make(map[string][]string)
 
 	metricsDataCache.buildPVClass(ctx, monitorType, params, batchParams)
+	metricsDataCache.buildVolumeSnapshotClass(ctx, monitorType, params, batchParams)
 	metricsDataCache.buildStorageClass(ctx, monitorType, params, batchParams)
 
 	return batchParams, nil
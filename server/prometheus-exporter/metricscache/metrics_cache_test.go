@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -128,7 +128,7 @@ func TestMetricsDataCache_buildPVBatchParams_ObjectSuccess(t *testing.T) {
 	monitorType := "object"
 	params := map[string][]string{"pv": {}}
 	batchParams := make(map[string][]string)
-	wantRes := map[string][]string{"lun": {""}, "filesystem": {""}}
+	wantRes := map[string][]string{"lun": {""}, "filesystem": {""}, "qos": {""}}
 
 	// action
 	gotErr := metricsDataCache.buildPVBatchParams(ctx, monitorType, params, batchParams)
@@ -183,3 +183,83 @@ func TestMetricsDataCache_buildPVBatchParams_EmptyIndicatorsFail(t *testing.T) {
 	}
 
 }
+
+func TestMetricsDataCache_buildVolumeSnapshotClass(t *testing.T) {
+	tests := []struct {
+		name            string
+		monitorType     string
+		wantBatchParams map[string][]string
+		wantMerge       bool
+	}{
+		{name: "object monitor type", monitorType: "object", wantMerge: true,
+			wantBatchParams: map[string][]string{"lunsnapshot": {""}, "fssnapshot": {""}}},
+		{name: "performance monitor type", monitorType: "performance",
+			wantBatchParams: map[string][]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			metricsDataCache := &MetricsDataCache{MergeMetrics: make(map[string]MergeMetricsData)}
+			batchParams := make(map[string][]string)
+
+			// action
+			metricsDataCache.buildVolumeSnapshotClass(context.TODO(), tt.monitorType,
+				map[string][]string{"volumesnapshot": {""}}, batchParams)
+
+			// assert
+			if !reflect.DeepEqual(batchParams, tt.wantBatchParams) {
+				t.Errorf("buildVolumeSnapshotClass() batchParams = %v, want %v", batchParams, tt.wantBatchParams)
+			}
+			if _, ok := metricsDataCache.MergeMetrics["volumesnapshot"]; ok != tt.wantMerge {
+				t.Errorf("buildVolumeSnapshotClass() merge metrics exist = %v, want %v", ok, tt.wantMerge)
+			}
+		})
+	}
+}
+
+func TestMetricsDataCache_setObjectNames(t *testing.T) {
+	// arrange
+	lunData := &StorageMetricsData{BaseMetricsData: &BaseMetricsData{}, filterByPV: true}
+	lunSnapshotData := &StorageMetricsData{BaseMetricsData: &BaseMetricsData{}, filterByPV: true}
+	metricsDataCache := &MetricsDataCache{CacheDataMap: map[string]MetricsData{
+		"lun":         lunData,
+		"lunsnapshot": lunSnapshotData,
+	}}
+	snapshotData := &MetricsVolumeSnapshotData{BaseMetricsData: &BaseMetricsData{
+		MetricsDataResponse: &cmi.CollectResponse{Details: []*cmi.CollectDetail{
+			{Data: map[string]string{"storageName": "snapshot-1"}},
+			{Data: map[string]string{"storageName": "snapshot-1"}},
+		}}}}
+
+	// action
+	metricsDataCache.setObjectNames(snapshotData, snapshotTypeMap)
+
+	// assert
+	if !reflect.DeepEqual(lunSnapshotData.objectNames, []string{"snapshot-1"}) {
+		t.Errorf("setObjectNames() lunsnapshot object names = %v, want [snapshot-1]", lunSnapshotData.objectNames)
+	}
+	if len(lunData.objectNames) != 0 {
+		t.Errorf("setObjectNames() lun object names = %v, want empty", lunData.objectNames)
+	}
+}
+
+func TestMetricsDataCache_buildStorageClass_QoSNotFilteredByPV(t *testing.T) {
+	// arrange
+	metricsDataCache := &MetricsDataCache{BackendName: "fake_backend", CacheDataMap: map[string]MetricsData{}}
+	params := map[string][]string{"pv": {""}}
+	batchParams := map[string][]string{"lun": {""}, "qos": {""}}
+
+	// action
+	metricsDataCache.buildStorageClass(context.TODO(), "object", params, batchParams)
+
+	// assert
+	lunData, ok := metricsDataCache.CacheDataMap["lun"].(*StorageMetricsData)
+	if !ok || !lunData.filterByPV {
+		t.Errorf("buildStorageClass() lun metrics data = %v, want filtered by pv", lunData)
+	}
+	qosData, ok := metricsDataCache.CacheDataMap["qos"].(*StorageMetricsData)
+	if !ok || qosData.filterByPV {
+		t.Errorf("buildStorageClass() qos metrics data = %v, want not filtered by pv", qosData)
+	}
+}
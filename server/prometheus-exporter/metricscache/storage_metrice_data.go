@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,18 +19,33 @@ package metricscache
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
 	clientSet "github.com/huawei/csm/v2/server/prometheus-exporter/clientset"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
+// objectNameKeys the keys of object name in the details of object and performance data
+var objectNameKeys = []string{"NAME", "ObjectName"}
+
 // StorageMetricsData save one batch data with storage MetricsType,
 // from prometheus request
 type StorageMetricsData struct {
 	*BaseMetricsData
+	// objectNames is used to collect only the objects with these names, e.g. the luns backing pv
+	objectNames []string
+	// objectNameSet is the set of objectNames, used to discard the received objects which are not required
+	objectNameSet map[string]struct{}
+	// filterByPV indicates the collector is only required by pv or volume snapshot,
+	// so only the objects backing them need to be collected
+	filterByPV bool
 }
 
 func init() {
@@ -39,6 +54,27 @@ func init() {
 	RegisterMetricsData("storagepool", NewStorageMetricsData)
 	RegisterMetricsData("filesystem", NewStorageMetricsData)
 	RegisterMetricsData("lun", NewStorageMetricsData)
+	RegisterMetricsData("disk", NewStorageMetricsData)
+	RegisterMetricsData("host", NewStorageMetricsData)
+	RegisterMetricsData("hostgroup", NewStorageMetricsData)
+	RegisterMetricsData("mappingview", NewStorageMetricsData)
+	RegisterMetricsData("fcport", NewStorageMetricsData)
+	RegisterMetricsData("ethport", NewStorageMetricsData)
+	RegisterMetricsData("enclosure", NewStorageMetricsData)
+	RegisterMetricsData("psu", NewStorageMetricsData)
+	RegisterMetricsData("fan", NewStorageMetricsData)
+	RegisterMetricsData("bbu", NewStorageMetricsData)
+	RegisterMetricsData("interfacemodule", NewStorageMetricsData)
+	RegisterMetricsData("lunsnapshot", NewStorageMetricsData)
+	RegisterMetricsData("fssnapshot", NewStorageMetricsData)
+	RegisterMetricsData("hypermetrodomain", NewStorageMetricsData)
+	RegisterMetricsData("hypermetropair", NewStorageMetricsData)
+	RegisterMetricsData("replicationpair", NewStorageMetricsData)
+	RegisterMetricsData("nfsshare", NewStorageMetricsData)
+	RegisterMetricsData("cifsshare", NewStorageMetricsData)
+	RegisterMetricsData("dtree", NewStorageMetricsData)
+	RegisterMetricsData("quota", NewStorageMetricsData)
+	RegisterMetricsData("qos", NewStorageMetricsData)
 }
 
 // NewStorageMetricsData new a StorageMetricsData
@@ -54,6 +90,7 @@ func (storageMetricsData *StorageMetricsData) buildTheStorageGRPCRequest(
 		CollectType: collectorName,
 		MetricsType: monitorType,
 		Indicators:  []string{},
+		ObjectNames: storageMetricsData.objectNames,
 	}
 	if monitorType != "performance" {
 		return batchCollectRequest
@@ -67,11 +104,69 @@ func (storageMetricsData *StorageMetricsData) buildTheStorageGRPCRequest(
 	return batchCollectRequest
 }
 
+func setFilterByPV(metricsData MetricsData) {
+	storageMetricsData, ok := metricsData.(*StorageMetricsData)
+	if !ok {
+		return
+	}
+	storageMetricsData.filterByPV = true
+}
+
+// SetObjectNames set the names of objects to be collected if the collector is only required by pv or volume snapshot
+func (storageMetricsData *StorageMetricsData) SetObjectNames(names []string) {
+	if !storageMetricsData.filterByPV {
+		return
+	}
+	storageMetricsData.objectNames = names
+	storageMetricsData.objectNameSet = make(map[string]struct{}, len(names))
+	for _, name := range names {
+		storageMetricsData.objectNameSet[name] = struct{}{}
+	}
+}
+
+// isRequired whether the object of detail is required,
+// the objects not backing pv or volume snapshot are not required if the collector is filtered by pv
+func (storageMetricsData *StorageMetricsData) isRequired(detail *storageGRPC.CollectDetail) bool {
+	if !storageMetricsData.filterByPV {
+		return true
+	}
+	for _, key := range objectNameKeys {
+		if _, ok := storageMetricsData.objectNameSet[detail.GetData()[key]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// consumePage parse the details of one received page into the response,
+// the page itself is not referenced after that, so only the required details are kept in memory
+func (storageMetricsData *StorageMetricsData) consumePage(response, page *storageGRPC.CollectResponse) {
+	response.Partial = response.GetPartial() || page.GetPartial()
+	for _, detail := range page.GetDetails() {
+		if !storageMetricsData.isRequired(detail) {
+			continue
+		}
+		response.Details = append(response.Details, detail)
+	}
+}
+
 func (storageMetricsData *StorageMetricsData) getStorageData(ctx context.Context,
 	batchCollectRequest *storageGRPC.CollectRequest,
 	usedClientSet *clientSet.ClientsSet) (*storageGRPC.CollectResponse, error) {
 	storageGRPCClient := usedClientSet.StorageGRPCClientSet.CollectorClient
-	batchCollectResponse, err := storageGRPCClient.Collect(ctx, batchCollectRequest)
+	batchCollectResponse := &storageGRPC.CollectResponse{
+		BackendName: batchCollectRequest.GetBackendName(),
+		CollectType: batchCollectRequest.GetCollectType(),
+		MetricsType: batchCollectRequest.GetMetricsType(),
+	}
+	err := storageMetricsData.getStorageDataByStream(ctx, batchCollectRequest, storageGRPCClient,
+		func(page *storageGRPC.CollectResponse) {
+			storageMetricsData.consumePage(batchCollectResponse, page)
+		})
+	if status.Code(err) == codes.Unimplemented {
+		log.AddContext(ctx).Warningln("collect stream is unimplemented by cmi, try to collect in one response")
+		batchCollectResponse, err = storageGRPCClient.Collect(ctx, batchCollectRequest)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("can not get storage response the err is [%w]", err)
 	}
@@ -79,9 +174,35 @@ func (storageMetricsData *StorageMetricsData) getStorageData(ctx context.Context
 	if batchCollectResponse.Details == nil {
 		log.AddContext(ctx).Warningln("get storage response of <nil>")
 	}
+	if batchCollectResponse.GetPartial() {
+		log.AddContext(ctx).Warningf("only part of the [%s] data is collected from storage",
+			batchCollectRequest.GetCollectType())
+	}
 	return batchCollectResponse, nil
 }
 
+// getStorageDataByStream receive storage data in stream mode,
+// each received page is handed to consume before the next one is received
+func (storageMetricsData *StorageMetricsData) getStorageDataByStream(ctx context.Context,
+	batchCollectRequest *storageGRPC.CollectRequest, storageGRPCClient storageGRPC.CollectorClient,
+	consume func(page *storageGRPC.CollectResponse)) error {
+	stream, err := storageGRPCClient.CollectStream(ctx, batchCollectRequest)
+	if err != nil {
+		return err
+	}
+
+	for {
+		pageResponse, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		consume(pageResponse)
+	}
+}
+
 // SetMetricsData set storage data MetricsDataResponse
 func (storageMetricsData *StorageMetricsData) SetMetricsData(ctx context.Context,
 	collectorName, monitorType string, metricsIndicators []string) error {
@@ -92,6 +213,13 @@ func (storageMetricsData *StorageMetricsData) SetMetricsData(ctx context.Context
 		return fmt.Errorf("can not get clientset when set metrics data, client err is [%w]", usedClientSet.InitError)
 	}
 
+	if storageMetricsData.filterByPV && len(storageMetricsData.objectNames) == 0 {
+		log.AddContext(ctx).Infof("no pv object of %s need to be collected", collectorName)
+		storageMetricsData.MetricsDataResponse = &storageGRPC.CollectResponse{
+			BackendName: storageMetricsData.BackendName, CollectType: collectorName, MetricsType: monitorType}
+		return nil
+	}
+
 	// get storage data
 	batchCollectRequest := storageMetricsData.buildTheStorageGRPCRequest(
 		collectorName, monitorType, metricsIndicators)
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -17,9 +17,13 @@
 package metricscache
 
 import (
+	"context"
+	"io"
 	"reflect"
 	"testing"
 
+	"google.golang.org/grpc"
+
 	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
 )
 
@@ -42,3 +46,105 @@ func TestStorageMetricsData_buildTheStorageGRPCRequest(t *testing.T) {
 		t.Errorf("buildTheStorageGRPCRequest() got = [%v], want [%v]", got, wantRequest)
 	}
 }
+
+type mockCollectorClient struct {
+	storageGRPC.CollectorClient
+	responses []*storageGRPC.CollectResponse
+	consumed  *int
+	// consumedBeforeRecv records the number of consumed pages when each page is received
+	consumedBeforeRecv []int
+}
+
+func (m *mockCollectorClient) CollectStream(ctx context.Context, in *storageGRPC.CollectRequest,
+	opts ...grpc.CallOption) (storageGRPC.Collector_CollectStreamClient, error) {
+	return &mockCollectStreamClient{client: m}, nil
+}
+
+type mockCollectStreamClient struct {
+	grpc.ClientStream
+	client *mockCollectorClient
+}
+
+func (m *mockCollectStreamClient) Recv() (*storageGRPC.CollectResponse, error) {
+	m.client.consumedBeforeRecv = append(m.client.consumedBeforeRecv, *m.client.consumed)
+	if len(m.client.responses) == 0 {
+		return nil, io.EOF
+	}
+	response := m.client.responses[0]
+	m.client.responses = m.client.responses[1:]
+	return response, nil
+}
+
+func TestStorageMetricsData_getStorageDataByStream(t *testing.T) {
+	// arrange
+	mockStorageData := StorageMetricsData{BaseMetricsData: &BaseMetricsData{BackendName: "fake_backend_name"}}
+	request := &storageGRPC.CollectRequest{BackendName: "fake_backend_name", CollectType: "lun", MetricsType: "object"}
+	consumed := 0
+	mockClient := &mockCollectorClient{consumed: &consumed, responses: []*storageGRPC.CollectResponse{
+		{Details: []*storageGRPC.CollectDetail{{Data: map[string]string{"ID": "1"}}}},
+		{Details: []*storageGRPC.CollectDetail{{Data: map[string]string{"ID": "2"}}}},
+	}}
+
+	// action
+	err := mockStorageData.getStorageDataByStream(context.Background(), request, mockClient,
+		func(page *storageGRPC.CollectResponse) {
+			consumed++
+		})
+
+	// assert
+	if err != nil {
+		t.Errorf("getStorageDataByStream() error = %v", err)
+		return
+	}
+	// each page is consumed before the next page is received
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(mockClient.consumedBeforeRecv, want) {
+		t.Errorf("getStorageDataByStream() consumed pages before each receive = %v, want %v",
+			mockClient.consumedBeforeRecv, want)
+	}
+}
+
+func TestStorageMetricsData_consumePage_WithPVObjectNames(t *testing.T) {
+	// arrange
+	mockStorageData := &StorageMetricsData{BaseMetricsData: &BaseMetricsData{BackendName: "fake_backend_name"}}
+	setFilterByPV(mockStorageData)
+	mockStorageData.SetObjectNames([]string{"pvc-1", "pvc-3"})
+	response := &storageGRPC.CollectResponse{}
+	pages := []*storageGRPC.CollectResponse{
+		{Details: []*storageGRPC.CollectDetail{
+			{Data: map[string]string{"NAME": "pvc-1"}}, {Data: map[string]string{"NAME": "lun-2"}}}},
+		{Details: []*storageGRPC.CollectDetail{{Data: map[string]string{"ObjectName": "pvc-3"}}}},
+	}
+
+	// action
+	for _, page := range pages {
+		mockStorageData.consumePage(response, page)
+	}
+
+	// assert
+	if len(response.GetDetails()) != 2 || response.GetDetails()[0].GetData()["NAME"] != "pvc-1" ||
+		response.GetDetails()[1].GetData()["ObjectName"] != "pvc-3" {
+		t.Errorf("consumePage() got = [%v], want the details of pvc-1 and pvc-3", response.GetDetails())
+	}
+}
+
+func TestStorageMetricsData_buildTheStorageGRPCRequest_WithPVObjectNames(t *testing.T) {
+	// arrange
+	wantRequest := &storageGRPC.CollectRequest{
+		BackendName: "fake_backend_name",
+		CollectType: "lun",
+		MetricsType: "object",
+		Indicators:  []string{},
+		ObjectNames: []string{"pvc-1", "pvc-2"},
+	}
+	mockStorageData := &StorageMetricsData{BaseMetricsData: &BaseMetricsData{BackendName: "fake_backend_name"}}
+	setFilterByPV(mockStorageData)
+	mockStorageData.SetObjectNames([]string{"pvc-1", "pvc-2"})
+
+	// action
+	got := mockStorageData.buildTheStorageGRPCRequest("lun", "object", []string{""})
+
+	// assert
+	if !reflect.DeepEqual(got, wantRequest) {
+		t.Errorf("buildTheStorageGRPCRequest() got = [%v], want [%v]", got, wantRequest)
+	}
+}
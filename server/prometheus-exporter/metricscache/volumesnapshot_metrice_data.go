@@ -0,0 +1,58 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package metricscache use to save query the data of the storage metrics once
+package metricscache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// MetricsVolumeSnapshotData save one batch data of VolumeSnapshotContent,
+// from prometheus request
+type MetricsVolumeSnapshotData struct {
+	*BaseMetricsData
+}
+
+func init() {
+	RegisterMetricsData("volumesnapshot", NewMetricsVolumeSnapshotData)
+}
+
+// NewMetricsVolumeSnapshotData creates a new MetricsVolumeSnapshotData with special MetricsType
+func NewMetricsVolumeSnapshotData(backendName, metricsType string) (MetricsData, error) {
+	return &MetricsVolumeSnapshotData{BaseMetricsData: &BaseMetricsData{
+		BackendName: backendName, MetricsType: metricsType}}, nil
+}
+
+// SetMetricsData set volume snapshot data MetricsDataResponse
+func (metricsData *MetricsVolumeSnapshotData) SetMetricsData(ctx context.Context,
+	collectorName, monitorType string, metricsIndicators []string) error {
+	log.AddContext(ctx).Infof("start to get volume snapshot metrics data with collector name: %v, "+
+		"monitor type: %v, indicators: %v", collectorName, monitorType, metricsIndicators)
+
+	batchCollectResponse, err := GetAndParseVolumeSnapshotInfo(ctx, metricsData.BackendName,
+		metricsData.MetricsType)
+	if err != nil {
+		return fmt.Errorf("get volume snapshot info failed, err is [%w]", err)
+	}
+
+	metricsData.MetricsDataResponse = batchCollectResponse
+	log.AddContext(ctx).Infoln("get volume snapshot metrics data success")
+	return nil
+}
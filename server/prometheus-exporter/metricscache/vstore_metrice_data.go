@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2025. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2025-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -33,16 +33,20 @@ import (
 	"github.com/huawei/csm/v2/utils/log"
 )
 
-// DefaultVstoreID the default vstore id when can not get vstoreID
-const DefaultVstoreID = "0"
-
-// DefaultVstoreName the default vstore name when can not get vstoreName
+// DefaultVstoreName the default vstore name when the backend does not specify vstoreName
 const DefaultVstoreName = "System_vStore"
 
-// MetricsVstoreData save one batch data with special MetricsType,
-// from prometheus request
+// vstoreBackendKey the key of backends using the vstore, the backends are joined by comma
+const vstoreBackendKey = "sbcName"
+
+// DefaultVstoreID the default vstore id when the backend does not specify vstoreID
+const DefaultVstoreID = "0"
+
+// MetricsVstoreData save the vstores collected from storage, from prometheus request,
+// the backends using each vstore are got from the StorageBackendContent.
+// The pool capacities of each backend are kept as deprecated details for the pool level vstore metrics.
 type MetricsVstoreData struct {
-	*BaseMetricsData
+	*StorageMetricsData
 }
 
 func init() {
@@ -51,24 +55,27 @@ func init() {
 
 // NewMetricsVstoreData creates a new MetricsVstoreData with special MetricsType
 func NewMetricsVstoreData(backendName, metricsType string) (MetricsData, error) {
-	return &MetricsVstoreData{BaseMetricsData: &BaseMetricsData{
-		BackendName: backendName, MetricsType: metricsType}}, nil
+	return &MetricsVstoreData{StorageMetricsData: &StorageMetricsData{BaseMetricsData: &BaseMetricsData{
+		BackendName: backendName, MetricsType: metricsType}}}, nil
 }
 
-// SetMetricsData set Vstore data MetricsDataResponse
+// SetMetricsData get the vstores from storage and set the backends using each vstore,
+// if the backend contents can not be got, the vstore data is still returned without backends and pools
 func (metricsData *MetricsVstoreData) SetMetricsData(ctx context.Context,
 	collectorName, monitorType string, metricsIndicators []string) error {
-	log.AddContext(ctx).Infof("start to get vstore metrics data with collector name: %v, monitor type: %v, "+
-		"indicators: %v", collectorName, monitorType, metricsIndicators)
-
-	backendContent, err := getBackendContentFromApi(ctx)
+	err := metricsData.StorageMetricsData.SetMetricsData(ctx, collectorName, monitorType, metricsIndicators)
 	if err != nil {
-		log.AddContext(ctx).Errorf("can not get backend content, err is [%v]", err)
 		return err
 	}
-	batchCollectResponse := buildVstoreOutData(ctx, metricsData.BackendName, collectorName, backendContent)
 
-	metricsData.MetricsDataResponse = batchCollectResponse
+	backendContent, err := getBackendContentFromApi(ctx)
+	if err != nil {
+		log.AddContext(ctx).Warningf("can not get backend content, the vstore backends will not be set, "+
+			"err is [%v]", err)
+		return nil
+	}
+	setVstoreBackends(metricsData.BackendName, metricsData.MetricsDataResponse, backendContent)
+	appendVstorePoolDetails(metricsData.MetricsDataResponse, backendContent)
 	log.AddContext(ctx).Infoln("get vstore metrics data success")
 	return nil
 }
@@ -117,56 +124,97 @@ func filterVstoreBackend(backendClaims *xuanwuV1.StorageBackendClaimList,
 	backendContents.Items = supportStorageTypeContentList
 }
 
-// buildVstoreOutData build vstore data from sbct list and return final matrix responses
-func buildVstoreOutData(ctx context.Context, backendName, collectType string,
-	backendContents *xuanwuV1.StorageBackendContentList) *storageGRPC.CollectResponse {
-	outVstoreData := &storageGRPC.CollectResponse{
-		BackendName: backendName,
-		CollectType: collectType,
-		Details:     []*storageGRPC.CollectDetail{}}
-	for _, sbctInfo := range backendContents.Items {
-		if sbctInfo.Status == nil {
+// setVstoreBackends set the backends using each vstore into vstore details,
+// only the backends on the same storage as backendName are considered
+func setVstoreBackends(backendName string, vstoreResponse *storageGRPC.CollectResponse,
+	backendContents *xuanwuV1.StorageBackendContentList) {
+	if vstoreResponse == nil {
+		return
+	}
+
+	vstoreBackends := getVstoreBackends(backendName, backendContents)
+	for _, detail := range vstoreResponse.GetDetails() {
+		if detail.GetData() == nil {
 			continue
 		}
+		detail.Data[vstoreBackendKey] = strings.Join(vstoreBackends[detail.GetData()["NAME"]], ",")
+	}
+}
 
-		if sbctInfo.Status.Pools == nil {
+// getVstoreBackends get the backend names of each vstore, key is vstore name.
+// The backends on the same storage have the same sn, if the sn of backendName is unknown,
+// only backendName itself is considered.
+func getVstoreBackends(backendName string,
+	backendContents *xuanwuV1.StorageBackendContentList) map[string][]string {
+	sbcNames := make([]string, len(backendContents.Items))
+	sn := ""
+	for index, sbctInfo := range backendContents.Items {
+		sbcConfigName := strings.Split(sbctInfo.Spec.ConfigmapMeta, "/")
+		if sbctInfo.Status == nil || len(sbcConfigName) != sbConfigMapLen {
 			continue
 		}
-		for _, pool := range sbctInfo.Status.Pools {
-			fillVstorePoolDetail(pool, sbctInfo, outVstoreData)
+		sbcNames[index] = sbcConfigName[1]
+		if sbcConfigName[1] == backendName {
+			sn = sbctInfo.Status.SN
 		}
 	}
-	return outVstoreData
-}
 
-// fillVstorePoolDetail fill vstore pool detail data into outVstoreData
-func fillVstorePoolDetail(pool xuanwuV1.Pool, sbctInfo xuanwuV1.StorageBackendContent,
-	outVstoreData *storageGRPC.CollectResponse) {
-	singleCollectDetail := &storageGRPC.CollectDetail{Data: make(map[string]string)}
-
-	sbcConfigName := strings.Split(sbctInfo.Spec.ConfigmapMeta, "/")
-	if len(sbcConfigName) != sbConfigMapLen {
-		return
-	}
-	singleCollectDetail.Data["BackendName"] = sbcConfigName[1]
+	vstoreBackends := make(map[string][]string)
+	for index, sbctInfo := range backendContents.Items {
+		sbcName := sbcNames[index]
+		if sbcName == "" {
+			continue
+		}
+		if sbcName != backendName && (sn == "" || sbctInfo.Status.SN != sn) {
+			continue
+		}
 
-	if vstoreID, ok := sbctInfo.Status.Specification["VStoreID"]; ok {
-		singleCollectDetail.Data["VStoreID"] = vstoreID
-	} else {
-		singleCollectDetail.Data["VStoreID"] = DefaultVstoreID
+		vstoreName, ok := sbctInfo.Status.Specification["VStoreName"]
+		if !ok || vstoreName == "" {
+			vstoreName = DefaultVstoreName
+		}
+		vstoreBackends[vstoreName] = append(vstoreBackends[vstoreName], sbcName)
 	}
+	return vstoreBackends
+}
 
-	if vstoreName, ok := sbctInfo.Status.Specification["VStoreName"]; ok {
-		singleCollectDetail.Data["VStoreName"] = vstoreName
-	} else {
-		singleCollectDetail.Data["VStoreName"] = DefaultVstoreName
+// appendVstorePoolDetails append the pool capacities of each backend into vstore details,
+// these details have the PoolName key and are only used by the deprecated pool level vstore metrics
+func appendVstorePoolDetails(vstoreResponse *storageGRPC.CollectResponse,
+	backendContents *xuanwuV1.StorageBackendContentList) {
+	if vstoreResponse == nil {
+		return
 	}
 
-	singleCollectDetail.Data["PoolName"] = pool.Name
-
-	singleCollectDetail.Data["TotalCapacity"] = pool.Capacities["TotalCapacity"]
-	singleCollectDetail.Data["FreeCapacity"] = pool.Capacities["FreeCapacity"]
-	singleCollectDetail.Data["UsedCapacity"] = pool.Capacities["UsedCapacity"]
+	for _, sbctInfo := range backendContents.Items {
+		if sbctInfo.Status == nil {
+			continue
+		}
+		sbcConfigName := strings.Split(sbctInfo.Spec.ConfigmapMeta, "/")
+		if len(sbcConfigName) != sbConfigMapLen {
+			continue
+		}
 
-	outVstoreData.Details = append(outVstoreData.Details, singleCollectDetail)
+		vstoreID, ok := sbctInfo.Status.Specification["VStoreID"]
+		if !ok {
+			vstoreID = DefaultVstoreID
+		}
+		vstoreName, ok := sbctInfo.Status.Specification["VStoreName"]
+		if !ok {
+			vstoreName = DefaultVstoreName
+		}
+		for _, pool := range sbctInfo.Status.Pools {
+			vstoreResponse.Details = append(vstoreResponse.Details, &storageGRPC.CollectDetail{
+				Data: map[string]string{
+					"BackendName":   sbcConfigName[1],
+					"ID":            vstoreID,
+					"NAME":          vstoreName,
+					"PoolName":      pool.Name,
+					"TotalCapacity": pool.Capacities["TotalCapacity"],
+					"FreeCapacity":  pool.Capacities["FreeCapacity"],
+					"UsedCapacity":  pool.Capacities["UsedCapacity"],
+				},
+			})
+		}
+	}
 }
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -18,6 +18,7 @@ package metricscache
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -25,17 +26,28 @@ import (
 
 	xuanwuV1 "github.com/Huawei/eSDK_K8S_Plugin/v4/client/apis/xuanwu/v1"
 	"github.com/huawei/csm/v2/controller/utils/consts"
+	storageGRPC "github.com/huawei/csm/v2/grpc/lib/go/cmi"
 )
 
 func TestMetricsVstoreData_SetMetricsData(t *testing.T) {
 	// arrange
 	backendNamespace := "fake_namespace"
 	backendName := "fake_backend_name"
-	mockMetricsVstoreData, _ := NewMetricsVstoreData("fake_backend_name", "fakeType")
+	mockMetricsVstoreData, _ := NewMetricsVstoreData(backendName, "object")
+	vstoreData, _ := mockMetricsVstoreData.(*MetricsVstoreData)
 	ctx := context.Background()
+	want := map[string]string{"ID": "1", "NAME": "fake_vstore_name", vstoreBackendKey: backendName}
 
 	// mock
 	mock := gomonkey.NewPatches()
+	mock.ApplyMethod(vstoreData.StorageMetricsData, "SetMetricsData",
+		func(metricsData *StorageMetricsData, ctx context.Context,
+			collectorName, monitorType string, metricsIndicators []string) error {
+			metricsData.MetricsDataResponse = &storageGRPC.CollectResponse{
+				Details: []*storageGRPC.CollectDetail{{Data: map[string]string{"ID": "1", "NAME": "fake_vstore_name"}}},
+			}
+			return nil
+		})
 	mock.ApplyFunc(getBackendContentFromApi, func(ctx context.Context) (*xuanwuV1.StorageBackendContentList, error) {
 		backendClaims := &xuanwuV1.StorageBackendClaimList{
 			TypeMeta: metaV1.TypeMeta{},
@@ -60,19 +72,10 @@ func TestMetricsVstoreData_SetMetricsData(t *testing.T) {
 					TypeMeta:   metaV1.TypeMeta{},
 					ObjectMeta: metaV1.ObjectMeta{},
 					Spec: xuanwuV1.StorageBackendContentSpec{
-						BackendClaim: backendNamespace + "/" + backendName,
+						BackendClaim:  backendNamespace + "/" + backendName,
+						ConfigmapMeta: backendNamespace + "/" + backendName,
 					},
 					Status: &xuanwuV1.StorageBackendContentStatus{
-						Pools: []xuanwuV1.Pool{
-							{
-								Name: "fakePoolName",
-								Capacities: map[string]string{
-									"TotalCapacity": "100",
-									"UsedCapacity":  "50",
-									"FreeCapacity":  "50",
-								},
-							},
-						},
 						Specification: map[string]string{"VStoreID": "1", "VStoreName": "fake_vstore_name"},
 					},
 				},
@@ -81,16 +84,74 @@ func TestMetricsVstoreData_SetMetricsData(t *testing.T) {
 		filterVstoreBackend(backendClaims, backendContents)
 		return backendContents, nil
 	})
+
 	// action
-	got := mockMetricsVstoreData.SetMetricsData(ctx, "fake_name", "object", []string{})
+	got := mockMetricsVstoreData.SetMetricsData(ctx, "vstore", "object", []string{})
 
 	// assert
 	if got != nil {
 		t.Errorf("SetMetricsData() err got = %v, want nil", got)
 	}
+	if details := vstoreData.MetricsDataResponse.GetDetails(); len(details) != 1 ||
+		!reflect.DeepEqual(details[0].GetData(), want) {
+		t.Errorf("SetMetricsData() got details = %v, want %v", details, want)
+	}
 
 	// cleanup
 	t.Cleanup(func() {
 		mock.Reset()
 	})
 }
+
+func Test_getVstoreBackends(t *testing.T) {
+	// arrange
+	newContent := func(sbcName, sn string, specification map[string]string) xuanwuV1.StorageBackendContent {
+		return xuanwuV1.StorageBackendContent{
+			Spec:   xuanwuV1.StorageBackendContentSpec{ConfigmapMeta: "fake_namespace/" + sbcName},
+			Status: &xuanwuV1.StorageBackendContentStatus{SN: sn, Specification: specification},
+		}
+	}
+	backendContents := &xuanwuV1.StorageBackendContentList{Items: []xuanwuV1.StorageBackendContent{
+		newContent("backend-1", "sn-1", map[string]string{"VStoreName": "vstore-1"}),
+		newContent("backend-2", "sn-1", map[string]string{"VStoreName": "vstore-1"}),
+		newContent("backend-3", "sn-1", nil),
+		newContent("backend-4", "sn-2", map[string]string{"VStoreName": "vstore-1"}),
+	}}
+	want := map[string][]string{
+		"vstore-1":        {"backend-1", "backend-2"},
+		DefaultVstoreName: {"backend-3"},
+	}
+
+	// action
+	got := getVstoreBackends("backend-1", backendContents)
+
+	// assert
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getVstoreBackends() got = %v, want %v", got, want)
+	}
+}
+
+func Test_appendVstorePoolDetails(t *testing.T) {
+	// arrange
+	vstoreResponse := &storageGRPC.CollectResponse{
+		Details: []*storageGRPC.CollectDetail{{Data: map[string]string{"ID": "1", "NAME": "vstore-1"}}},
+	}
+	backendContents := &xuanwuV1.StorageBackendContentList{Items: []xuanwuV1.StorageBackendContent{
+		{
+			Spec: xuanwuV1.StorageBackendContentSpec{ConfigmapMeta: "fake_namespace/backend-1"},
+			Status: &xuanwuV1.StorageBackendContentStatus{Pools: []xuanwuV1.Pool{{Name: "pool-1",
+				Capacities: map[string]string{"TotalCapacity": "200", "FreeCapacity": "150", "UsedCapacity": "50"}}}},
+		},
+		{Spec: xuanwuV1.StorageBackendContentSpec{ConfigmapMeta: "fake_namespace/backend-2"}},
+	}}
+	want := map[string]string{"BackendName": "backend-1", "ID": DefaultVstoreID, "NAME": DefaultVstoreName,
+		"PoolName": "pool-1", "TotalCapacity": "200", "FreeCapacity": "150", "UsedCapacity": "50"}
+
+	// action
+	appendVstorePoolDetails(vstoreResponse, backendContents)
+
+	// assert
+	if details := vstoreResponse.GetDetails(); len(details) != 2 || !reflect.DeepEqual(details[1].GetData(), want) {
+		t.Errorf("appendVstorePoolDetails() got details = %v, want pool detail %v", details, want)
+	}
+}
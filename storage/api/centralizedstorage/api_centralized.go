@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2025. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -22,7 +22,8 @@ import (
 var (
 	storageApiMap = map[string]string{
 		// system
-		"GetSystemInfo": "/system/",
+		"GetSystemInfo":    "/system/",
+		"GetSystemUtcTime": "/system_utc_time",
 
 		// filesystem
 		"CreateFileSystem":    "/filesystem",
@@ -32,23 +33,112 @@ var (
 		"GetFilesystemCount":  "/filesystem/count",
 
 		// performance
-		"PerformanceData":     "/performance_data?object_type={{.objectType}}&indicators={{.indicators}}",
-		"PerformanceDataPost": "/performance_data",
+		"PerformanceData":       "/performance_data?object_type={{.objectType}}&indicators={{.indicators}}",
+		"PerformanceDataPost":   "/performance_data",
+		"PerformanceIndicators": "/performance_indicator?object_type={{.objectType}}",
+		"PerformanceHistory":    "/performance_history_data",
 
 		// storage info
 		"GetStoragePools": "/storagepool",
 		"GetControllers":  "/controller",
+		"GetDiskPools":    "/diskpool",
+		"GetFCPorts":      "/fc_port?range=[{{.start}}-{{.end}}]",
+		"GetFCPortCount":  "/fc_port/count",
+		"GetETHPorts":     "/eth_port?range=[{{.start}}-{{.end}}]",
+		"GetETHPortCount": "/eth_port/count",
+
+		// hardware components
+		"GetEnclosures":           "/enclosure?range=[{{.start}}-{{.end}}]",
+		"GetEnclosureCount":       "/enclosure/count",
+		"GetPowerSupplies":        "/power?range=[{{.start}}-{{.end}}]",
+		"GetPowerSupplyCount":     "/power/count",
+		"GetFans":                 "/fan?range=[{{.start}}-{{.end}}]",
+		"GetFanCount":             "/fan/count",
+		"GetBBUs":                 "/backup_power?range=[{{.start}}-{{.end}}]",
+		"GetBBUCount":             "/backup_power/count",
+		"GetInterfaceModules":     "/intf_module?range=[{{.start}}-{{.end}}]",
+		"GetInterfaceModuleCount": "/intf_module/count",
 
 		// lun
 		"GetLuns":      "/lun?filter=SUBTYPE::0&range=[{{.start}}-{{.end}}]",
 		"GetLunCount":  "/lun/count",
 		"GetLunByName": "/lun?filter=NAME::{{.lunName}}&range=[0-100]",
+		"GetLunById":   "/lun/{{.id}}",
+
+		// snapshot
+		"GetSnapshots":       "/snapshot?range=[{{.start}}-{{.end}}]",
+		"GetSnapshotCount":   "/snapshot/count",
+		"GetSnapshotByName":  "/snapshot?filter=NAME::{{.name}}&range=[0-100]",
+		"GetSnapshotById":    "/snapshot/{{.id}}",
+		"GetFSSnapshots":     "/fssnapshot?PARENTID={{.parentId}}&range=[{{.start}}-{{.end}}]",
+		"GetFSSnapshotCount": "/fssnapshot/count?PARENTID={{.parentId}}",
+
+		// hypermetro and remote replication
+		"GetHyperMetroDomains":    "/HyperMetroDomain",
+		"GetFSHyperMetroDomains":  "/FsHyperMetroDomain",
+		"GetHyperMetroPairs":      "/HyperMetroPair?range=[{{.start}}-{{.end}}]",
+		"GetHyperMetroPairCount":  "/HyperMetroPair/count",
+		"GetReplicationPairs":     "/REPLICATIONPAIR?range=[{{.start}}-{{.end}}]",
+		"GetReplicationPairCount": "/REPLICATIONPAIR/count",
+
+		// share, dtree and quota
+		"GetNFSShares":           "/NFSHARE?range=[{{.start}}-{{.end}}]",
+		"GetNFSShareCount":       "/NFSHARE/count",
+		"GetNFSShareClientCount": "/NFS_SHARE_AUTH_CLIENT/count?filter=PARENTID::{{.parentId}}",
+		"GetCIFSShares":          "/CIFSHARE?range=[{{.start}}-{{.end}}]",
+		"GetCIFSShareCount":      "/CIFSHARE/count",
+		"GetCIFSShareACLCount":   "/CIFS_SHARE_AUTH_CLIENT/count?filter=PARENTID::{{.parentId}}",
+		"GetDTrees":              "/QUOTATREE?PARENTTYPE=40&PARENTID={{.parentId}}&range=[{{.start}}-{{.end}}]",
+		"GetDTreeCount":          "/QUOTATREE/count?PARENTTYPE=40&PARENTID={{.parentId}}",
+		"GetQuotas":              "/FS_QUOTA?PARENTTYPE={{.parentType}}&PARENTID={{.parentId}}&range=[{{.start}}-{{.end}}]",
+		"GetQuotaCount":          "/FS_QUOTA/count?PARENTTYPE={{.parentType}}&PARENTID={{.parentId}}",
+
+		// qos
+		"GetQoSPolicies":    "/ioclass?range=[{{.start}}-{{.end}}]",
+		"GetQoSPolicyCount": "/ioclass/count",
+
+		// vstore
+		"GetVStores":                 "/vstore?range=[{{.start}}-{{.end}}]",
+		"GetVStoreCount":             "/vstore/count",
+		"GetLunCountByVStore":        "/lun/count?vstoreId={{.vstoreId}}",
+		"GetFilesystemCountByVStore": "/filesystem/count?vstoreId={{.vstoreId}}",
+
+		// alarm
+		"GetCurrentAlarms":     "/alarm/currentalarm?range=[{{.start}}-{{.end}}]",
+		"GetCurrentAlarmCount": "/alarm/currentalarm/count",
+
+		// disk
+		"GetDisks":     "/disk?range=[{{.start}}-{{.end}}]",
+		"GetDiskCount": "/disk/count",
+
+		// host, host group, initiator and mapping view
+		"GetHosts":                   "/host?range=[{{.start}}-{{.end}}]",
+		"GetHostCount":               "/host/count",
+		"GetHostByName":              "/host?filter=NAME::{{.name}}&range=[0-100]",
+		"GetHostById":                "/host/{{.id}}",
+		"GetHostLuns":                "/lun/associate?ASSOCIATEOBJTYPE=21&ASSOCIATEOBJID={{.id}}&range=[{{.start}}-{{.end}}]",
+		"GetHostGroups":              "/hostgroup?range=[{{.start}}-{{.end}}]",
+		"GetHostGroupCount":          "/hostgroup/count",
+		"GetFCInitiators":            "/fc_initiator?range=[{{.start}}-{{.end}}]",
+		"GetFCInitiatorCount":        "/fc_initiator/count",
+		"GetISCSIInitiators":         "/iscsi_initiator?range=[{{.start}}-{{.end}}]",
+		"GetISCSIInitiatorCount":     "/iscsi_initiator/count",
+		"GetMappingViews":            "/mappingview?range=[{{.start}}-{{.end}}]",
+		"GetMappingViewCount":        "/mappingview/count",
+		"GetHostGroupsByMappingView": "/hostgroup/associate?ASSOCIATEOBJTYPE=245&ASSOCIATEOBJID={{.id}}",
+		"GetLunGroupsByMappingView":  "/lungroup/associate?ASSOCIATEOBJTYPE=245&ASSOCIATEOBJID={{.id}}",
 
 		// label
-		"CreatePvLabel":  "/container_pv",
-		"DeletePvLabel":  "/container_pv",
-		"CreatePodLabel": "/container_pod",
-		"DeletePodLabel": "/container_pod",
+		"CreatePvLabel":          "/container_pv",
+		"DeletePvLabel":          "/container_pv",
+		"CreatePodLabel":         "/container_pod",
+		"DeletePodLabel":         "/container_pod",
+		"GetPvLabels":            "/container_pv?range=[{{.start}}-{{.end}}]",
+		"GetPvLabelCount":        "/container_pv/count",
+		"GetPvLabelsByResource":  "/container_pv?resourceId={{.resourceId}}&resourceType={{.resourceType}}",
+		"GetPodLabels":           "/container_pod?range=[{{.start}}-{{.end}}]",
+		"GetPodLabelCount":       "/container_pod/count",
+		"GetPodLabelsByResource": "/container_pod?resourceId={{.resourceId}}&resourceType={{.resourceType}}",
 	}
 
 	storageApis = make(map[string]*api.StorageApi)
@@ -0,0 +1,71 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage api
+package distributedstorage
+
+import (
+	"github.com/huawei/csm/v2/storage/api"
+)
+
+var (
+	storageApiMap = map[string]string{
+		// session
+		"Sessions": "/api/v2/aa/sessions",
+
+		// system
+		"GetSystemInfo": "/api/v2/cluster/product",
+
+		// storage pool
+		"GetStoragePools": "/api/v2/data_service/storagepool",
+
+		// volume
+		"GetVolumes":      "/api/v2/block_service/volumes?offset={{.start}}&limit={{.limit}}",
+		"GetVolumeCount":  "/api/v2/block_service/volumes/count",
+		"GetVolumeByName": "/api/v2/block_service/volumes?name={{.name}}",
+
+		// filesystem
+		"GetFileSystems":      "/api/v2/converged_service/namespaces?offset={{.start}}&limit={{.limit}}",
+		"GetFileSystemCount":  "/api/v2/converged_service/namespaces/count",
+		"GetFileSystemByName": "/api/v2/converged_service/namespaces?name={{.name}}",
+
+		// performance
+		"PerformanceData": "/api/v2/pms/performance_data?object_type={{.objectType}}&indicators={{.indicators}}",
+
+		// label
+		"CreatePvLabel":   "/api/v2/container_service/container_pv",
+		"DeletePvLabel":   "/api/v2/container_service/container_pv",
+		"CreatePodLabel":  "/api/v2/container_service/container_pod",
+		"DeletePodLabel":  "/api/v2/container_service/container_pod",
+		"GetPvLabels":     "/api/v2/container_service/container_pv?offset={{.start}}&limit={{.limit}}",
+		"GetPvLabelCount": "/api/v2/container_service/container_pv/count",
+		"GetPvLabelsByResource": "/api/v2/container_service/container_pv" +
+			"?resourceId={{.resourceId}}&resourceType={{.resourceType}}",
+		"GetPodLabels":     "/api/v2/container_service/container_pod?offset={{.start}}&limit={{.limit}}",
+		"GetPodLabelCount": "/api/v2/container_service/container_pod/count",
+		"GetPodLabelsByResource": "/api/v2/container_service/container_pod" +
+			"?resourceId={{.resourceId}}&resourceType={{.resourceType}}",
+	}
+
+	storageApis = make(map[string]*api.StorageApi)
+)
+
+func init() {
+	api.RegisterStorageApi(storageApiMap, storageApis)
+}
+
+// GenerateUrl is used to generate distributed storage request url
+func GenerateUrl(name string, args map[string]interface{}) (string, error) {
+	return api.GenerateUrl(storageApis, name, args)
+}
@@ -0,0 +1,85 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package client is related with storage common client and operation
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// CallRecord is the snapshot of CallState
+type CallRecord struct {
+	LastSuccessTime time.Time
+	LastErrorTime   time.Time
+	LastError       string
+}
+
+// Available the storage is available if the latest call is succeeded
+func (r CallRecord) Available() bool {
+	return !r.LastSuccessTime.IsZero() && !r.LastErrorTime.After(r.LastSuccessTime)
+}
+
+// CallState records the results of the calls to storage, which is used to report the backend state
+type CallState struct {
+	mutex    sync.RWMutex
+	record   CallRecord
+	observer func(CallRecord)
+}
+
+// SetObserver set the function which is called with the new record when the storage becomes available
+// or unavailable, it is called out of the lock, so it may call GetRecord
+func (s *CallState) SetObserver(observer func(CallRecord)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.observer = observer
+}
+
+// Record records the result of a call, nil error means the call is succeeded
+func (s *CallState) Record(err error) {
+	s.mutex.Lock()
+	available := s.record.Available()
+	if err == nil {
+		s.record.LastSuccessTime = time.Now()
+	} else {
+		s.record.LastErrorTime = time.Now()
+		s.record.LastError = err.Error()
+	}
+	record, observer := s.record, s.observer
+	s.mutex.Unlock()
+
+	if observer != nil && record.Available() != available {
+		observer(record)
+	}
+}
+
+// GetRecord returns the snapshot of call results
+func (s *CallState) GetRecord() CallRecord {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.record
+}
+
+// GetCallRecord returns the snapshot of the call results of client
+func (c *Client) GetCallRecord() CallRecord {
+	return c.CallState.GetRecord()
+}
+
+// ObserveCallState set the function which is called when the storage becomes available or unavailable
+func (c *Client) ObserveCallState(observer func(CallRecord)) {
+	c.CallState.SetObserver(observer)
+}
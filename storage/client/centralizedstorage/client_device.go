@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2022. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -38,3 +38,20 @@ func (c *CentralizedClient) GetSystemInfo(ctx context.Context) (map[string]inter
 	result, _, err := c.getResultFromResponse(ctx, resp)
 	return result, err
 }
+
+// GetSystemUtcTime is used to get the current utc time of storage, the time is the seconds since the epoch
+func (c *CentralizedClient) GetSystemUtcTime(ctx context.Context) (map[string]interface{}, error) {
+	url, err := centralizedstorage.GenerateUrl("GetSystemUtcTime", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.get(ctx, url, nil)
+	if err != nil {
+		log.AddContext(ctx).Errorf("storage client get system utc time error: %v", err)
+		return nil, err
+	}
+
+	result, _, err := c.getResultFromResponse(ctx, resp)
+	return result, err
+}
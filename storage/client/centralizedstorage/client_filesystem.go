@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2022. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -48,6 +48,11 @@ func (c *CentralizedClient) GetFileSystemByName(ctx context.Context, name string
 	return c.Client.RetryCall(ctx, filesystem.GetRetryCodes(), callFunc)
 }
 
+// GetFileSystemById get filesystem by id, an empty result will be returned if the filesystem does not exist
+func (c *CentralizedClient) GetFileSystemById(ctx context.Context, id string) (map[string]interface{}, error) {
+	return c.getById(ctx, id, "GetFileSystemById", filesystem.FileSystemNotExist)
+}
+
 // GetFileSystemIdByName get filesystem id by name
 func (c *CentralizedClient) GetFileSystemIdByName(ctx context.Context, name string) (string, error) {
 	data, err := c.GetFileSystemByName(ctx, name)
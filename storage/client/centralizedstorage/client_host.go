@@ -0,0 +1,140 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+
+	"github.com/huawei/csm/v2/storage/api/centralizedstorage"
+	"github.com/huawei/csm/v2/storage/httpcode"
+	"github.com/huawei/csm/v2/storage/httpcode/host"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// GetHosts is used to get hosts information
+func (c *CentralizedClient) GetHosts(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetHosts")
+}
+
+// GetHostCount used to get host count
+func (c *CentralizedClient) GetHostCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetHostCount")
+}
+
+// GetHostByName used to get host by name
+func (c *CentralizedClient) GetHostByName(ctx context.Context, name string) (map[string]interface{}, error) {
+	url, err := centralizedstorage.GenerateUrl("GetHostByName", map[string]interface{}{"name": name})
+	if err != nil {
+		log.AddContext(ctx).Errorf("storage client get host by name generate url error: %v", err)
+		return nil, err
+	}
+
+	callFunc := func() (map[string]interface{}, *float64, error) {
+		resp, err := c.get(ctx, url, nil)
+		if err != nil {
+			log.AddContext(ctx).Errorf("storage client get host by name error: %v", err)
+			return nil, nil, err
+		}
+
+		return c.getResultFromResponseList(ctx, resp)
+	}
+
+	return c.Client.RetryCall(ctx, httpcode.RetryCodes, callFunc)
+}
+
+// GetHostById used to get host by id, an empty result will be returned if the host does not exist
+func (c *CentralizedClient) GetHostById(ctx context.Context, id string) (map[string]interface{}, error) {
+	return c.getById(ctx, id, "GetHostById", host.HostNotExist)
+}
+
+// GetHostLuns used to get one page of the luns mapped to the host
+func (c *CentralizedClient) GetHostLuns(ctx context.Context, hostId string, start,
+	end int) ([]map[string]interface{}, error) {
+	return c.pageQueryWithArgs(ctx, start, end, "GetHostLuns", map[string]interface{}{"id": hostId})
+}
+
+// GetHostGroups is used to get host groups information
+func (c *CentralizedClient) GetHostGroups(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetHostGroups")
+}
+
+// GetHostGroupCount used to get host group count
+func (c *CentralizedClient) GetHostGroupCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetHostGroupCount")
+}
+
+// GetFCInitiators is used to get fc initiators information
+func (c *CentralizedClient) GetFCInitiators(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetFCInitiators")
+}
+
+// GetFCInitiatorCount used to get fc initiator count
+func (c *CentralizedClient) GetFCInitiatorCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetFCInitiatorCount")
+}
+
+// GetISCSIInitiators is used to get iscsi initiators information
+func (c *CentralizedClient) GetISCSIInitiators(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetISCSIInitiators")
+}
+
+// GetISCSIInitiatorCount used to get iscsi initiator count
+func (c *CentralizedClient) GetISCSIInitiatorCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetISCSIInitiatorCount")
+}
+
+// GetMappingViews is used to get mapping views information
+func (c *CentralizedClient) GetMappingViews(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetMappingViews")
+}
+
+// GetMappingViewCount used to get mapping view count
+func (c *CentralizedClient) GetMappingViewCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetMappingViewCount")
+}
+
+// GetHostGroupsByMappingView used to get the host groups associated with the mapping view
+func (c *CentralizedClient) GetHostGroupsByMappingView(ctx context.Context,
+	mappingViewId string) ([]map[string]interface{}, error) {
+	return c.associateQuery(ctx, mappingViewId, "GetHostGroupsByMappingView")
+}
+
+// GetLunGroupsByMappingView used to get the lun groups associated with the mapping view
+func (c *CentralizedClient) GetLunGroupsByMappingView(ctx context.Context,
+	mappingViewId string) ([]map[string]interface{}, error) {
+	return c.associateQuery(ctx, mappingViewId, "GetLunGroupsByMappingView")
+}
+
+// associateQuery is used to query the objects associated with the specified object
+func (c *CentralizedClient) associateQuery(ctx context.Context, id, urlKey string) ([]map[string]interface{}, error) {
+	url, err := centralizedstorage.GenerateUrl(urlKey, map[string]interface{}{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	callFunc := func() ([]map[string]interface{}, *float64, error) {
+		resp, err := c.get(ctx, url, nil)
+		if err != nil {
+			log.AddContext(ctx).Errorf("associate query failed, url: %s error: %v", urlKey, err)
+			return nil, nil, err
+		}
+
+		return c.getResultListFromResponseList(ctx, resp)
+	}
+
+	return c.Client.RetryListCall(ctx, httpcode.RetryCodes, callFunc)
+}
@@ -0,0 +1,82 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/storage/client"
+)
+
+func TestCentralizedClient_GetHostLuns(t *testing.T) {
+	// arrange
+	var gotUrl string
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrl = url
+			return mockGetresponse, nil
+		})
+	defer patches.Reset()
+
+	// action
+	_, err := centralizedCli.GetHostLuns(context.Background(), "1", 0, 100)
+
+	// assert
+	if err != nil {
+		t.Errorf("GetHostLuns() error = %v", err)
+		return
+	}
+	if !strings.HasSuffix(gotUrl, "/lun/associate?ASSOCIATEOBJTYPE=21&ASSOCIATEOBJID=1&range=[0-100]") {
+		t.Errorf("GetHostLuns() got url = %s", gotUrl)
+	}
+}
+
+func TestCentralizedClient_associateQuery(t *testing.T) {
+	httpGet := MockHttpGet(mockGetresponse)
+	defer httpGet.Reset()
+
+	tests := []struct {
+		name   string
+		urlKey string
+	}{
+		{
+			name:   "TestGetHostGroupsByMappingView",
+			urlKey: "GetHostGroupsByMappingView",
+		},
+		{
+			name:   "TestGetLunGroupsByMappingView",
+			urlKey: "GetLunGroupsByMappingView",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := centralizedCli.associateQuery(context.Background(), "1", tt.urlKey)
+			if err != nil {
+				t.Errorf("associateQuery() error = %v,", err)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2022. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/huawei/csm/v2/storage/client"
 	"github.com/huawei/csm/v2/storage/httpcode"
 	"github.com/huawei/csm/v2/utils/log"
 )
@@ -58,6 +59,13 @@ func (c *CentralizedClient) callCentralizedStorage(ctx context.Context, method s
 		return c.baseCall(ctx, method, methodUrl, reqData)
 	}
 
+	response, err := c.callWithReLogin(ctx, method, methodUrl, reqData)
+	c.CallState.Record(err)
+	return response, err
+}
+
+func (c *CentralizedClient) callWithReLogin(ctx context.Context, method string,
+	methodUrl string, reqData map[string]interface{}) (*Response, error) {
 	response, err := c.baseCall(ctx, method, methodUrl, reqData)
 	if err != nil {
 		return c.reLoginCall(ctx, method, methodUrl, reqData)
@@ -82,7 +90,7 @@ func (c *CentralizedClient) baseCall(ctx context.Context, method string,
 	url := c.getRequestUrl(methodUrl)
 	response, err := c.Call(ctx, method, url, reqData)
 	if err != nil && strings.Contains(err.Error(), "x509") {
-		if err = c.initHttpClient(ctx); err != nil {
+		if err = c.InitHttpClient(ctx); err != nil {
 			return nil, err
 		}
 
@@ -259,10 +267,9 @@ func (c *CentralizedClient) checkResponseCode(ctx context.Context, response *Res
 	}
 
 	if respCode != httpcode.SuccessCode {
-		msg := fmt.Sprintf("storage client response httpcode is not success code, "+
-			"code: %v, description: %v", respCode, response.Error["description"])
-		log.AddContext(ctx).Errorln(msg)
-		return &respCode, errors.New(msg)
+		err := &client.ResponseCodeError{Code: respCode, Description: response.Error["description"]}
+		log.AddContext(ctx).Errorln(err)
+		return &respCode, err
 	}
 
 	return &respCode, nil
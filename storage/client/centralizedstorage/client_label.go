@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -22,29 +22,14 @@ import (
 	"fmt"
 
 	"github.com/huawei/csm/v2/storage/api/centralizedstorage"
+	"github.com/huawei/csm/v2/storage/client"
 	"github.com/huawei/csm/v2/storage/httpcode"
 	"github.com/huawei/csm/v2/storage/httpcode/label"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
-// PvLabelRequest create pv label request
-type PvLabelRequest struct {
-	ResourceId   string
-	ResourceType string
-	PvName       string
-	ClusterName  string
-}
-
-// PodLabelRequest create and delete label request
-type PodLabelRequest struct {
-	ResourceId   string
-	ResourceType string
-	PodName      string
-	NameSpace    string
-}
-
 // CreatePvLabel create pv label
-func (c *CentralizedClient) CreatePvLabel(ctx context.Context, request PvLabelRequest) (map[string]interface{},
+func (c *CentralizedClient) CreatePvLabel(ctx context.Context, request client.PvLabelRequest) (map[string]interface{},
 	error) {
 	data := map[string]interface{}{
 		"resourceId":   request.ResourceId,
@@ -68,7 +53,7 @@ func (c *CentralizedClient) DeletePvLabel(ctx context.Context, requestId, resour
 }
 
 // CreatePodLabel create pod label
-func (c *CentralizedClient) CreatePodLabel(ctx context.Context, request PodLabelRequest) (map[string]interface{},
+func (c *CentralizedClient) CreatePodLabel(ctx context.Context, request client.PodLabelRequest) (map[string]interface{},
 	error) {
 	data := map[string]interface{}{
 		"resourceId":   request.ResourceId,
@@ -81,7 +66,7 @@ func (c *CentralizedClient) CreatePodLabel(ctx context.Context, request PodLabel
 }
 
 // DeletePodLabel delete pod label
-func (c *CentralizedClient) DeletePodLabel(ctx context.Context, request PodLabelRequest) (map[string]interface{},
+func (c *CentralizedClient) DeletePodLabel(ctx context.Context, request client.PodLabelRequest) (map[string]interface{},
 	error) {
 	data := map[string]interface{}{
 		"resourceId":   request.ResourceId,
@@ -93,6 +78,65 @@ func (c *CentralizedClient) DeletePodLabel(ctx context.Context, request PodLabel
 	return c.DeleteLabel(ctx, "DeletePodLabel", data, label.PodLabelNotExist)
 }
 
+// GetPvLabels page query pv labels
+func (c *CentralizedClient) GetPvLabels(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetPvLabels")
+}
+
+// GetPvLabelCount get the count of pv labels
+func (c *CentralizedClient) GetPvLabelCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetPvLabelCount")
+}
+
+// GetPvLabelsByResource get pv labels of the specified resource
+func (c *CentralizedClient) GetPvLabelsByResource(ctx context.Context, resourceId,
+	resourceType string) ([]map[string]interface{}, error) {
+	return c.GetLabelsByResource(ctx, "GetPvLabelsByResource", resourceId, resourceType)
+}
+
+// GetPodLabels page query pod labels
+func (c *CentralizedClient) GetPodLabels(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetPodLabels")
+}
+
+// GetPodLabelCount get the count of pod labels
+func (c *CentralizedClient) GetPodLabelCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetPodLabelCount")
+}
+
+// GetPodLabelsByResource get pod labels of the specified resource
+func (c *CentralizedClient) GetPodLabelsByResource(ctx context.Context, resourceId,
+	resourceType string) ([]map[string]interface{}, error) {
+	return c.GetLabelsByResource(ctx, "GetPodLabelsByResource", resourceId, resourceType)
+}
+
+// GetLabelsByResource get labels of the specified resource
+func (c *CentralizedClient) GetLabelsByResource(ctx context.Context, urlKey, resourceId,
+	resourceType string) ([]map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"resourceId":   resourceId,
+		"resourceType": resourceType,
+	}
+
+	url, err := centralizedstorage.GenerateUrl(urlKey, data)
+	if err != nil {
+		log.AddContext(ctx).Errorf("get labels get url failed, url: %s, error: %v", urlKey, err)
+		return nil, err
+	}
+
+	callFunc := func() ([]map[string]interface{}, *float64, error) {
+		resp, err := c.get(ctx, url, nil)
+		if err != nil {
+			log.AddContext(ctx).Errorf("get labels failed, url: %s, error: %v", urlKey, err)
+			return nil, nil, err
+		}
+
+		return c.getResultListFromResponseList(ctx, resp)
+	}
+
+	return c.Client.RetryListCall(ctx, httpcode.RetryCodes, callFunc)
+}
+
 // CreateLabel create label
 func (c *CentralizedClient) CreateLabel(ctx context.Context, urlKey string, data map[string]interface{},
 	permittedCode float64) (map[string]interface{}, error) {
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -92,3 +92,31 @@ func TestGetResponse_ErrorCode(t *testing.T) {
 		t.Errorf("getResponse() expected error for error code")
 	}
 }
+
+func Test_CentralizedClient_GetLabelsByResource(t *testing.T) {
+	var cli *client.Client
+	var centralizedCli = &CentralizedClient{
+		Client: client.Client{Semaphore: utils.NewSemaphore(3)},
+	}
+	var want = []map[string]interface{}{{"resourceId": "1", "resourceType": "11", "podName": "pod-1"}}
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(cli), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": float64(0),
+				},
+				"data": []interface{}{
+					map[string]interface{}{"resourceId": "1", "resourceType": "11", "podName": "pod-1"},
+				},
+			}, nil
+		})
+	defer patches.Reset()
+
+	got, err := centralizedCli.GetPodLabelsByResource(context.Background(), "1", "11")
+
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Test_CentralizedClient_GetLabelsByResource() want = %v, got = %v, error: %v", want, got, err)
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -25,6 +25,7 @@ import (
 	"github.com/huawei/csm/v2/storage/api/centralizedstorage"
 	"github.com/huawei/csm/v2/storage/httpcode"
 	"github.com/huawei/csm/v2/storage/httpcode/filesystem"
+	"github.com/huawei/csm/v2/storage/httpcode/lun"
 	"github.com/huawei/csm/v2/utils/log"
 )
 
@@ -68,6 +69,11 @@ func (c *CentralizedClient) GetLunByName(ctx context.Context, name string) (map[
 	return c.Client.RetryCall(ctx, filesystem.GetRetryCodes(), callFunc)
 }
 
+// GetLunById used to get lun by id, an empty result will be returned if the lun does not exist
+func (c *CentralizedClient) GetLunById(ctx context.Context, id string) (map[string]interface{}, error) {
+	return c.getById(ctx, id, "GetLunById", lun.LunNotExist)
+}
+
 // GetLunIdByName used to get lun id by name
 func (c *CentralizedClient) GetLunIdByName(ctx context.Context, name string) (string, error) {
 	data, err := c.GetLunByName(ctx, name)
@@ -83,7 +89,12 @@ func (c *CentralizedClient) GetLunIdByName(ctx context.Context, name string) (st
 
 // countQuery used to query count information
 func (c *CentralizedClient) countQuery(ctx context.Context, urlKey string) (int, error) {
-	data := map[string]interface{}{}
+	return c.countQueryWithArgs(ctx, urlKey, map[string]interface{}{})
+}
+
+// countQueryWithArgs used to query count information with the url arguments, e.g. the associated object id
+func (c *CentralizedClient) countQueryWithArgs(ctx context.Context, urlKey string,
+	data map[string]interface{}) (int, error) {
 	url, err := centralizedstorage.GenerateUrl(urlKey, data)
 	if err != nil {
 		return 0, err
@@ -119,13 +130,21 @@ func (c *CentralizedClient) countQuery(ctx context.Context, urlKey string) (int,
 // pageQuery is used to page query
 func (c *CentralizedClient) pageQuery(ctx context.Context, start, end int,
 	urlKey string) ([]map[string]interface{}, error) {
+	return c.pageQueryWithArgs(ctx, start, end, urlKey, map[string]interface{}{})
+}
 
-	data := map[string]interface{}{
+// pageQueryWithArgs used to query one page of objects with the url arguments, e.g. the parent object id
+func (c *CentralizedClient) pageQueryWithArgs(ctx context.Context, start, end int, urlKey string,
+	data map[string]interface{}) ([]map[string]interface{}, error) {
+	args := map[string]interface{}{
 		"start": start,
 		"end":   end,
 	}
+	for key, value := range data {
+		args[key] = value
+	}
 
-	url, err := centralizedstorage.GenerateUrl(urlKey, data)
+	url, err := centralizedstorage.GenerateUrl(urlKey, args)
 	if err != nil {
 		return nil, err
 	}
@@ -142,3 +161,34 @@ func (c *CentralizedClient) pageQuery(ctx context.Context, start, end int,
 
 	return c.Client.RetryListCall(ctx, httpcode.RetryCodes, callFunc)
 }
+
+// getById is used to query one object by id, an empty result will be returned if the object does not exist
+func (c *CentralizedClient) getById(ctx context.Context, id, urlKey string,
+	notExistCode float64) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"id": id,
+	}
+
+	url, err := centralizedstorage.GenerateUrl(urlKey, data)
+	if err != nil {
+		return nil, err
+	}
+
+	callFunc := func() (map[string]interface{}, *float64, error) {
+		resp, err := c.get(ctx, url, nil)
+		if err != nil {
+			log.AddContext(ctx).Errorf("query by id failed, url: %s error: %v", urlKey, err)
+			return nil, nil, err
+		}
+
+		respCode, err := getResponseCode(resp)
+		if err == nil && *respCode == notExistCode {
+			log.AddContext(ctx).Infof("object [%s] does not exist, url: %s", id, urlKey)
+			return map[string]interface{}{}, respCode, nil
+		}
+
+		return c.getResultFromResponse(ctx, resp)
+	}
+
+	return c.Client.RetryCall(ctx, httpcode.RetryCodes, callFunc)
+}
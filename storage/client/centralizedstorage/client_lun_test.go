@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -46,6 +46,70 @@ func TestCentralizedClient_countQuery(t *testing.T) {
 			name:   "TestGetLunCount",
 			urlKey: "GetLunCount",
 		},
+		{
+			name:   "TestGetDiskCount",
+			urlKey: "GetDiskCount",
+		},
+		{
+			name:   "TestGetSnapshotCount",
+			urlKey: "GetSnapshotCount",
+		},
+		{
+			name:   "TestGetHyperMetroPairCount",
+			urlKey: "GetHyperMetroPairCount",
+		},
+		{
+			name:   "TestGetReplicationPairCount",
+			urlKey: "GetReplicationPairCount",
+		},
+		{
+			name:   "TestGetNFSShareCount",
+			urlKey: "GetNFSShareCount",
+		},
+		{
+			name:   "TestGetCIFSShareCount",
+			urlKey: "GetCIFSShareCount",
+		},
+		{
+			name:   "TestGetQoSPolicyCount",
+			urlKey: "GetQoSPolicyCount",
+		},
+		{
+			name:   "TestGetCurrentAlarmCount",
+			urlKey: "GetCurrentAlarmCount",
+		},
+		{
+			name:   "TestGetVStoreCount",
+			urlKey: "GetVStoreCount",
+		},
+		{
+			name:   "TestGetFCPortCount",
+			urlKey: "GetFCPortCount",
+		},
+		{
+			name:   "TestGetETHPortCount",
+			urlKey: "GetETHPortCount",
+		},
+		{
+			name:   "TestGetEnclosureCount",
+			urlKey: "GetEnclosureCount",
+		},
+		{
+			name:   "TestGetPowerSupplyCount",
+			urlKey: "GetPowerSupplyCount",
+		},
+		{
+			name:   "TestGetFanCount",
+			urlKey: "GetFanCount",
+		},
+		{
+			name:   "TestGetBBUCount",
+			urlKey: "GetBBUCount",
+		},
+		{
+			name:   "TestGetInterfaceModuleCount",
+			urlKey: "GetInterfaceModuleCount",
+		},
 	}
 
 	for _, tt := range tests {
@@ -74,6 +138,70 @@ func TestCentralizedClient_pageQuery(t *testing.T) {
 			name:   "TestGetLuns",
 			urlKey: "GetLuns",
 		},
+		{
+			name:   "TestGetDisks",
+			urlKey: "GetDisks",
+		},
+		{
+			name:   "TestGetSnapshots",
+			urlKey: "GetSnapshots",
+		},
+		{
+			name:   "TestGetHyperMetroPairs",
+			urlKey: "GetHyperMetroPairs",
+		},
+		{
+			name:   "TestGetReplicationPairs",
+			urlKey: "GetReplicationPairs",
+		},
+		{
+			name:   "TestGetNFSShares",
+			urlKey: "GetNFSShares",
+		},
+		{
+			name:   "TestGetCIFSShares",
+			urlKey: "GetCIFSShares",
+		},
+		{
+			name:   "TestGetQoSPolicies",
+			urlKey: "GetQoSPolicies",
+		},
+		{
+			name:   "TestGetCurrentAlarms",
+			urlKey: "GetCurrentAlarms",
+		},
+		{
+			name:   "TestGetVStores",
+			urlKey: "GetVStores",
+		},
+		{
+			name:   "TestGetFCPorts",
+			urlKey: "GetFCPorts",
+		},
+		{
+			name:   "TestGetETHPorts",
+			urlKey: "GetETHPorts",
+		},
+		{
+			name:   "TestGetEnclosures",
+			urlKey: "GetEnclosures",
+		},
+		{
+			name:   "TestGetPowerSupplies",
+			urlKey: "GetPowerSupplies",
+		},
+		{
+			name:   "TestGetFans",
+			urlKey: "GetFans",
+		},
+		{
+			name:   "TestGetBBUs",
+			urlKey: "GetBBUs",
+		},
+		{
+			name:   "TestGetInterfaceModules",
+			urlKey: "GetInterfaceModules",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,6 +19,7 @@ package centralizedstorage
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/huawei/csm/v2/storage/api/centralizedstorage"
@@ -83,3 +84,171 @@ func (c *CentralizedClient) GetPerformanceByPost(ctx context.Context, objectType
 	}
 	return c.Client.RetryListCall(ctx, httpcode.RetryCodes, callFunc)
 }
+
+const (
+	// historyQueryWindow the max time range of one historical performance query to the storage, in seconds
+	historyQueryWindow int64 = 3600
+	// millisecondTimestampThreshold the timestamps not less than it are in milliseconds
+	millisecondTimestampThreshold int64 = 1e12
+	millisecondsPerSecond         int64 = 1000
+	float64BitSize                      = 64
+)
+
+// GetHistoryPerformance query the historical performance of the storage in the time range, the times are
+// in seconds since epoch, and each data contains the timestamp of the sample in seconds.
+// The time range is queried in windows of historyQueryWindow, so that one response of the storage is bounded,
+// and the samples at the boundary of two windows are only returned once.
+// If the interval is not positive, the default sample interval of the storage will be used.
+func (c *CentralizedClient) GetHistoryPerformance(ctx context.Context, objectType int, indicators []int,
+	startTime, endTime, interval int64) ([]map[string]interface{}, error) {
+	url, err := centralizedstorage.GenerateUrl("PerformanceHistory", nil)
+	if err != nil {
+		log.AddContext(ctx).Errorf("get history performance url error: %v", err)
+		return nil, err
+	}
+
+	var result []map[string]interface{}
+	seen := make(map[string]struct{})
+	for windowStart := startTime; windowStart < endTime; windowStart += historyQueryWindow {
+		windowEnd := min(windowStart+historyQueryWindow, endTime)
+		data, err := c.getHistoryPerformanceInWindow(ctx, url, objectType, indicators,
+			windowStart, windowEnd, interval)
+		if err != nil {
+			return nil, err
+		}
+		for _, performance := range data {
+			key := fmt.Sprintf("%v-%v", performance["object_id"], performance["timestamp"])
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			result = append(result, performance)
+		}
+	}
+	return result, nil
+}
+
+func (c *CentralizedClient) getHistoryPerformanceInWindow(ctx context.Context, url string, objectType int,
+	indicators []int, startTime, endTime, interval int64) ([]map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"object_type": objectType,
+		"indicators":  indicators,
+		"start_time":  startTime,
+		"end_time":    endTime,
+	}
+	if interval > 0 {
+		data["interval"] = interval
+	}
+
+	callFunc := func() ([]map[string]interface{}, *float64, error) {
+		resp, err := c.post(ctx, url, data)
+		if err != nil {
+			log.AddContext(ctx).Errorf("get history performance error: %v", err)
+			return nil, nil, err
+		}
+
+		return c.getResultListFromResponseList(ctx, resp)
+	}
+	rows, err := c.Client.RetryListCall(ctx, httpcode.RetryCodes, callFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		performance, err := parseHistoryPerformance(row)
+		if err != nil {
+			log.AddContext(ctx).Errorf("parse history performance %v error: %v", row, err)
+			return nil, err
+		}
+		result = append(result, performance)
+	}
+	return result, nil
+}
+
+// parseHistoryPerformance convert one sample of the storage to the performance data, the numbers of the storage
+// may be strings, and the timestamp in milliseconds is converted to seconds
+func parseHistoryPerformance(row map[string]interface{}) (map[string]interface{}, error) {
+	objectId, err := parseString(row["object_id"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid object_id: %w", err)
+	}
+	timestamp, err := parseFloat(row["timestamp"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	rawIndicators, _ := row["indicators"].([]interface{})
+	rawValues, _ := row["indicator_values"].([]interface{})
+	if len(rawIndicators) != len(rawValues) {
+		return nil, fmt.Errorf("indicators count %d mismatch values count %d", len(rawIndicators), len(rawValues))
+	}
+
+	indicators := make([]int, len(rawIndicators))
+	values := make([]float64, len(rawValues))
+	for i := range rawIndicators {
+		indicator, err := parseFloat(rawIndicators[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid indicator: %w", err)
+		}
+		indicators[i] = int(indicator)
+		if values[i], err = parseFloat(rawValues[i]); err != nil {
+			return nil, fmt.Errorf("invalid value of indicator %d: %w", indicators[i], err)
+		}
+	}
+
+	seconds := int64(timestamp)
+	if seconds >= millisecondTimestampThreshold {
+		seconds /= millisecondsPerSecond
+	}
+	return map[string]interface{}{
+		"object_id":        objectId,
+		"indicators":       indicators,
+		"indicator_values": values,
+		"timestamp":        seconds,
+	}, nil
+}
+
+func parseString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, float64BitSize), nil
+	default:
+		return "", fmt.Errorf("unexpected type %T", value)
+	}
+}
+
+func parseFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, float64BitSize)
+	default:
+		return 0, fmt.Errorf("unexpected type %T", value)
+	}
+}
+
+// GetPerformanceIndicators query the performance indicators which are available for the object type
+func (c *CentralizedClient) GetPerformanceIndicators(ctx context.Context,
+	objectType int) ([]map[string]interface{}, error) {
+	url, err := centralizedstorage.GenerateUrl("PerformanceIndicators", map[string]interface{}{
+		"objectType": objectType,
+	})
+	if err != nil {
+		log.AddContext(ctx).Errorf("get performance indicators url error: %v", err)
+		return nil, err
+	}
+
+	callFunc := func() ([]map[string]interface{}, *float64, error) {
+		resp, err := c.get(ctx, url, nil)
+		if err != nil {
+			log.AddContext(ctx).Errorf("get performance indicators error: %v", err)
+			return nil, nil, err
+		}
+
+		return c.getResultListFromResponseList(ctx, resp)
+	}
+	return c.Client.RetryListCall(ctx, httpcode.RetryCodes, callFunc)
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2024. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -19,6 +19,7 @@ package centralizedstorage
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -80,3 +81,103 @@ func TestCentralizedClient_GetPerformanceByPost_RetrySuccess(t *testing.T) {
 		p.Reset()
 	})
 }
+
+func TestCentralizedClient_GetPerformanceIndicators(t *testing.T) {
+	httpGet := MockHttpGet(mockGetresponse)
+	defer httpGet.Reset()
+
+	_, err := centralizedCli.GetPerformanceIndicators(context.Background(), 11)
+	if err != nil {
+		t.Errorf("GetPerformanceIndicators() error = %v,", err)
+	}
+}
+
+func TestCentralizedClient_GetHistoryPerformance(t *testing.T) {
+	// arrange
+	var gotUrl string
+	var gotReqData map[string]interface{}
+	want := map[string]interface{}{"object_type": 11, "indicators": []int{22}, "start_time": int64(1700000000),
+		"end_time": int64(1700000300), "interval": int64(60)}
+
+	// mock
+	p := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrl, gotReqData = url, reqData
+			return mockGetresponse, nil
+		})
+	defer p.Reset()
+
+	// action
+	_, err := centralizedCli.GetHistoryPerformance(context.Background(), 11, []int{22},
+		1700000000, 1700000300, 60)
+
+	// assert
+	if err != nil {
+		t.Errorf("GetHistoryPerformance() error = %v", err)
+		return
+	}
+	if !strings.HasSuffix(gotUrl, "/performance_history_data") || !reflect.DeepEqual(want, gotReqData) {
+		t.Errorf("GetHistoryPerformance() got url = %s, request = %v, want request = %v", gotUrl, gotReqData, want)
+	}
+}
+
+func TestCentralizedClient_GetHistoryPerformance_InWindows(t *testing.T) {
+	// arrange
+	var gotWindows [][2]interface{}
+	sample := map[string]interface{}{"object_id": "0", "timestamp": float64(1700003600000),
+		"indicators": []interface{}{float64(22)}, "indicator_values": []interface{}{"12.5"}}
+	want := []map[string]interface{}{{"object_id": "0", "timestamp": int64(1700003600),
+		"indicators": []int{22}, "indicator_values": []float64{12.5}}}
+
+	// mock
+	p := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotWindows = append(gotWindows, [2]interface{}{reqData["start_time"], reqData["end_time"]})
+			return map[string]interface{}{"Error": map[string]interface{}{"code": float64(0)},
+				"Data": []interface{}{sample}}, nil
+		})
+	defer p.Reset()
+
+	// action
+	got, err := centralizedCli.GetHistoryPerformance(context.Background(), 11, []int{22},
+		1700000000, 1700005000, 0)
+
+	// assert
+	if err != nil {
+		t.Errorf("GetHistoryPerformance() error = %v", err)
+		return
+	}
+	wantWindows := [][2]interface{}{{int64(1700000000), int64(1700003600)}, {int64(1700003600), int64(1700005000)}}
+	if !reflect.DeepEqual(wantWindows, gotWindows) {
+		t.Errorf("GetHistoryPerformance() got windows = %v, want %v", gotWindows, wantWindows)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("GetHistoryPerformance() got = %v, want %v", got, want)
+	}
+}
+
+func TestCentralizedClient_GetHistoryPerformance_InvalidValue(t *testing.T) {
+	// arrange
+	sample := map[string]interface{}{"object_id": "0", "timestamp": float64(1700000060),
+		"indicators": []interface{}{float64(22)}, "indicator_values": []interface{}{"invalid"}}
+
+	// mock
+	p := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"Error": map[string]interface{}{"code": float64(0)},
+				"Data": []interface{}{sample}}, nil
+		})
+	defer p.Reset()
+
+	// action
+	_, err := centralizedCli.GetHistoryPerformance(context.Background(), 11, []int{22},
+		1700000000, 1700000300, 0)
+
+	// assert
+	if err == nil {
+		t.Errorf("GetHistoryPerformance() error = nil, want parse error")
+	}
+}
@@ -0,0 +1,31 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+)
+
+// GetQoSPolicies is used to get smart qos policies information
+func (c *CentralizedClient) GetQoSPolicies(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetQoSPolicies")
+}
+
+// GetQoSPolicyCount used to get smart qos policy count
+func (c *CentralizedClient) GetQoSPolicyCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetQoSPolicyCount")
+}
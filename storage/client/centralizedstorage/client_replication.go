@@ -0,0 +1,51 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+)
+
+// GetHyperMetroDomains is used to get the hypermetro domains of san
+func (c *CentralizedClient) GetHyperMetroDomains(ctx context.Context) ([]map[string]interface{}, error) {
+	return c.GetByUrl(ctx, "GetHyperMetroDomains")
+}
+
+// GetFSHyperMetroDomains is used to get the hypermetro domains of nas
+func (c *CentralizedClient) GetFSHyperMetroDomains(ctx context.Context) ([]map[string]interface{}, error) {
+	return c.GetByUrl(ctx, "GetFSHyperMetroDomains")
+}
+
+// GetHyperMetroPairs is used to get hypermetro pairs information, including the pairs of lun and filesystem
+func (c *CentralizedClient) GetHyperMetroPairs(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetHyperMetroPairs")
+}
+
+// GetHyperMetroPairCount used to get hypermetro pair count
+func (c *CentralizedClient) GetHyperMetroPairCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetHyperMetroPairCount")
+}
+
+// GetReplicationPairs is used to get remote replication pairs information
+func (c *CentralizedClient) GetReplicationPairs(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetReplicationPairs")
+}
+
+// GetReplicationPairCount used to get remote replication pair count
+func (c *CentralizedClient) GetReplicationPairCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetReplicationPairCount")
+}
@@ -0,0 +1,75 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+)
+
+// GetNFSShares is used to get nfs shares information
+func (c *CentralizedClient) GetNFSShares(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetNFSShares")
+}
+
+// GetNFSShareCount used to get nfs share count
+func (c *CentralizedClient) GetNFSShareCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetNFSShareCount")
+}
+
+// GetNFSShareClientCount used to get the count of clients which are allowed to access the nfs share
+func (c *CentralizedClient) GetNFSShareClientCount(ctx context.Context, shareId string) (int, error) {
+	return c.countQueryWithArgs(ctx, "GetNFSShareClientCount", map[string]interface{}{"parentId": shareId})
+}
+
+// GetCIFSShares is used to get cifs shares information
+func (c *CentralizedClient) GetCIFSShares(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetCIFSShares")
+}
+
+// GetCIFSShareCount used to get cifs share count
+func (c *CentralizedClient) GetCIFSShareCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetCIFSShareCount")
+}
+
+// GetCIFSShareACLCount used to get the count of access control entries of the cifs share
+func (c *CentralizedClient) GetCIFSShareACLCount(ctx context.Context, shareId string) (int, error) {
+	return c.countQueryWithArgs(ctx, "GetCIFSShareACLCount", map[string]interface{}{"parentId": shareId})
+}
+
+// GetDTrees is used to get the dtrees information of the filesystem
+func (c *CentralizedClient) GetDTrees(ctx context.Context, filesystemId string,
+	start, end int) ([]map[string]interface{}, error) {
+	return c.pageQueryWithArgs(ctx, start, end, "GetDTrees", map[string]interface{}{"parentId": filesystemId})
+}
+
+// GetDTreeCount used to get the dtree count of the filesystem
+func (c *CentralizedClient) GetDTreeCount(ctx context.Context, filesystemId string) (int, error) {
+	return c.countQueryWithArgs(ctx, "GetDTreeCount", map[string]interface{}{"parentId": filesystemId})
+}
+
+// GetQuotas is used to get the quotas information of the parent, the parent is a filesystem or a dtree
+func (c *CentralizedClient) GetQuotas(ctx context.Context, parentType, parentId string,
+	start, end int) ([]map[string]interface{}, error) {
+	return c.pageQueryWithArgs(ctx, start, end, "GetQuotas",
+		map[string]interface{}{"parentType": parentType, "parentId": parentId})
+}
+
+// GetQuotaCount used to get the quota count of the parent, the parent is a filesystem or a dtree
+func (c *CentralizedClient) GetQuotaCount(ctx context.Context, parentType, parentId string) (int, error) {
+	return c.countQueryWithArgs(ctx, "GetQuotaCount",
+		map[string]interface{}{"parentType": parentType, "parentId": parentId})
+}
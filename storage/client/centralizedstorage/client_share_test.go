@@ -0,0 +1,100 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/storage/client"
+)
+
+func TestCentralizedClient_GetQuotas(t *testing.T) {
+	// arrange
+	var gotUrls []string
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrls = append(gotUrls, url)
+			if strings.Contains(url, "/count") {
+				return mockCountResponse, nil
+			}
+			return mockGetresponse, nil
+		})
+	defer patches.Reset()
+
+	// action
+	count, err := centralizedCli.GetQuotaCount(context.Background(), "16445", "1")
+	if err != nil {
+		t.Errorf("GetQuotaCount() error = %v", err)
+		return
+	}
+	_, err = centralizedCli.GetQuotas(context.Background(), "16445", "1", 0, 100)
+
+	// assert
+	if err != nil {
+		t.Errorf("GetQuotas() error = %v", err)
+		return
+	}
+	if count != 10 {
+		t.Errorf("GetQuotaCount() got = %d, want 10", count)
+	}
+	wantSuffixes := []string{"/FS_QUOTA/count?PARENTTYPE=16445&PARENTID=1",
+		"/FS_QUOTA?PARENTTYPE=16445&PARENTID=1&range=[0-100]"}
+	for i, suffix := range wantSuffixes {
+		if len(gotUrls) <= i || !strings.HasSuffix(gotUrls[i], suffix) {
+			t.Errorf("GetQuotas() got urls = %v, want suffix %s", gotUrls, suffix)
+		}
+	}
+}
+
+func TestCentralizedClient_GetShareAuthCount(t *testing.T) {
+	// arrange
+	var gotUrls []string
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrls = append(gotUrls, url)
+			return mockCountResponse, nil
+		})
+	defer patches.Reset()
+
+	// action
+	_, nfsErr := centralizedCli.GetNFSShareClientCount(context.Background(), "1")
+	_, cifsErr := centralizedCli.GetCIFSShareACLCount(context.Background(), "2")
+
+	// assert
+	if nfsErr != nil || cifsErr != nil {
+		t.Errorf("get share auth count error, nfs: %v, cifs: %v", nfsErr, cifsErr)
+		return
+	}
+	wantSuffixes := []string{"/NFS_SHARE_AUTH_CLIENT/count?filter=PARENTID::1",
+		"/CIFS_SHARE_AUTH_CLIENT/count?filter=PARENTID::2"}
+	for i, suffix := range wantSuffixes {
+		if len(gotUrls) <= i || !strings.HasSuffix(gotUrls[i], suffix) {
+			t.Errorf("get share auth count got urls = %v, want suffix %s", gotUrls, suffix)
+		}
+	}
+}
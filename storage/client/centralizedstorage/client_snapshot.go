@@ -0,0 +1,73 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+
+	"github.com/huawei/csm/v2/storage/api/centralizedstorage"
+	"github.com/huawei/csm/v2/storage/httpcode"
+	"github.com/huawei/csm/v2/storage/httpcode/snapshot"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// GetSnapshots is used to get lun snapshots information
+func (c *CentralizedClient) GetSnapshots(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetSnapshots")
+}
+
+// GetSnapshotCount used to get lun snapshot count
+func (c *CentralizedClient) GetSnapshotCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetSnapshotCount")
+}
+
+// GetSnapshotByName used to get lun snapshot by name
+func (c *CentralizedClient) GetSnapshotByName(ctx context.Context, name string) (map[string]interface{}, error) {
+	url, err := centralizedstorage.GenerateUrl("GetSnapshotByName", map[string]interface{}{"name": name})
+	if err != nil {
+		log.AddContext(ctx).Errorf("storage client get snapshot by name generate url error: %v", err)
+		return nil, err
+	}
+
+	callFunc := func() (map[string]interface{}, *float64, error) {
+		resp, err := c.get(ctx, url, nil)
+		if err != nil {
+			log.AddContext(ctx).Errorf("storage client get snapshot by name error: %v", err)
+			return nil, nil, err
+		}
+
+		return c.getResultFromResponseList(ctx, resp)
+	}
+
+	return c.Client.RetryCall(ctx, httpcode.RetryCodes, callFunc)
+}
+
+// GetSnapshotById used to get lun snapshot by id, an empty result will be returned if the snapshot does not exist
+func (c *CentralizedClient) GetSnapshotById(ctx context.Context, id string) (map[string]interface{}, error) {
+	return c.getById(ctx, id, "GetSnapshotById", snapshot.SnapshotNotExist)
+}
+
+// GetFSSnapshots is used to get the snapshots information of the filesystem
+func (c *CentralizedClient) GetFSSnapshots(ctx context.Context, filesystemId string,
+	start, end int) ([]map[string]interface{}, error) {
+	return c.pageQueryWithArgs(ctx, start, end, "GetFSSnapshots", map[string]interface{}{"parentId": filesystemId})
+}
+
+// GetFSSnapshotCount used to get the snapshot count of the filesystem
+func (c *CentralizedClient) GetFSSnapshotCount(ctx context.Context, filesystemId string) (int, error) {
+	return c.countQueryWithArgs(ctx, "GetFSSnapshotCount", map[string]interface{}{"parentId": filesystemId})
+}
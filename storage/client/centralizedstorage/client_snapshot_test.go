@@ -0,0 +1,68 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/storage/client"
+)
+
+func TestCentralizedClient_GetFSSnapshots(t *testing.T) {
+	// arrange
+	var gotUrls []string
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrls = append(gotUrls, url)
+			if strings.Contains(url, "/count") {
+				return mockCountResponse, nil
+			}
+			return mockGetresponse, nil
+		})
+	defer patches.Reset()
+
+	// action
+	count, err := centralizedCli.GetFSSnapshotCount(context.Background(), "1")
+	if err != nil {
+		t.Errorf("GetFSSnapshotCount() error = %v", err)
+		return
+	}
+	_, err = centralizedCli.GetFSSnapshots(context.Background(), "1", 0, 100)
+
+	// assert
+	if err != nil {
+		t.Errorf("GetFSSnapshots() error = %v", err)
+		return
+	}
+	if count != 10 {
+		t.Errorf("GetFSSnapshotCount() got = %d, want 10", count)
+	}
+	wantSuffixes := []string{"/fssnapshot/count?PARENTID=1", "/fssnapshot?PARENTID=1&range=[0-100]"}
+	for i, suffix := range wantSuffixes {
+		if len(gotUrls) <= i || !strings.HasSuffix(gotUrls[i], suffix) {
+			t.Errorf("GetFSSnapshots() got urls = %v, want suffix %s", gotUrls, suffix)
+		}
+	}
+}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -34,6 +34,81 @@ func (c *CentralizedClient) GetControllers(ctx context.Context) ([]map[string]in
 	return c.GetByUrl(ctx, "GetControllers")
 }
 
+// GetDiskPools is used to get storage disk pools, which are also known as disk domains
+func (c *CentralizedClient) GetDiskPools(ctx context.Context) ([]map[string]interface{}, error) {
+	return c.GetByUrl(ctx, "GetDiskPools")
+}
+
+// GetFCPorts is used to get storage fc ports by page, including the ports used by NVMe over FC
+func (c *CentralizedClient) GetFCPorts(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetFCPorts")
+}
+
+// GetFCPortCount is used to get storage fc port count
+func (c *CentralizedClient) GetFCPortCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetFCPortCount")
+}
+
+// GetETHPorts is used to get storage ethernet ports by page
+func (c *CentralizedClient) GetETHPorts(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetETHPorts")
+}
+
+// GetETHPortCount is used to get storage ethernet port count
+func (c *CentralizedClient) GetETHPortCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetETHPortCount")
+}
+
+// GetEnclosures is used to get storage enclosures, including controller enclosures and disk enclosures by page
+func (c *CentralizedClient) GetEnclosures(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetEnclosures")
+}
+
+// GetEnclosureCount is used to get storage enclosure count
+func (c *CentralizedClient) GetEnclosureCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetEnclosureCount")
+}
+
+// GetPowerSupplies is used to get storage power supply units by page
+func (c *CentralizedClient) GetPowerSupplies(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetPowerSupplies")
+}
+
+// GetPowerSupplyCount is used to get storage power supply unit count
+func (c *CentralizedClient) GetPowerSupplyCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetPowerSupplyCount")
+}
+
+// GetFans is used to get storage fans by page
+func (c *CentralizedClient) GetFans(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetFans")
+}
+
+// GetFanCount is used to get storage fan count
+func (c *CentralizedClient) GetFanCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetFanCount")
+}
+
+// GetBBUs is used to get storage backup battery units by page
+func (c *CentralizedClient) GetBBUs(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetBBUs")
+}
+
+// GetBBUCount is used to get storage backup battery unit count
+func (c *CentralizedClient) GetBBUCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetBBUCount")
+}
+
+// GetInterfaceModules is used to get storage interface modules by page
+func (c *CentralizedClient) GetInterfaceModules(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetInterfaceModules")
+}
+
+// GetInterfaceModuleCount is used to get storage interface module count
+func (c *CentralizedClient) GetInterfaceModuleCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetInterfaceModuleCount")
+}
+
 // GetByUrl is used to query storage information based on a specified URL, requiring no parameters when querying
 func (c *CentralizedClient) GetByUrl(ctx context.Context, urlKey string) ([]map[string]interface{}, error) {
 	data := map[string]interface{}{}
@@ -1,5 +1,5 @@
 /*
- *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2023. All rights reserved.
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2023-2026. All rights reserved.
  *
  *  Licensed under the Apache License, Version 2.0 (the "License");
  *  you may not use this file except in compliance with the License.
@@ -65,6 +65,18 @@ func TestCentralizedClient_GetByUrl(t *testing.T) {
 			name:   "TestGetControllers",
 			urlKey: "GetControllers",
 		},
+		{
+			name:   "TestGetDiskPools",
+			urlKey: "GetDiskPools",
+		},
+		{
+			name:   "TestGetHyperMetroDomains",
+			urlKey: "GetHyperMetroDomains",
+		},
+		{
+			name:   "TestGetFSHyperMetroDomains",
+			urlKey: "GetFSHyperMetroDomains",
+		},
 	}
 
 	for _, tt := range tests {
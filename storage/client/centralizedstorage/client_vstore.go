@@ -0,0 +1,41 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+)
+
+// GetVStores is used to get vstores information
+func (c *CentralizedClient) GetVStores(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetVStores")
+}
+
+// GetVStoreCount used to get vstore count
+func (c *CentralizedClient) GetVStoreCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetVStoreCount")
+}
+
+// GetLunCountByVStore used to get the count of luns belonging to the vstore
+func (c *CentralizedClient) GetLunCountByVStore(ctx context.Context, vstoreId string) (int, error) {
+	return c.countQueryWithArgs(ctx, "GetLunCountByVStore", map[string]interface{}{"vstoreId": vstoreId})
+}
+
+// GetFilesystemCountByVStore used to get the count of filesystems belonging to the vstore
+func (c *CentralizedClient) GetFilesystemCountByVStore(ctx context.Context, vstoreId string) (int, error) {
+	return c.countQueryWithArgs(ctx, "GetFilesystemCountByVStore", map[string]interface{}{"vstoreId": vstoreId})
+}
@@ -0,0 +1,61 @@
+/*
+ *  Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package centralizedstorage
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/storage/client"
+)
+
+func TestCentralizedClient_GetCountByVStore(t *testing.T) {
+	// arrange
+	var gotUrls []string
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrls = append(gotUrls, url)
+			return mockCountResponse, nil
+		})
+	defer patches.Reset()
+
+	// action
+	lunCount, lunErr := centralizedCli.GetLunCountByVStore(context.Background(), "1")
+	_, fsErr := centralizedCli.GetFilesystemCountByVStore(context.Background(), "1")
+
+	// assert
+	if lunErr != nil || fsErr != nil {
+		t.Errorf("get count by vstore error, lun: %v, filesystem: %v", lunErr, fsErr)
+		return
+	}
+	if lunCount != 10 {
+		t.Errorf("GetLunCountByVStore() got = %d, want 10", lunCount)
+	}
+	wantSuffixes := []string{"/lun/count?vstoreId=1", "/filesystem/count?vstoreId=1"}
+	for i, suffix := range wantSuffixes {
+		if len(gotUrls) <= i || !strings.HasSuffix(gotUrls[i], suffix) {
+			t.Errorf("get count by vstore got urls = %v, want suffix %s", gotUrls, suffix)
+		}
+	}
+}
@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2024. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -36,6 +36,8 @@ const (
 	charLimit = 20000
 
 	sessionsSubStr = "/sessions"
+
+	defaultTokenHeader = "iBaseToken"
 )
 
 // Client is used to extract storage common attribute
@@ -48,6 +50,9 @@ type Client struct {
 	VStore   string
 	Client   HttpClient
 
+	// TokenHeader is the request header to carry the token, iBaseToken is used if it is empty
+	TokenHeader string
+
 	SecretNamespace string
 	SecretName      string
 
@@ -56,6 +61,9 @@ type Client struct {
 
 	ReLoginMutex sync.Mutex
 	Semaphore    *utils.Semaphore
+
+	// CallState records the results of the calls, except the session calls
+	CallState CallState
 }
 
 // HttpClient is used to define http interface
@@ -103,6 +111,11 @@ func (c *Client) Call(ctx context.Context, method string, url string,
 	return resp, nil
 }
 
+// GetSemaphore get the semaphore which limits the concurrent calls of the client
+func (c *Client) GetSemaphore() *utils.Semaphore {
+	return c.Semaphore
+}
+
 // RetryCall is used to retry remote call storage interfaces
 func (c *Client) RetryCall(ctx context.Context, retryCodes []float64,
 	call func() (map[string]interface{}, *float64, error)) (map[string]interface{}, error) {
@@ -239,7 +252,7 @@ func (c *Client) getRequestBody(ctx context.Context, url string, reqData map[str
 
 func (c *Client) newRequest(ctx context.Context, method string, reqUrl string,
 	reqBody io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest(method, reqUrl, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, reqUrl, reqBody)
 	if err != nil {
 		log.AddContext(ctx).Errorf("client http new request error: %s", err.Error())
 		return req, err
@@ -249,7 +262,11 @@ func (c *Client) newRequest(ctx context.Context, method string, reqUrl string,
 	req.Header.Set("Content-Type", "application/json")
 
 	if c.Token != "" {
-		req.Header.Set("iBaseToken", c.Token)
+		tokenHeader := c.TokenHeader
+		if tokenHeader == "" {
+			tokenHeader = defaultTokenHeader
+		}
+		req.Header.Set(tokenHeader, c.Token)
 	}
 
 	return req, nil
@@ -0,0 +1,295 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package client is related with storage common client and operation
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/huawei/csm/v2/storage/constant"
+	"github.com/huawei/csm/v2/utils/log"
+	"github.com/huawei/csm/v2/utils/resource"
+)
+
+const (
+	// AuthenticationModeKey is the key of authentication mode in the secret of backend
+	AuthenticationModeKey = "authenticationMode"
+	// PasswordKey is the key of password in the secret of backend
+	PasswordKey = "password"
+
+	authModeScopeLocal = "0"
+	defaultTimeout     = 60 * time.Second
+)
+
+// BackendLoginParams for login backend
+type BackendLoginParams struct {
+	// Password for log in backend
+	Password []byte
+	// Scope authentication, local:0, ldap:1
+	Scope string
+}
+
+// NewHttpClient is used to new a http client which skips the certificate verification
+func NewHttpClient() *http.Client {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		log.Warningf("storage client init http client fail, error: %v", err)
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+		Jar:     jar,
+		Timeout: defaultTimeout,
+	}
+}
+
+// InitHttpClient is used to init the http client with the certificate configured in the backend
+func (c *Client) InitHttpClient(ctx context.Context) error {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		log.AddContext(ctx).Errorf("init http client cookiejar fail, error: %v", err)
+		return err
+	}
+
+	certPool, skipVerify, err := c.getTlsCertConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	tlsConfig := tls.Config{
+		InsecureSkipVerify: skipVerify,
+	}
+
+	if certPool != nil {
+		tlsConfig.RootCAs = certPool
+	}
+
+	c.Client = &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tlsConfig,
+		},
+		Jar:     jar,
+		Timeout: defaultTimeout,
+	}
+
+	log.AddContext(ctx).Infof("init http client success, skip verify certificate: %v", skipVerify)
+	return nil
+}
+
+func (c *Client) getTlsCertConfig(ctx context.Context) (*x509.CertPool, bool, error) {
+	useCert, certSecret, err := c.getCertParametersFromSbcDynamically(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("get cert parameters from sbc error: %v", err)
+		return nil, true, err
+	}
+
+	// judge to skip certificate verification
+	if !useCert {
+		return nil, true, nil
+	}
+
+	// certSecret format is <namespace>/<name>
+	certSecretNameSpace, certSecretName, err := cache.SplitMetaNamespaceKey(certSecret)
+	if err != nil {
+		log.AddContext(ctx).Errorf("split cert secret error: %v", err)
+		return nil, true, err
+	}
+
+	secret, err := resource.Instance().GetSecret(certSecretName, certSecretNameSpace)
+	if err != nil {
+		log.AddContext(ctx).Errorf("get cert secret error: %v", err)
+		return nil, true, err
+	}
+
+	certPool, err := c.getCertPool(ctx, secret)
+	if err != nil {
+		log.AddContext(ctx).Errorf("get certificate error: %v", err)
+		return nil, true, err
+	}
+
+	return certPool, false, nil
+}
+
+func (c *Client) getCertPool(ctx context.Context, secret *coreV1.Secret) (*x509.CertPool, error) {
+	log.AddContext(ctx).Infof("start get cert from secret %s/%s", secret.Namespace, secret.Name)
+	defer log.AddContext(ctx).Infof("end get cert from secret %s/%s", secret.Namespace, secret.Name)
+
+	certData, exist := secret.Data[constant.CertificateKeyName]
+	if !exist {
+		msg := fmt.Sprintf("certificate not config in secret %s/%s", secret.Namespace, secret.Name)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, errors.New(msg)
+	}
+
+	certBlock, _ := pem.Decode(certData)
+	if certBlock == nil {
+		msg := fmt.Sprintf("certificate data decode error in secret %s/%s", secret.Namespace, secret.Name)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, errors.New(msg)
+	}
+
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		log.AddContext(ctx).Errorf("error parse certificate: %v", err)
+		return nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	certPool.AddCert(cert)
+	return certPool, nil
+}
+
+// GetBackendLoginParams is used to get password and authMode from the secret of backend
+func (c *Client) GetBackendLoginParams(ctx context.Context) (*BackendLoginParams, error) {
+	secret, err := resource.Instance().GetSecret(c.SecretName, c.SecretNamespace)
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return nil, fmt.Errorf("storage client get secret with name %s and namespace %s failed, error: %w",
+			c.SecretName, c.SecretNamespace, err)
+	}
+
+	// when the sbc change the password by using oceanctl, the secret of sbc will be changed.
+	// in this case, need to get the latest secret from sbc.
+	if apiErrors.IsNotFound(err) {
+		log.AddContext(ctx).Infof("secret [%s/%s] not found, try to get new one from sbc dynamically",
+			c.SecretNamespace, c.SecretName)
+		secret, err = c.getSecretFromSbcDynamically(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get secret from sbc dynamiclly failed, error is [%w]", err)
+		}
+		log.AddContext(ctx).Infof("get secret [%s/%s] from sbc dynamically", secret.Namespace, secret.Name)
+	}
+
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("secret is nil or the data not exist in secret, namespace: %s, secret name: %s",
+			c.SecretName, c.SecretNamespace)
+	}
+
+	password, exist := secret.Data[PasswordKey]
+	if !exist {
+		return nil, fmt.Errorf("failed to query the password, namespace: %s, secret name: %s",
+			c.SecretName, c.SecretNamespace)
+	}
+
+	scope := authModeScopeLocal
+	authMode, exist := secret.Data[AuthenticationModeKey]
+	if exist {
+		scope = string(authMode)
+	}
+
+	return &BackendLoginParams{Password: password, Scope: scope}, nil
+}
+
+func (c *Client) getSecretFromSbcDynamically(ctx context.Context) (*coreV1.Secret, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("getting cluster config error, error is [%v]", err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+
+	gvr := schema.GroupVersionResource{
+		Group:    "xuanwu.huawei.io",
+		Version:  "v1",
+		Resource: "storagebackendclaims",
+	}
+	unstructuredResource, err := dynamicClient.Resource(gvr).Namespace(c.StorageBackendNamespace).
+		Get(context.TODO(), c.StorageBackendName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get unstructuredResource of sbc [%s/%s] failed, "+
+			"error is [%v]", c.StorageBackendNamespace, c.StorageBackendName, err)
+	}
+
+	secretMeta, found, err := unstructured.NestedString(
+		unstructuredResource.UnstructuredContent(), "spec", "secretMeta")
+	if !found || err != nil {
+		return nil, fmt.Errorf("get secret meta from sbc [%s/%s] failed, "+
+			"error is [%v]", c.StorageBackendNamespace, c.StorageBackendName, err)
+	}
+
+	// secretMeta format is <namespace>/<name>
+	secretNameSpace := strings.Split(secretMeta, "/")[0]
+	secretName := strings.Split(secretMeta, "/")[1]
+	return resource.Instance().GetSecret(secretName, secretNameSpace)
+}
+
+func (c *Client) getCertParametersFromSbcDynamically(ctx context.Context) (bool, string, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return false, "", fmt.Errorf("getting cluster config error, error is [%v]", err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return false, "", fmt.Errorf("getting dynamicClient error, error is [%v]", err)
+	}
+
+	gvr := schema.GroupVersionResource{
+		Group:    "xuanwu.huawei.io",
+		Version:  "v1",
+		Resource: "storagebackendclaims",
+	}
+	unstructuredResource, err := dynamicClient.Resource(gvr).Namespace(c.StorageBackendNamespace).
+		Get(context.TODO(), c.StorageBackendName, metav1.GetOptions{})
+	if err != nil {
+		return false, "", fmt.Errorf("get unstructuredResource of sbc [%s/%s] failed, "+
+			"error is [%v]", c.StorageBackendNamespace, c.StorageBackendName, err)
+	}
+
+	useCert, found, err := unstructured.NestedBool(
+		unstructuredResource.UnstructuredContent(), "spec", "useCert")
+	if err != nil {
+		return false, "", fmt.Errorf("get isUseCert parameter from sbc [%s/%s] failed, "+
+			"error is [%v]", c.StorageBackendNamespace, c.StorageBackendName, err)
+	}
+	if !found {
+		log.AddContext(ctx).Infof("useCert is not found, skip the cert")
+		return false, "", nil
+	}
+
+	if !useCert {
+		log.AddContext(ctx).Infof("useCert is false, skip the cert")
+		return false, "", nil
+	}
+
+	certSecret, found, err := unstructured.NestedString(
+		unstructuredResource.UnstructuredContent(), "spec", "certSecret")
+	if err != nil {
+		return false, "", fmt.Errorf("get certSecret parameter from sbc [%s/%s] failed, "+
+			"error is [%v]", c.StorageBackendNamespace, c.StorageBackendName, err)
+	}
+	if !found {
+		return false, "", fmt.Errorf("get certSecret parameter from sbc [%s/%s] failed, "+
+			"certSecret parameter is not found", c.StorageBackendNamespace, c.StorageBackendName)
+	}
+
+	return true, certSecret, nil
+}
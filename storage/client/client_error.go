@@ -0,0 +1,36 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package client is related with storage common client and operation
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrConnectFailed means none of the storage urls can be connected
+var ErrConnectFailed = errors.New("storage client all url connect error")
+
+// ResponseCodeError means the storage responds with a code which is not success code
+type ResponseCodeError struct {
+	Code        float64
+	Description interface{}
+}
+
+// Error implements error interface
+func (e *ResponseCodeError) Error() string {
+	return fmt.Sprintf("storage client response httpcode is not success code, code: %v, description: %v",
+		e.Code, e.Description)
+}
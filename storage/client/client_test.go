@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2022. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -136,3 +136,54 @@ func TestClient_getRequest_JsonMarshalFailed(t *testing.T) {
 		p.Reset()
 	})
 }
+
+func TestClient_newRequest_TokenHeader(t *testing.T) {
+	tests := []struct {
+		name        string
+		tokenHeader string
+		wantHeader  string
+	}{
+		{name: "default token header", tokenHeader: "", wantHeader: defaultTokenHeader},
+		{name: "specified token header", tokenHeader: "X-Auth-Token", wantHeader: "X-Auth-Token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			cli := &Client{Token: "token", TokenHeader: tt.tokenHeader}
+
+			// action
+			req, err := cli.newRequest(ctx, "GET", "https://url", nil)
+
+			// assert
+			if err != nil {
+				t.Errorf("newRequest() error = %v", err)
+				return
+			}
+			if got := req.Header.Get(tt.wantHeader); got != "token" {
+				t.Errorf("newRequest() got header %s = %s, want token", tt.wantHeader, got)
+			}
+		})
+	}
+}
+
+func TestCallState_Record_WithObserver(t *testing.T) {
+	// arrange
+	var state CallState
+	var got []bool
+	state.SetObserver(func(record CallRecord) {
+		got = append(got, record.Available())
+	})
+
+	// action
+	state.Record(nil)
+	state.Record(nil)
+	state.Record(errors.New("call failed"))
+	state.Record(errors.New("call failed"))
+	state.Record(nil)
+
+	// assert
+	want := []bool{true, false, true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Record() got observed = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage client
+package distributedstorage
+
+import (
+	"context"
+
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/storage/constant"
+	"github.com/huawei/csm/v2/storage/utils"
+)
+
+const (
+	tokenHeader = "X-Auth-Token"
+)
+
+// DistributedClient is used to use distributed storage related functions, e.g. OceanStor Pacific
+type DistributedClient struct {
+	client.Client
+}
+
+// NewDistributedClient is used to new distributed storage client
+func NewDistributedClient(ctx context.Context, config *constant.StorageBackendConfig) (*DistributedClient, error) {
+	distributedClient := &DistributedClient{
+		Client: client.Client{
+			Urls:                    config.Urls,
+			User:                    config.User,
+			SecretNamespace:         config.SecretNamespace,
+			SecretName:              config.SecretName,
+			StorageBackendNamespace: config.StorageBackendNamespace,
+			StorageBackendName:      config.StorageBackendName,
+			Client:                  client.NewHttpClient(),
+			Semaphore:               utils.NewSemaphore(config.ClientMaxThreads),
+			TokenHeader:             tokenHeader,
+		},
+	}
+	if err := distributedClient.InitHttpClient(ctx); err != nil {
+		return nil, err
+	}
+
+	return distributedClient, nil
+}
@@ -0,0 +1,284 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage client
+package distributedstorage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/huawei/csm/v2/storage/api/distributedstorage"
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/storage/httpcode"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+const (
+	// noAuthenticatedCode means the session is not logged in or expired
+	noAuthenticatedCode float64 = 10000003
+	// offlineCode means the session is forced offline
+	offlineCode float64 = 1077949069
+
+	sessionsUrlKey = "Sessions"
+)
+
+// Response is used to receive storage response when client remote call storage interfaces
+type Response struct {
+	Result map[string]interface{} `json:"result"`
+	Data   interface{}            `json:"data,omitempty"`
+}
+
+func (c *DistributedClient) get(ctx context.Context, urlKey string,
+	args map[string]interface{}) (*Response, error) {
+	return c.callDistributedStorage(ctx, "GET", urlKey, args, nil)
+}
+
+func (c *DistributedClient) post(ctx context.Context, urlKey string,
+	reqData map[string]interface{}) (*Response, error) {
+	return c.callDistributedStorage(ctx, "POST", urlKey, nil, reqData)
+}
+
+func (c *DistributedClient) delete(ctx context.Context, urlKey string,
+	reqData map[string]interface{}) (*Response, error) {
+	return c.callDistributedStorage(ctx, "DELETE", urlKey, nil, reqData)
+}
+
+func (c *DistributedClient) callDistributedStorage(ctx context.Context, method, urlKey string,
+	args map[string]interface{}, reqData map[string]interface{}) (*Response, error) {
+	methodUrl, err := distributedstorage.GenerateUrl(urlKey, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if urlKey == sessionsUrlKey {
+		return c.baseCall(ctx, method, methodUrl, reqData)
+	}
+
+	response, err := c.callWithReLogin(ctx, method, methodUrl, reqData)
+	c.CallState.Record(err)
+	return response, err
+}
+
+func (c *DistributedClient) callWithReLogin(ctx context.Context, method string,
+	methodUrl string, reqData map[string]interface{}) (*Response, error) {
+	response, err := c.baseCall(ctx, method, methodUrl, reqData)
+	if err != nil {
+		return c.reLoginCall(ctx, method, methodUrl, reqData)
+	}
+
+	code, _ := c.checkResponseCode(ctx, response)
+	if code != nil && (*code == noAuthenticatedCode || *code == offlineCode) {
+		log.AddContext(ctx).Infof("%v no authentication, need reLogin", *code)
+		return c.reLoginCall(ctx, method, methodUrl, reqData)
+	}
+
+	return response, nil
+}
+
+func (c *DistributedClient) baseCall(ctx context.Context, method string,
+	methodUrl string, reqData map[string]interface{}) (*Response, error) {
+	c.Semaphore.Acquire()
+	defer c.Semaphore.Release()
+	log.AddContext(ctx).Infof("%s call semaphore: %d", c.Curl, c.Semaphore.AvailablePermits())
+
+	url := c.Curl + methodUrl
+	response, err := c.Call(ctx, method, url, reqData)
+	if err != nil && strings.Contains(err.Error(), "x509") {
+		if err = c.InitHttpClient(ctx); err != nil {
+			return nil, err
+		}
+
+		response, err = c.Call(ctx, method, url, reqData)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToCallResponse(ctx, response)
+}
+
+func (c *DistributedClient) reLoginCall(ctx context.Context, method string,
+	methodUrl string, reqData map[string]interface{}) (*Response, error) {
+	log.AddContext(ctx).Infof("storage client reLogin call start. method: %s, url: %s", method, methodUrl)
+	defer log.AddContext(ctx).Infof("storage client reLogin call success. method: %s, url: %s", method, methodUrl)
+
+	if err := c.ReLogin(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.baseCall(ctx, method, methodUrl, reqData)
+}
+
+func convertToCallResponse(ctx context.Context, response map[string]interface{}) (*Response, error) {
+	jsData, err := json.Marshal(response)
+	if err != nil {
+		msg := fmt.Sprintf("storage client call response to json error: %v", err)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, errors.New(msg)
+	}
+
+	var resp Response
+	err = json.Unmarshal(jsData, &resp)
+	if err != nil {
+		msg := fmt.Sprintf("storage client call json to response error: %v", err)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, errors.New(msg)
+	}
+
+	return &resp, nil
+}
+
+func (c *DistributedClient) checkResponseCode(ctx context.Context, response *Response) (*float64, error) {
+	respCode, exist := response.Result["code"].(float64)
+	if !exist {
+		msg := fmt.Sprintf("storage client response httpcode does not exist, response: %v", response)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, errors.New(msg)
+	}
+
+	if respCode != httpcode.SuccessCode {
+		err := &client.ResponseCodeError{Code: respCode, Description: response.Result["description"]}
+		log.AddContext(ctx).Errorln(err)
+		return &respCode, err
+	}
+
+	return &respCode, nil
+}
+
+func (c *DistributedClient) getResultFromResponse(ctx context.Context,
+	response *Response) (map[string]interface{}, error) {
+	if _, err := c.checkResponseCode(ctx, response); err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		log.AddContext(ctx).Infoln("find response data is nil")
+		return nil, nil
+	}
+
+	respData, exist := response.Data.(map[string]interface{})
+	if !exist {
+		msg := fmt.Sprintf(
+			"storage client response data can not convert to map[string]interface{}, response data: %v", response.Data)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, errors.New(msg)
+	}
+
+	return respData, nil
+}
+
+func (c *DistributedClient) getResultListFromResponse(ctx context.Context,
+	response *Response) ([]map[string]interface{}, error) {
+	if _, err := c.checkResponseCode(ctx, response); err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		log.AddContext(ctx).Infoln("find response data is nil")
+		return nil, nil
+	}
+
+	respData, exist := response.Data.([]interface{})
+	if !exist {
+		msg := fmt.Sprintf("response data list can not convert to []interface{}, data: %v", response.Data)
+		log.AddContext(ctx).Errorln(msg)
+		return nil, errors.New(msg)
+	}
+
+	var resultList []map[string]interface{}
+	for _, data := range respData {
+		result, exist := data.(map[string]interface{})
+		if !exist {
+			msg := fmt.Sprintf("response data can not convert to map[string]interface{}, data: %v", data)
+			log.AddContext(ctx).Errorln(msg)
+			return nil, errors.New(msg)
+		}
+
+		resultList = append(resultList, result)
+	}
+
+	return resultList, nil
+}
+
+// listQuery query the object list by the url key
+func (c *DistributedClient) listQuery(ctx context.Context, urlKey string,
+	args map[string]interface{}) ([]map[string]interface{}, error) {
+	resp, err := c.get(ctx, urlKey, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getResultListFromResponse(ctx, resp)
+}
+
+// pageQuery query the object list in range [start, end)
+func (c *DistributedClient) pageQuery(ctx context.Context, start, end int,
+	urlKey string) ([]map[string]interface{}, error) {
+	return c.listQuery(ctx, urlKey, map[string]interface{}{"start": start, "limit": end - start})
+}
+
+// countQuery query the count of objects, the count is in the count field of the response data
+func (c *DistributedClient) countQuery(ctx context.Context, urlKey string) (int, error) {
+	resp, err := c.get(ctx, urlKey, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := c.getResultFromResponse(ctx, resp)
+	if err != nil {
+		return 0, err
+	}
+
+	count, ok := result["count"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("convert count [%v] to float64 failed", result["count"])
+	}
+	return int(count), nil
+}
+
+// getByName query the object by name, nil will be returned if the object does not exist
+func (c *DistributedClient) getByName(ctx context.Context, urlKey, name string) (map[string]interface{}, error) {
+	objects, err := c.listQuery(ctx, urlKey, map[string]interface{}{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, object := range objects {
+		if object["name"] == name {
+			return object, nil
+		}
+	}
+	return nil, nil
+}
+
+// getIdByName query the id of the object by name, an empty id will be returned if the object does not exist
+func (c *DistributedClient) getIdByName(ctx context.Context, urlKey, name string) (string, error) {
+	object, err := c.getByName(ctx, urlKey, name)
+	if err != nil || object == nil {
+		return "", err
+	}
+
+	switch id := object["id"].(type) {
+	case string:
+		return id, nil
+	case float64:
+		return fmt.Sprintf("%.0f", id), nil
+	default:
+		return "", fmt.Errorf("convert id [%v] of [%s] failed", object["id"], name)
+	}
+}
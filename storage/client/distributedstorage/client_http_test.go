@@ -0,0 +1,136 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package distributedstorage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/storage/utils"
+)
+
+func newTestClient() *DistributedClient {
+	return &DistributedClient{Client: client.Client{Curl: "https://url", Semaphore: utils.NewSemaphore(3)}}
+}
+
+func successResponse(data interface{}) map[string]interface{} {
+	return map[string]interface{}{"result": map[string]interface{}{"code": float64(0)}, "data": data}
+}
+
+func TestDistributedClient_Query(t *testing.T) {
+	// arrange
+	cli := newTestClient()
+	var gotUrls []string
+	responses := []map[string]interface{}{
+		successResponse(map[string]interface{}{"count": float64(2)}),
+		successResponse([]interface{}{map[string]interface{}{"id": float64(1), "name": "vol-1"}}),
+		successResponse([]interface{}{map[string]interface{}{"id": "2", "name": "fs-1"}}),
+	}
+
+	// mock
+	call := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrls = append(gotUrls, url)
+			response := responses[0]
+			responses = responses[1:]
+			return response, nil
+		})
+	defer call.Reset()
+
+	// action
+	count, countErr := cli.GetVolumeCount(context.Background())
+	volumeId, volumeErr := cli.GetLunIdByName(context.Background(), "vol-1")
+	filesystemId, fsErr := cli.GetFileSystemIdByName(context.Background(), "fs-1")
+
+	// assert
+	if countErr != nil || volumeErr != nil || fsErr != nil {
+		t.Errorf("query error, count: %v, volume: %v, filesystem: %v", countErr, volumeErr, fsErr)
+		return
+	}
+	if count != 2 || volumeId != "1" || filesystemId != "2" {
+		t.Errorf("query got count = %d, volume id = %s, filesystem id = %s", count, volumeId, filesystemId)
+	}
+	wantUrls := []string{
+		"https://url/api/v2/block_service/volumes/count",
+		"https://url/api/v2/block_service/volumes?name=vol-1",
+		"https://url/api/v2/converged_service/namespaces?name=fs-1",
+	}
+	if !reflect.DeepEqual(gotUrls, wantUrls) {
+		t.Errorf("query got urls = %v, want %v", gotUrls, wantUrls)
+	}
+}
+
+func TestDistributedClient_GetVolumes(t *testing.T) {
+	// arrange
+	cli := newTestClient()
+	var gotUrl string
+
+	// mock
+	call := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			gotUrl = url
+			return successResponse([]interface{}{map[string]interface{}{"id": float64(1)}}), nil
+		})
+	defer call.Reset()
+
+	// action
+	volumes, err := cli.GetVolumes(context.Background(), 100, 200)
+
+	// assert
+	if err != nil || len(volumes) != 1 {
+		t.Errorf("GetVolumes() got = %v, error = %v", volumes, err)
+	}
+	if gotUrl != "https://url/api/v2/block_service/volumes?offset=100&limit=100" {
+		t.Errorf("GetVolumes() got url = %s", gotUrl)
+	}
+}
+
+func TestDistributedClient_CallWithReLogin(t *testing.T) {
+	// arrange
+	cli := newTestClient()
+	reLogin := false
+	responses := []map[string]interface{}{
+		{"result": map[string]interface{}{"code": noAuthenticatedCode}},
+		successResponse([]interface{}{}),
+	}
+
+	// mock
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+		func(_ *client.Client, ctx context.Context, method string,
+			url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+			response := responses[0]
+			responses = responses[1:]
+			return response, nil
+		}).
+		ApplyMethod(reflect.TypeOf(cli), "ReLogin", func(_ *DistributedClient, ctx context.Context) error {
+			reLogin = true
+			return nil
+		})
+	defer patches.Reset()
+
+	// action
+	_, err := cli.GetStoragePools(context.Background())
+
+	// assert
+	if err != nil || !reLogin {
+		t.Errorf("GetStoragePools() reLogin = %v, error = %v", reLogin, err)
+	}
+}
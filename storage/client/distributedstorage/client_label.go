@@ -0,0 +1,137 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage client
+package distributedstorage
+
+import (
+	"context"
+
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/storage/httpcode/label"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// CreatePvLabel create pv label
+func (c *DistributedClient) CreatePvLabel(ctx context.Context,
+	request client.PvLabelRequest) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"resourceId":   request.ResourceId,
+		"resourceType": request.ResourceType,
+		"pvName":       request.PvName,
+		"clusterName":  request.ClusterName,
+	}
+
+	return c.changeLabel(ctx, "POST", "CreatePvLabel", data, label.PvLabelExist)
+}
+
+// DeletePvLabel delete pv label
+func (c *DistributedClient) DeletePvLabel(ctx context.Context,
+	resourceId, resourceType string) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"resourceId":   resourceId,
+		"resourceType": resourceType,
+	}
+
+	return c.changeLabel(ctx, "DELETE", "DeletePvLabel", data, label.PvLabelNotExist)
+}
+
+// CreatePodLabel create pod label
+func (c *DistributedClient) CreatePodLabel(ctx context.Context,
+	request client.PodLabelRequest) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"resourceId":   request.ResourceId,
+		"resourceType": request.ResourceType,
+		"podName":      request.PodName,
+		"nameSpace":    request.NameSpace,
+	}
+
+	return c.changeLabel(ctx, "POST", "CreatePodLabel", data, label.PodLabelExist)
+}
+
+// DeletePodLabel delete pod label
+func (c *DistributedClient) DeletePodLabel(ctx context.Context,
+	request client.PodLabelRequest) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"resourceId":   request.ResourceId,
+		"resourceType": request.ResourceType,
+		"podName":      request.PodName,
+		"nameSpace":    request.NameSpace,
+	}
+
+	return c.changeLabel(ctx, "DELETE", "DeletePodLabel", data, label.PodLabelNotExist)
+}
+
+// GetPvLabels page query pv labels
+func (c *DistributedClient) GetPvLabels(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetPvLabels")
+}
+
+// GetPvLabelCount get the count of pv labels
+func (c *DistributedClient) GetPvLabelCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetPvLabelCount")
+}
+
+// GetPvLabelsByResource get pv labels of the specified resource
+func (c *DistributedClient) GetPvLabelsByResource(ctx context.Context, resourceId,
+	resourceType string) ([]map[string]interface{}, error) {
+	return c.listQuery(ctx, "GetPvLabelsByResource", map[string]interface{}{
+		"resourceId":   resourceId,
+		"resourceType": resourceType,
+	})
+}
+
+// GetPodLabels page query pod labels
+func (c *DistributedClient) GetPodLabels(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetPodLabels")
+}
+
+// GetPodLabelCount get the count of pod labels
+func (c *DistributedClient) GetPodLabelCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetPodLabelCount")
+}
+
+// GetPodLabelsByResource get pod labels of the specified resource
+func (c *DistributedClient) GetPodLabelsByResource(ctx context.Context, resourceId,
+	resourceType string) ([]map[string]interface{}, error) {
+	return c.listQuery(ctx, "GetPodLabelsByResource", map[string]interface{}{
+		"resourceId":   resourceId,
+		"resourceType": resourceType,
+	})
+}
+
+// changeLabel create or delete label, the permitted code means the label has already been created or deleted
+func (c *DistributedClient) changeLabel(ctx context.Context, method, urlKey string,
+	data map[string]interface{}, permittedCode float64) (map[string]interface{}, error) {
+	resp, err := c.callDistributedStorage(ctx, method, urlKey, nil, data)
+	if err != nil {
+		log.AddContext(ctx).Errorf("change label failed, url: %s, error: %v", urlKey, err)
+		return nil, err
+	}
+
+	code, err := c.checkResponseCode(ctx, resp)
+	if code != nil && *code == permittedCode {
+		log.AddContext(ctx).Infof("the label has been changed, url: %s, code: %v", urlKey, *code)
+		return map[string]interface{}{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := resp.Data.(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, nil
+	}
+	return result, nil
+}
@@ -0,0 +1,37 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage client
+package distributedstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GetPerformance query storage performance, the data of each object contains
+// object_id, indicators and indicator_values
+func (c *DistributedClient) GetPerformance(ctx context.Context, objectType int,
+	indicators []int) ([]map[string]interface{}, error) {
+	var temp = make([]string, len(indicators))
+	for k, v := range indicators {
+		temp[k] = fmt.Sprintf("%d", v)
+	}
+
+	return c.listQuery(ctx, "PerformanceData", map[string]interface{}{
+		"objectType": objectType,
+		"indicators": "[" + strings.Join(temp, ",") + "]",
+	})
+}
@@ -0,0 +1,126 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage client
+package distributedstorage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/utils/log"
+)
+
+// Login is used to log in storage client
+func (c *DistributedClient) Login(ctx context.Context) error {
+	log.AddContext(ctx).Infof("storage client login start, urls: %v", c.Urls)
+	params, err := c.GetBackendLoginParams(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("get BackendLoginParams failed, err: %v", err)
+		return err
+	}
+
+	reqData := map[string]interface{}{
+		"user_name": c.User,
+		"password":  string(params.Password),
+		"scope":     params.Scope,
+	}
+
+	for i := range params.Password {
+		params.Password[i] = 0
+	}
+
+	resp, err := c.loginCall(ctx, reqData)
+	reqData[client.PasswordKey] = ""
+	if err != nil {
+		log.AddContext(ctx).Errorf("storage client login error: %v", err)
+		return err
+	}
+
+	respData, err := c.getResultFromResponse(ctx, resp)
+	if err != nil {
+		return err
+	}
+
+	token, exist := respData["x_auth_token"].(string)
+	if !exist {
+		msg := "storage client login response x_auth_token can not convert to string"
+		log.AddContext(ctx).Errorln(msg)
+		return errors.New(msg)
+	}
+	c.Token = token
+
+	log.AddContext(ctx).Infof("storage client login success, url: %s", c.Curl)
+	return nil
+}
+
+// ReLogin is used to reLogin storage client
+func (c *DistributedClient) ReLogin(ctx context.Context) error {
+	log.AddContext(ctx).Infof("storage client reLogin start...")
+	defer log.AddContext(ctx).Infof("storage client reLogin success...")
+
+	oldToken := c.Token
+
+	c.ReLoginMutex.Lock()
+	defer c.ReLoginMutex.Unlock()
+	if c.Token != "" && oldToken != c.Token {
+		// other thread had already done relogin, so no need to relogin again
+		return nil
+	}
+
+	c.Logout(ctx)
+	err := c.Login(ctx)
+	if err != nil {
+		log.AddContext(ctx).Errorf("storage client try to relogin error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Logout is used to logout storage client
+func (c *DistributedClient) Logout(ctx context.Context) {
+	log.AddContext(ctx).Infof("storage client logout start...")
+	defer log.AddContext(ctx).Infof("storage client logout success...")
+
+	resp, err := c.delete(ctx, sessionsUrlKey, nil)
+	if err != nil {
+		log.AddContext(ctx).Errorf("storage client logout %s error: %v", c.Curl, err)
+		return
+	}
+
+	_, err = c.checkResponseCode(ctx, resp)
+	if err != nil {
+		log.AddContext(ctx).Errorf("storage client logout %s error: %v", c.Curl, err)
+		return
+	}
+
+	log.AddContext(ctx).Infof("storage client logout %s success", c.Curl)
+}
+
+func (c *DistributedClient) loginCall(ctx context.Context, reqData map[string]interface{}) (*Response, error) {
+	for _, url := range c.Urls {
+		c.Curl = url
+		log.AddContext(ctx).Infof("storage client try to login: %s", c.Curl)
+		resp, err := c.post(ctx, sessionsUrlKey, reqData)
+		if err == nil {
+			return resp, err
+		}
+
+		log.AddContext(ctx).Infof("storage client %s login error, going to try another url", c.Curl)
+	}
+
+	return nil, client.ErrConnectFailed
+}
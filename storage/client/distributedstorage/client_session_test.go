@@ -0,0 +1,89 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package distributedstorage
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/huawei/csm/v2/storage/client"
+	"github.com/huawei/csm/v2/storage/utils"
+	"github.com/huawei/csm/v2/utils/resource"
+)
+
+func mockSecret(t *testing.T) *gomonkey.Patches {
+	t.Helper()
+	secret := &coreV1.Secret{
+		Data: map[string][]byte{
+			client.PasswordKey: []byte{'1'},
+		},
+	}
+	return gomonkey.ApplyMethod(reflect.TypeOf(&resource.Client{}), "GetSecret",
+		func(_ *resource.Client, name string, namespace string) (*coreV1.Secret, error) {
+			return secret, nil
+		})
+}
+
+func TestDistributedClient_Login(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      map[string]interface{}
+		wantToken string
+		wantErr   bool
+	}{
+		{name: "login success", data: map[string]interface{}{"x_auth_token": "token"}, wantToken: "token"},
+		{name: "token not exist", data: map[string]interface{}{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			var gotUrl string
+			var gotReqData map[string]interface{}
+			cli := &DistributedClient{Client: client.Client{Urls: []string{"https://url"},
+				User: "user", Semaphore: utils.NewSemaphore(3)}}
+
+			// mock
+			getSecret := mockSecret(t)
+			defer getSecret.Reset()
+			call := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "Call",
+				func(_ *client.Client, ctx context.Context, method string,
+					url string, reqData map[string]interface{}) (map[string]interface{}, error) {
+					gotUrl, gotReqData = url, reqData
+					return map[string]interface{}{"result": map[string]interface{}{"code": float64(0)},
+						"data": tt.data}, nil
+				})
+			defer call.Reset()
+
+			// action
+			err := cli.Login(context.Background())
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Login() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if cli.Token != tt.wantToken {
+				t.Errorf("Login() got token = %s, want %s", cli.Token, tt.wantToken)
+			}
+			if gotUrl != "https://url/api/v2/aa/sessions" || gotReqData["user_name"] != "user" {
+				t.Errorf("Login() got url = %s, request = %v", gotUrl, gotReqData)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage client
+package distributedstorage
+
+import (
+	"context"
+)
+
+// GetSystemInfo used to get the product information of the cluster
+func (c *DistributedClient) GetSystemInfo(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := c.get(ctx, "GetSystemInfo", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getResultFromResponse(ctx, resp)
+}
+
+// GetStoragePools used to get storage pools
+func (c *DistributedClient) GetStoragePools(ctx context.Context) ([]map[string]interface{}, error) {
+	return c.listQuery(ctx, "GetStoragePools", nil)
+}
@@ -0,0 +1,40 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package distributedstorage is related with distributed storage client
+package distributedstorage
+
+import (
+	"context"
+)
+
+// GetVolumes used to get volumes in range [start, end)
+func (c *DistributedClient) GetVolumes(ctx context.Context, start, end int) ([]map[string]interface{}, error) {
+	return c.pageQuery(ctx, start, end, "GetVolumes")
+}
+
+// GetVolumeCount used to get the count of volumes
+func (c *DistributedClient) GetVolumeCount(ctx context.Context) (int, error) {
+	return c.countQuery(ctx, "GetVolumeCount")
+}
+
+// GetVolumeByName used to get volume by name, an empty result will be returned if the volume does not exist
+func (c *DistributedClient) GetVolumeByName(ctx context.Context, name string) (map[string]interface{}, error) {
+	return c.getByName(ctx, "GetVolumeByName", name)
+}
+
+// GetLunIdByName used to get volume id by name
+func (c *DistributedClient) GetLunIdByName(ctx context.Context, name string) (string, error) {
+	return c.getIdByName(ctx, "GetVolumeByName", name)
+}
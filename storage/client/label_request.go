@@ -0,0 +1,32 @@
+/*
+ Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package client is related with storage common client and operation
+package client
+
+// PvLabelRequest create pv label request
+type PvLabelRequest struct {
+	ResourceId   string
+	ResourceType string
+	PvName       string
+	ClusterName  string
+}
+
+// PodLabelRequest create and delete label request
+type PodLabelRequest struct {
+	ResourceId   string
+	ResourceType string
+	PodName      string
+	NameSpace    string
+}
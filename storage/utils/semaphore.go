@@ -1,5 +1,5 @@
 /*
- Copyright (c) Huawei Technologies Co., Ltd. 2022-2022. All rights reserved.
+ Copyright (c) Huawei Technologies Co., Ltd. 2022-2026. All rights reserved.
 
  Licensed under the Apache License, Version 2.0 (the "License");
  you may not use this file except in compliance with the License.
@@ -43,3 +43,8 @@ func (s *Semaphore) Release() {
 func (s *Semaphore) AvailablePermits() int {
 	return s.permits - len(s.channel)
 }
+
+// Permits get total permits
+func (s *Semaphore) Permits() int {
+	return s.permits
+}